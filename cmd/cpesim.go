@@ -0,0 +1,76 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/cpesim"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var cpeSimCmd = &cobra.Command{
+	Use:   "cpe-sim ACS-URL",
+	Short: "Simulate a TR-069 CPE",
+	Long: `Simulate a TR-069 CPE connecting to the given ACS, to develop and test CWMP sequences without hardware.
+The simulated CPE sends a BOOT Inform, answers connection requests, keeps an in-memory TR-181 parameter tree
+and simulates ChangeDUState operations`,
+	Example: "corteca cpe-sim http://127.0.0.1:7547 --listen 127.0.0.1:7548 --serial 123456",
+	Args:    cobra.ExactArgs(1),
+	Run:     func(cmd *cobra.Command, args []string) { doSimulateCPE(args[0]) },
+}
+
+var cpeSimConfig cpesim.Config
+var cpeSimParamsFile string
+var cpeSimUsername, cpeSimPassword string
+var cpeSimCWMPVersion string
+
+func init() {
+	flags := cpeSimCmd.Flags()
+	flags.StringVar(&cpeSimConfig.Listen, "listen", cpesim.DefaultListenAddr, "Address to listen on for connection requests")
+	flags.StringVar(&cpeSimConfig.ConnectionRequestURL, "connection-request-url", "", "Connection request URL reported to the ACS (derived from --listen if not specified)")
+	flags.StringVar(&cpeSimConfig.DeviceId.SerialNumber, "serial", "000000000001", "Serial number of the simulated CPE")
+	flags.StringVar(&cpeSimConfig.DeviceId.OUI, "oui", "000000", "OUI of the simulated CPE")
+	flags.StringVar(&cpeSimConfig.DeviceId.ProductClass, "product-class", "CortecaSim", "Product class of the simulated CPE")
+	flags.StringVar(&cpeSimConfig.DeviceId.Manufacturer, "manufacturer", "Nokia", "Manufacturer of the simulated CPE")
+	flags.StringVar(&cpeSimParamsFile, "params", "", "YAML file with the initial parameters of the simulated CPE")
+	flags.DurationVar(&cpeSimConfig.PeriodicInterval, "periodic", 0, "Interval of periodic Informs (disabled if 0)")
+	flags.UintVar(&cpeSimConfig.DUStateChange.FaultCode, "du-fault", 0, "Fault code reported for every ChangeDUState operation (success if 0)")
+	flags.StringVar(&cpeSimConfig.DUStateChange.FaultString, "du-fault-string", "", "Fault string reported along with --du-fault")
+	flags.DurationVar(&cpeSimConfig.DUStateChange.Delay, "du-delay", 0, "Delay before ChangeDUState operations complete")
+	flags.StringVar(&cpeSimCWMPVersion, "cwmp-version", messages.LatestCWMPVersion.String(), "Highest CWMP version supported by the simulated CPE")
+	flags.StringVar(&cpeSimUsername, "username", "", "Username to authenticate to the ACS")
+	flags.StringVar(&cpeSimPassword, "password", "", "Password to authenticate to the ACS")
+	cpeSimCmd.RegisterFlagCompletionFunc("params", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"yaml", "yml"}, cobra.ShellCompDirectiveFilterFileExt
+	})
+	rootCmd.AddCommand(cpeSimCmd)
+}
+
+func doSimulateCPE(acsURL string) {
+	cpeSimConfig.ACS.Addr = configuration.T(acsURL)
+	cpeSimConfig.ACS.Username = configuration.T(cpeSimUsername)
+	cpeSimConfig.ACS.Password = configuration.T(cpeSimPassword)
+	version, err := messages.ParseCWMPVersion(cpeSimCWMPVersion)
+	assertOperation("parsing CWMP version", err)
+	cpeSimConfig.CWMPVersion = version
+	if len(cpeSimParamsFile) > 0 {
+		data, err := os.ReadFile(cpeSimParamsFile)
+		assertOperation("reading parameters file", err)
+		assertOperation("parsing parameters file", yaml.Unmarshal(data, &cpeSimConfig.Parameters))
+	}
+	sim, err := cpesim.New(cpeSimConfig)
+	assertOperation("creating CPE simulator", err)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	assertOperation("simulating CPE", sim.Run(ctx))
+}
@@ -0,0 +1,243 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cmd
+
+import (
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device"
+	"github.com/nokia/corteca-cli/internal/platform"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+var deviceCmd = &cobra.Command{
+	Use:   "device",
+	Short: "Inspect a device",
+	Long:  `Inspect a configured device`,
+}
+
+var deviceTreeCmd = &cobra.Command{
+	Use:   "tree DEVICE [PATH]",
+	Short: "Print the data model of a device",
+	Long: `Walk the data model of a device, starting from PATH (default "Device."), and print it as an indented tree, YAML or JSON.
+CWMP devices are walked with GetParameterNames/GetParameterValues, USP devices with a Get message and SSH devices
+(prplOS) with ba-cli`,
+	Example:           "corteca device tree my-cpe Device.DeviceInfo. --output yaml",
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: validDeviceArgsFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		path := device.DefaultDataModelRoot
+		if len(args) > 1 {
+			path = args[1]
+		}
+		doPrintDeviceTree(args[0], path)
+	},
+}
+
+var deviceSnapshotCmd = &cobra.Command{
+	Use:   "snapshot DEVICE [PATH]",
+	Short: "Capture the parameter values of a device",
+	Long: `Capture the values of all the parameters under PATH (default "Device.") to a snapshot file, to be compared later
+with 'corteca device diff'. CWMP devices are read with a single GetParameterValues of the partial path`,
+	Example:           "corteca device snapshot my-cpe Device.SoftwareModules. --file before.yaml",
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: validDeviceArgsFunc,
+	Run: func(cmd *cobra.Command, args []string) {
+		path := device.DefaultDataModelRoot
+		if len(args) > 1 {
+			path = args[1]
+		}
+		doSnapshotDevice(args[0], path)
+	},
+}
+
+var deviceDiffCmd = &cobra.Command{
+	Use:   "diff SNAPSHOT [SNAPSHOT]",
+	Short: "Compare parameter snapshots",
+	Long: `Report the parameters added, removed or changed between two snapshots, or between a snapshot and the current
+state of a device (--device), read from the path of the snapshot`,
+	Example: "corteca device diff before.yaml --device my-cpe",
+	Args:    cobra.RangeArgs(1, 2),
+	Run:     func(cmd *cobra.Command, args []string) { doDiffSnapshots(args) },
+}
+
+var deviceTimeout time.Duration
+var snapshotFile string
+var diffOutputFormats = []string{"text", "yaml", "json"}
+var diffOutput string
+var diffDevice string
+var treeOutputFormats = []string{"tree", "yaml", "json"}
+var treeOutput string
+var treeDepth int
+
+func init() {
+	deviceCmd.PersistentFlags().DurationVar(&deviceTimeout, "timeout", configuration.DefaultMaxTimeout, "Maximum time to wait for the data model to be read")
+	deviceCmd.PersistentFlags().StringVar(&logFile, "logfile", platform.DefaultLog, "Specify where device logs will be stored")
+	deviceTreeCmd.Flags().StringVarP(&treeOutput, "output", "o", "tree", "Output format; one of: "+strings.Join(treeOutputFormats, ", "))
+	deviceTreeCmd.Flags().IntVar(&treeDepth, "depth", 0, "Number of object levels to descend below PATH (no limit if 0)")
+	deviceTreeCmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return treeOutputFormats, cobra.ShellCompDirectiveNoFileComp
+	})
+	deviceSnapshotCmd.Flags().StringVarP(&snapshotFile, "file", "f", "", "File to write the snapshot to (standard output if not specified)")
+	deviceDiffCmd.Flags().StringVar(&diffDevice, "device", "", "Compare the snapshot with the current state of the given device")
+	deviceDiffCmd.Flags().StringVarP(&diffOutput, "output", "o", "text", "Output format; one of: "+strings.Join(diffOutputFormats, ", "))
+	deviceDiffCmd.RegisterFlagCompletionFunc("device", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return validDeviceArgsFunc(cmd, nil, toComplete)
+	})
+	deviceDiffCmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return diffOutputFormats, cobra.ShellCompDirectiveNoFileComp
+	})
+	deviceCmd.AddCommand(deviceTreeCmd)
+	deviceCmd.AddCommand(deviceSnapshotCmd)
+	deviceCmd.AddCommand(deviceDiffCmd)
+	rootCmd.AddCommand(deviceCmd)
+}
+
+func doPrintDeviceTree(deviceName, path string) {
+	if !slices.Contains(treeOutputFormats, treeOutput) {
+		failOperation(fmt.Sprintf("invalid output format '%s'; expected one of: %s", treeOutput, strings.Join(treeOutputFormats, ", ")))
+	}
+	if treeDepth < 0 {
+		failOperation("depth must not be negative")
+	}
+	var params []device.Parameter
+	readFromDevice(deviceName, func(ctx context.Context, dev device.Device) (err error) {
+		reader, ok := dev.(device.DataModelReader)
+		if !ok {
+			failOperation(fmt.Sprintf("reading the data model is not supported for %s devices", dev.GetProtocol()))
+		}
+		tui.LogNormal("Reading '%s' from device '%s', protocol: %s", path, deviceName, dev.GetProtocol())
+		params, err = reader.ReadDataModel(ctx, path, treeDepth)
+		return err
+	})
+
+	var err error
+	tree := device.BuildDataModelTree(params)
+	switch treeOutput {
+	case "tree":
+		err = tree.WriteIndented(os.Stdout)
+	case "yaml":
+		encoder := yaml.NewEncoder(os.Stdout)
+		encoder.SetIndent(4)
+		err = encoder.Encode(tree.ToMap())
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(tree.ToMap())
+	}
+	assertOperation("printing data model", err)
+}
+
+// read the parameter values under path from the named device
+func readSnapshot(deviceName, path string) *device.Snapshot {
+	var params []device.Parameter
+	readFromDevice(deviceName, func(ctx context.Context, dev device.Device) (err error) {
+		tui.LogNormal("Reading '%s' from device '%s', protocol: %s", path, deviceName, dev.GetProtocol())
+		params, err = device.ReadParameterValues(ctx, dev, path)
+		return err
+	})
+	return device.NewSnapshot(deviceName, path, params)
+}
+
+func doSnapshotDevice(deviceName, path string) {
+	snapshot := readSnapshot(deviceName, path)
+	if len(snapshotFile) == 0 {
+		assertOperation("writing snapshot", snapshot.Write(os.Stdout))
+		return
+	}
+	f, err := os.Create(snapshotFile)
+	assertOperation("creating snapshot file", err)
+	err = snapshot.Write(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	assertOperation("writing snapshot", err)
+	tui.DisplaySuccessMsg(fmt.Sprintf("Snapshot of %d parameters written to '%s'", len(snapshot.Parameters), snapshotFile))
+}
+
+func doDiffSnapshots(files []string) {
+	if !slices.Contains(diffOutputFormats, diffOutput) {
+		failOperation(fmt.Sprintf("invalid output format '%s'; expected one of: %s", diffOutput, strings.Join(diffOutputFormats, ", ")))
+	}
+	if (len(files) == 1) == (len(diffDevice) == 0) {
+		failOperation("either two snapshots or a snapshot and a device (--device) must be specified")
+	}
+	old, err := device.LoadSnapshot(files[0])
+	assertOperation("reading snapshot", err)
+	var current *device.Snapshot
+	if len(files) > 1 {
+		current, err = device.LoadSnapshot(files[1])
+		assertOperation("reading snapshot", err)
+	} else {
+		current = readSnapshot(diffDevice, old.Path)
+	}
+
+	diff := device.DiffSnapshots(old, current)
+	switch diffOutput {
+	case "text":
+		if diff.IsEmpty() {
+			tui.DisplaySuccessMsg("No differences found")
+		}
+		err = diff.WriteText(os.Stdout)
+	case "yaml":
+		encoder := yaml.NewEncoder(os.Stdout)
+		encoder.SetIndent(4)
+		err = encoder.Encode(diff)
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(diff)
+	}
+	assertOperation("printing differences", err)
+}
+
+// connect to the named device and read from it within a sequence, bounded by --timeout
+func readFromDevice(deviceName string, read func(ctx context.Context, dev device.Device) error) {
+	if devConfig, found := config.Devices[deviceName]; !found {
+		failOperation(fmt.Sprintf("no config for device '%s' was found", deviceName))
+	} else {
+		configuration.GetCmdContext().Device.DeviceConfig = devConfig
+		configuration.GetCmdContext().Device.Name = deviceName
+	}
+
+	log, closeLog := openLogFile()
+	defer closeLog()
+
+	dev, err := device.NewDevice(&configuration.GetCmdContext().Device.DeviceConfig, log)
+	if err != nil {
+		failOperation(fmt.Sprintf("could not create device %s (%s)", deviceName, err.Error()))
+	}
+	defer dev.Close()
+
+	assertOperation("beginning sequence", dev.BeginSequence())
+	ctx, cancel := context.WithTimeout(context.Background(), deviceTimeout)
+	err = read(ctx, dev)
+	cancel()
+	endErr := dev.EndSequence()
+	assertOperation("reading data model", err)
+	assertOperation("ending sequence", endErr)
+}
+
+func validDeviceArgsFunc(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) != 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	devices := make([]string, 0, len(config.Devices))
+	for k := range config.Devices {
+		if strings.HasPrefix(k, toComplete) {
+			devices = append(devices, k)
+		}
+	}
+	return devices, cobra.ShellCompDirectiveNoFileComp
+}
@@ -5,10 +5,12 @@
 package cmd
 
 import (
+	"github.com/nokia/corteca-cli/internal/certs"
 	"github.com/nokia/corteca-cli/internal/configuration"
 	"github.com/nokia/corteca-cli/internal/device"
 	_ "github.com/nokia/corteca-cli/internal/device/cwmp"
 	_ "github.com/nokia/corteca-cli/internal/device/ssh"
+	_ "github.com/nokia/corteca-cli/internal/device/usp"
 	"github.com/nokia/corteca-cli/internal/platform"
 	"github.com/nokia/corteca-cli/internal/tui"
 	"fmt"
@@ -54,26 +56,12 @@ func doExecSequence(sequencename, deviceName string) {
 		configuration.GetCmdContext().Device.Name = deviceName
 		configuration.GetCmdContext().Arch = configuration.GetCmdContext().Device.Architecture
 	}
+	// sequences may provision devices with the CA through `.tls`
+	assertOperation("reading cached CA certificate", certs.ExportCA())
 
 	// prepare log file
-	var log io.WriteCloser
-	switch strings.ToLower(logFile) {
-	case "stdout":
-		log = os.Stdout
-	case "stderr":
-		log = os.Stderr
-	default:
-		if f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666); err != nil {
-			failOperation(fmt.Sprintf("Could not create log file: %s", err.Error()))
-		} else {
-			log = f
-			defer func() {
-				if err := f.Close(); err != nil {
-					tui.LogError("could not close log file (%s)", err.Error())
-				}
-			}()
-		}
-	}
+	log, closeLog := openLogFile()
+	defer closeLog()
 
 	// connect to the device console
 	device, err := device.NewDevice(&configuration.GetCmdContext().Device.DeviceConfig, log)
@@ -99,6 +87,25 @@ func doExecSequence(sequencename, deviceName string) {
 	}
 }
 
+// open the --logfile destination; the returned function closes it
+func openLogFile() (io.Writer, func()) {
+	switch strings.ToLower(logFile) {
+	case "stdout":
+		return os.Stdout, func() {}
+	case "stderr":
+		return os.Stderr, func() {}
+	}
+	f, err := os.OpenFile(logFile, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0666)
+	if err != nil {
+		failOperation(fmt.Sprintf("Could not create log file: %s", err.Error()))
+	}
+	return f, func() {
+		if err := f.Close(); err != nil {
+			tui.LogError("could not close log file (%s)", err.Error())
+		}
+	}
+}
+
 func validExecArgsFunc(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if len(args) == 0 {
 		sequences := make([]string, 0, len(config.Sequences))
@@ -44,6 +44,7 @@ type RegistryConfig struct {
 	configuration.HttpServerEndpoint `yaml:",inline"`
 	Namespace                        configuration.TemplateField `yaml:"namespace"`
 	Reference                        configuration.TemplateField `yaml:"reference"`
+	TLS                              bool                        `yaml:"tls,omitempty"`
 }
 
 func init() {
@@ -136,7 +137,7 @@ func connectableServerURL(server *http.Server) (*url.URL, error) {
 }
 
 func handleRegistry(config RegistryConfig, wait bool) {
-	registryServer, err := publish.StartRegistry(config.HttpServerEndpoint)
+	registryServer, err := publish.StartRegistry(config.HttpServerEndpoint, config.TLS)
 	if err != nil {
 		failOperation(fmt.Sprintf("failed to start local registry: %v", err))
 	}
@@ -5,7 +5,9 @@
 package cmd
 
 import (
+	"github.com/nokia/corteca-cli/internal/certs"
 	"github.com/nokia/corteca-cli/internal/configuration"
+	devssh "github.com/nokia/corteca-cli/internal/device/ssh"
 	specs "github.com/nokia/corteca-cli/internal/configuration/runtimeSpec"
 	"github.com/nokia/corteca-cli/internal/platform"
 	"github.com/nokia/corteca-cli/internal/tui"
@@ -21,7 +23,11 @@ import (
 	"github.com/spf13/cobra"
 )
 
-const distFolderName = "dist"
+const (
+	distFolderName  = "dist"
+	certsFolderName = "certs"
+	knownHostsFile  = "known_hosts"
+)
 
 var (
 	cpuShares      = uint64(1024)
@@ -106,6 +112,14 @@ func initConfiguration() {
 	}
 	// override config values
 	assertOperation("parsing configuration overrides", overrideConfigValues())
+	// self-signed certificates are cached per project (or per user, outside a project)
+	if projectRoot != "" {
+		certs.CacheDir = filepath.Join(projectRoot, ".corteca", certsFolderName)
+	} else {
+		certs.CacheDir = filepath.Join(userConfigRoot, certsFolderName)
+	}
+	// host keys are trusted per user, as devices are shared between projects
+	devssh.KnownHostsFile = filepath.Join(userConfigRoot, knownHostsFile)
 	// TODO: validate configuration settings
 }
 
@@ -0,0 +1,233 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package certs
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+const (
+	CAFileName         = "ca.pem"
+	CAKeyFileName      = "ca-key.pem"
+	ServerCertFileName = "server.pem"
+	ServerKeyFileName  = "server-key.pem"
+
+	caValidity     = 10 * 365 * 24 * time.Hour
+	serverValidity = 365 * 24 * time.Hour
+	renewBefore    = 24 * time.Hour
+	rsaKeySize     = 2048
+)
+
+// folder where the generated CA & server certificates are cached (set by the command line layer)
+var CacheDir string
+
+// return the given certificate/key pair, or a generated self-signed one if neither is given
+func ResolveServerCertificate(certFile, keyFile string) (string, string, error) {
+	switch {
+	case len(certFile) > 0 && len(keyFile) > 0:
+		return certFile, keyFile, nil
+	case len(certFile) > 0:
+		return "", "", errors.New("certificate configured without a key")
+	case len(keyFile) > 0:
+		return "", "", errors.New("key configured without a certificate")
+	}
+	return EnsureServerCertificate()
+}
+
+// generate (if needed) the CA & a server certificate valid for the host's name & address; returns the
+// paths of the server certificate & key
+func EnsureServerCertificate() (string, string, error) {
+	if len(CacheDir) == 0 {
+		return "", "", errors.New("no certificate cache folder specified")
+	}
+	if err := os.MkdirAll(CacheDir, 0700); err != nil {
+		return "", "", err
+	}
+	ca, caKey, err := ensureCA()
+	if err != nil {
+		return "", "", fmt.Errorf("preparing CA: %w", err)
+	}
+	certFile := filepath.Join(CacheDir, ServerCertFileName)
+	keyFile := filepath.Join(CacheDir, ServerKeyFileName)
+	dnsNames, ips := serverHosts()
+	if cert, err := readCertificate(certFile); err == nil && isUsable(cert, ca) && covers(cert, dnsNames, ips) {
+		if _, err := os.Stat(keyFile); err == nil {
+			return certFile, keyFile, ExportCA()
+		}
+	}
+
+	tui.LogNormal("Generating self-signed server certificate in '%s'", CacheDir)
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeySize)
+	if err != nil {
+		return "", "", err
+	}
+	template := &x509.Certificate{
+		SerialNumber: newSerialNumber(),
+		Subject:      pkix.Name{Organization: []string{"Corteca"}, CommonName: dnsNames[0]},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(serverValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		DNSNames:     dnsNames,
+		IPAddresses:  ips,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return "", "", err
+	}
+	if err := writePEM(keyFile, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key), 0600); err != nil {
+		return "", "", err
+	}
+	if err := writePEM(certFile, "CERTIFICATE", der, 0644); err != nil {
+		return "", "", err
+	}
+	return certFile, keyFile, ExportCA()
+}
+
+// expose the cached CA certificate (if any) to the template context as `.tls.ca` & `.tls.caFile`
+func ExportCA() error {
+	caFile := filepath.Join(CacheDir, CAFileName)
+	data, err := os.ReadFile(caFile)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	ctx := configuration.GetCmdContext()
+	ctx.TLS.CA = string(data)
+	ctx.TLS.CAFile = caFile
+	return nil
+}
+
+func ensureCA() (*x509.Certificate, *rsa.PrivateKey, error) {
+	caFile := filepath.Join(CacheDir, CAFileName)
+	caKeyFile := filepath.Join(CacheDir, CAKeyFileName)
+	if ca, err := readCertificate(caFile); err == nil && time.Until(ca.NotAfter) > renewBefore {
+		if key, err := readPrivateKey(caKeyFile); err == nil {
+			return ca, key, nil
+		}
+	}
+
+	tui.LogNormal("Generating self-signed CA in '%s'", CacheDir)
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeySize)
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          newSerialNumber(),
+		Subject:               pkix.Name{Organization: []string{"Corteca"}, CommonName: "Corteca Development CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := writePEM(caKeyFile, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key), 0600); err != nil {
+		return nil, nil, err
+	}
+	if err := writePEM(caFile, "CERTIFICATE", der, 0644); err != nil {
+		return nil, nil, err
+	}
+	// any server certificate signed by a previous CA is now stale
+	os.Remove(filepath.Join(CacheDir, ServerCertFileName))
+	ca, err := x509.ParseCertificate(der)
+	return ca, key, err
+}
+
+// names & addresses the server certificate must be valid for
+func serverHosts() ([]string, []net.IP) {
+	host := configuration.GetCmdContext().Host
+	dnsNames := []string{"localhost"}
+	if len(host.Name) > 0 && host.Name != "localhost" {
+		dnsNames = append([]string{host.Name}, dnsNames...)
+	}
+	ips := []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback}
+	if ip := net.ParseIP(host.Addr); ip != nil && !ip.IsLoopback() {
+		ips = append(ips, ip)
+	}
+	return dnsNames, ips
+}
+
+func isUsable(cert, ca *x509.Certificate) bool {
+	return time.Until(cert.NotAfter) > renewBefore && cert.CheckSignatureFrom(ca) == nil
+}
+
+func covers(cert *x509.Certificate, dnsNames []string, ips []net.IP) bool {
+	for _, name := range dnsNames {
+		if !slices.Contains(cert.DNSNames, name) {
+			return false
+		}
+	}
+	for _, ip := range ips {
+		if !slices.ContainsFunc(cert.IPAddresses, ip.Equal) {
+			return false
+		}
+	}
+	return true
+}
+
+func newSerialNumber() *big.Int {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		panic(err)
+	}
+	return serial
+}
+
+func readPEM(path, blockType string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != blockType {
+		return nil, fmt.Errorf("no %s found in '%s'", blockType, path)
+	}
+	return block.Bytes, nil
+}
+
+func readCertificate(path string) (*x509.Certificate, error) {
+	der, err := readPEM(path, "CERTIFICATE")
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+func readPrivateKey(path string) (*rsa.PrivateKey, error) {
+	der, err := readPEM(path, "RSA PRIVATE KEY")
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParsePKCS1PrivateKey(der)
+}
+
+func writePEM(path, blockType string, der []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return pem.Encode(f, &pem.Block{Type: blockType, Bytes: der})
+}
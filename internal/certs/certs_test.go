@@ -0,0 +1,116 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package certs
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupCacheDir(t *testing.T) {
+	configuration.ResetContext()
+	ctx := configuration.GetCmdContext()
+	ctx.Host.Name = "devhost"
+	ctx.Host.Addr = "192.0.2.10"
+	CacheDir = filepath.Join(t.TempDir(), "certs")
+	t.Cleanup(func() {
+		CacheDir = ""
+		configuration.ResetContext()
+	})
+}
+
+func TestEnsureServerCertificate(t *testing.T) {
+	setupCacheDir(t)
+
+	certFile, keyFile, err := EnsureServerCertificate()
+	require.NoError(t, err)
+	_, err = tls.LoadX509KeyPair(certFile, keyFile)
+	require.NoError(t, err)
+
+	cert, err := readCertificate(certFile)
+	require.NoError(t, err)
+	assert.Contains(t, cert.DNSNames, "devhost")
+	assert.Contains(t, cert.DNSNames, "localhost")
+	assert.True(t, slices.ContainsFunc(cert.IPAddresses, net.ParseIP("192.0.2.10").Equal))
+
+	// server certificate verifies against the exported CA
+	ctx := configuration.GetCmdContext()
+	assert.Equal(t, filepath.Join(CacheDir, CAFileName), ctx.TLS.CAFile)
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM([]byte(ctx.TLS.CA)))
+	_, err = cert.Verify(x509.VerifyOptions{DNSName: "devhost", Roots: pool})
+	assert.NoError(t, err)
+
+	info, err := os.Stat(keyFile)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestEnsureServerCertificate_Cached(t *testing.T) {
+	setupCacheDir(t)
+
+	certFile, _, err := EnsureServerCertificate()
+	require.NoError(t, err)
+	first, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+
+	_, _, err = EnsureServerCertificate()
+	require.NoError(t, err)
+	second, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+	assert.Equal(t, first, second, "certificate should be reused")
+
+	// a change of the host address requires a new certificate, signed by the same CA
+	ca, err := os.ReadFile(filepath.Join(CacheDir, CAFileName))
+	require.NoError(t, err)
+	configuration.GetCmdContext().Host.Addr = "192.0.2.20"
+	_, _, err = EnsureServerCertificate()
+	require.NoError(t, err)
+	third, err := os.ReadFile(certFile)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, third)
+	caAfter, err := os.ReadFile(filepath.Join(CacheDir, CAFileName))
+	require.NoError(t, err)
+	assert.Equal(t, ca, caAfter)
+}
+
+func TestResolveServerCertificate(t *testing.T) {
+	setupCacheDir(t)
+
+	certFile, keyFile, err := ResolveServerCertificate("my.pem", "my-key.pem")
+	require.NoError(t, err)
+	assert.Equal(t, "my.pem", certFile)
+	assert.Equal(t, "my-key.pem", keyFile)
+	_, err = os.Stat(CacheDir)
+	assert.True(t, os.IsNotExist(err), "nothing should be generated for explicit certificates")
+
+	// half a pair is a configuration error, rather than silently replaced
+	_, _, err = ResolveServerCertificate("my.pem", "")
+	assert.Error(t, err)
+	_, _, err = ResolveServerCertificate("", "my-key.pem")
+	assert.Error(t, err)
+	_, err = os.Stat(CacheDir)
+	assert.True(t, os.IsNotExist(err), "nothing should be generated for a partial configuration")
+
+	certFile, _, err = ResolveServerCertificate("", "")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(CacheDir, ServerCertFileName), certFile)
+}
+
+func TestExportCA_NoCA(t *testing.T) {
+	setupCacheDir(t)
+
+	assert.NoError(t, ExportCA())
+	assert.Empty(t, configuration.GetCmdContext().TLS.CA)
+}
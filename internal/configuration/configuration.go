@@ -22,6 +22,7 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+	"sync"
 
 	specs "github.com/nokia/corteca-cli/internal/configuration/runtimeSpec"
 	"github.com/nokia/corteca-cli/internal/configuration/templating"
@@ -188,6 +189,11 @@ func (t TemplateField) String() string {
 	return generateExpressions(t.RawTemplate, nil, GetCmdContext())
 }
 
+// whether the field contains expressions, i.e. its value is only known once expanded
+func (t TemplateField) IsTemplate() bool {
+	return regexDollarExpr.MatchString(t.RawTemplate)
+}
+
 type DictType[T any] map[string]T
 
 func (t *DictType[T]) UnmarshalYAML(data *yaml.Node) error {
@@ -211,7 +217,11 @@ func (t *DictType[T]) UnmarshalYAML(data *yaml.Node) error {
 	return nil
 }
 
-var commandContext CmdContext
+var (
+	commandContext CmdContext
+	// guards the fields of the context set by devices, whose sequences may run concurrently (e.g. in tests)
+	deviceContextMutex sync.Mutex
+)
 
 type CmdContext struct {
 	App    *AppSettings `yaml:"app,omitempty"`
@@ -219,6 +229,10 @@ type CmdContext struct {
 	Device struct {
 		DeviceConfig `yaml:",omitempty,inline"`
 		Name         string `yaml:"name,omitempty"`
+		// contents of the latest Inform of a CWMP device
+		Inform map[string]any `yaml:"inform,omitempty"`
+		// file the CPE of a CWMP device asked for with RequestDownload, within the session of the latest Inform
+		RequestDownload map[string]any `yaml:"requestDownload,omitempty"`
 	} `yaml:"device,omitempty"`
 	Publish struct {
 		PublishTarget `yaml:",omitempty,inline"`
@@ -232,6 +246,11 @@ type CmdContext struct {
 		Name string `yaml:"name"`
 		Addr string `yaml:"addr"`
 	} `yaml:"host"`
+	TLS struct {
+		CA     string `yaml:"ca,omitempty"`
+		CAFile string `yaml:"caFile,omitempty"`
+	} `yaml:"tls,omitempty"`
+	Steps map[string]any `yaml:"steps,omitempty"`
 }
 
 func getHostInfo() (string, string) {
@@ -255,6 +274,23 @@ func GetCmdContext() *CmdContext {
 	return &commandContext
 }
 
+// store the result of a sequence step, so that subsequent steps can refer to it as `.steps.<id>`
+func (c *CmdContext) SetStepResult(id string, result any) {
+	if c.Steps == nil {
+		c.Steps = make(map[string]any)
+	}
+	c.Steps[id] = result
+}
+
+// expose the latest Inform of a CWMP device and the download requested within its session (nil if none) to
+// templates, as `.device.inform` and `.device.requestDownload`
+func (c *CmdContext) SetDeviceSession(inform, requestDownload map[string]any) {
+	deviceContextMutex.Lock()
+	defer deviceContextMutex.Unlock()
+	c.Device.Inform = inform
+	c.Device.RequestDownload = requestDownload
+}
+
 func populateEnvVars() {
 	GetCmdContext().Env = make(map[string]string)
 	envVars := os.Environ()
@@ -297,6 +333,8 @@ func evaluateExpressionFunc(visited []string, context any) func(string) string {
 		if err != nil {
 			tui.LogWarning("Warning: could not read field '%s' with error: %s", key, err.Error())
 			return ""
+		} else if value == nil {
+			return ""
 		}
 
 		v := reflect.ValueOf(value)
@@ -472,8 +510,8 @@ func ReadField(conf any, fieldPath string) (any, error) {
 			// edge case: first elem is empty
 			continue
 		}
-		// if field is a pointer and not nil, dereference
-		if field.Kind() == reflect.Ptr {
+		// if field is a pointer (or an interface) and not nil, dereference
+		for field.Kind() == reflect.Ptr || field.Kind() == reflect.Interface {
 			if field.IsNil() {
 				return nil, fmt.Errorf("cannot address nil value with key '%s'", key)
 			} else {
@@ -105,9 +105,11 @@ func (ep *HttpClientEndpoint) NewHttpClient() (*http.Client, error) {
 }
 
 type SSHClientEndpoint struct {
-	Endpoint       `yaml:",inline"`
-	Username       TemplateField `yaml:"username,omitempty"`
-	Password       TemplateField `yaml:"password,omitempty"`
-	Password2      TemplateField `yaml:"password2,omitempty"`
-	PrivateKeyFile TemplateField `yaml:"privateKeyFile,omitempty"`
+	Endpoint             `yaml:",inline"`
+	Username             TemplateField `yaml:"username,omitempty"`
+	Password             TemplateField `yaml:"password,omitempty"`
+	Password2            TemplateField `yaml:"password2,omitempty"`
+	PrivateKeyFile       TemplateField `yaml:"privateKeyFile,omitempty"`
+	PrivateKeyPassphrase TemplateField `yaml:"privateKeyPassphrase,omitempty"`
+	HostKeyPolicy        string        `yaml:"hostKeyPolicy,omitempty"`
 }
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"slices"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -35,14 +36,25 @@ type SequenceMap map[string]Sequence
 type Sequence []SequenceCmd
 
 type SequenceCmd struct {
+	ID            string        `yaml:"id,omitempty"`
 	Cmd           TemplateField `yaml:"cmd"`
 	Delay         time.Duration `yaml:"duration,omitempty"`
 	Timeout       time.Duration `yaml:"timeout,omitempty"`
 	Retries       uint          `yaml:"retries,omitempty"`
 	IgnoreFailure *bool         `yaml:"ignoreFailure,omitempty"`
+	// when not empty, only failures reporting one of these fault codes are retried
+	RetryOnFaults []uint `yaml:"retryOnFaults,omitempty"`
+	// failures reporting any of these fault codes are never retried
+	AbortOnFaults []uint `yaml:"abortOnFaults,omitempty"`
 	raw           *yaml.Node
 }
 
+// FaultCoder is implemented by errors reporting a fault code of the device protocol (e.g. CWMP faults); the
+// retries of a step can be conditioned on it
+type FaultCoder interface {
+	GetFaultCode() uint
+}
+
 func parseDuration(value string, defaultvalue time.Duration) (time.Duration, error) {
 	if len(value) > 0 {
 		return time.ParseDuration(value)
@@ -54,15 +66,19 @@ func parseDuration(value string, defaultvalue time.Duration) (time.Duration, err
 func (cmd *SequenceCmd) UnmarshalYAML(value *yaml.Node) error {
 	cmd.raw = value
 	var proxy struct {
+		ID            string        `yaml:"id"`
 		Cmd           TemplateField `yaml:"cmd"`
 		Delay         string        `yaml:"duration"`
 		Timeout       string        `yaml:"timeout"`
 		Retries       uint          `yaml:"retries"`
 		IgnoreFailure *bool         `yaml:"ignoreFailure"`
+		RetryOnFaults []uint        `yaml:"retryOnFaults"`
+		AbortOnFaults []uint        `yaml:"abortOnFaults"`
 	}
 	if err := value.Decode(&proxy); err != nil {
 		return err
 	}
+	cmd.ID = proxy.ID
 	cmd.Cmd = proxy.Cmd
 	if d, err := parseDuration(proxy.Delay, 0); err != nil {
 		return err
@@ -76,6 +92,8 @@ func (cmd *SequenceCmd) UnmarshalYAML(value *yaml.Node) error {
 	}
 	cmd.Retries = proxy.Retries
 	cmd.IgnoreFailure = proxy.IgnoreFailure
+	cmd.RetryOnFaults = proxy.RetryOnFaults
+	cmd.AbortOnFaults = proxy.AbortOnFaults
 	return nil
 }
 
@@ -126,6 +144,9 @@ func (sm *SequenceMap) executeSequenceSteps(executor CommandExecutor, seqName st
 		if err != nil {
 			return fmt.Errorf("sequence '%s' failed at step %d: %w", seqName, idx+1, err)
 		}
+		if len(step.ID) > 0 {
+			GetCmdContext().SetStepResult(step.ID, res)
+		}
 		// TODO: provide option to suppress output
 		tui.SetOutputColor(tui.CBlue, os.Stdout)
 		enc := yaml.NewEncoder(os.Stdout)
@@ -170,7 +191,10 @@ func executeStep(step *SequenceCmd, executor CommandExecutor) (any, error) {
 				return res, nil
 			} else {
 				tui.LogError("Command failed: %s", err.Error())
-				if attempts > 0 {
+				if attempts > 0 && !step.shouldRetry(err) {
+					tui.LogNormal("Not retrying upon fault code(s) %v", faultCodes(err))
+					return res, err
+				} else if attempts > 0 {
 					tui.LogNormal("Will retry %d more time(s)", attempts)
 				} else {
 					return res, err
@@ -182,3 +206,36 @@ func executeStep(step *SequenceCmd, executor CommandExecutor) (any, error) {
 	}
 	return res, nil
 }
+
+// failures without fault codes are always retried; failures with fault codes are retried unless any of them is
+// listed in AbortOnFaults or, if RetryOnFaults is given, any of them is not listed there
+func (step *SequenceCmd) shouldRetry(err error) bool {
+	for _, code := range faultCodes(err) {
+		if slices.Contains(step.AbortOnFaults, code) {
+			return false
+		}
+		if len(step.RetryOnFaults) > 0 && !slices.Contains(step.RetryOnFaults, code) {
+			return false
+		}
+	}
+	return true
+}
+
+// fault codes of all the errors wrapped or joined in err
+func faultCodes(err error) []uint {
+	var codes []uint
+	if f, ok := err.(FaultCoder); ok {
+		codes = append(codes, f.GetFaultCode())
+	}
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		if inner := e.Unwrap(); inner != nil {
+			codes = append(codes, faultCodes(inner)...)
+		}
+	case interface{ Unwrap() []error }:
+		for _, inner := range e.Unwrap() {
+			codes = append(codes, faultCodes(inner)...)
+		}
+	}
+	return codes
+}
@@ -8,8 +8,12 @@ import (
 	"context"
 	"github.com/nokia/corteca-cli/internal/configuration"
 	"errors"
+	"fmt"
+	"slices"
 	"testing"
 	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 // boolPtr returns a pointer to b, a convenience helper for SequenceCmd.IgnoreFailure.
@@ -216,6 +220,83 @@ func TestExecute_Retries_SucceedsOnRetry(t *testing.T) {
 	}
 }
 
+// ---- Fault code retry policy tests ------------------------------------------------
+
+// faultError is an error reporting a protocol fault code.
+type faultError uint
+
+func (f faultError) Error() string      { return fmt.Sprintf("fault %d", uint(f)) }
+func (f faultError) GetFaultCode() uint { return uint(f) }
+
+// TestExecute_RetryOnFaults verifies that only faults listed in RetryOnFaults are retried,
+// while errors without a fault code are retried as usual.
+func TestExecute_RetryOnFaults(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantCalls int
+	}{
+		{name: "listed fault", err: faultError(9002), wantCalls: 3},
+		{name: "wrapped listed fault", err: fmt.Errorf("rpc failed: %w", faultError(9002)), wantCalls: 3},
+		{name: "unlisted fault", err: faultError(9005), wantCalls: 1},
+		{name: "joined faults", err: errors.Join(faultError(9002), faultError(9005)), wantCalls: 1},
+		{name: "no fault code", err: errors.New("timeout"), wantCalls: 3},
+	}
+	for _, tt := range tests {
+		cmd := configuration.SequenceCmd{
+			Cmd:           configuration.T("cmd"),
+			Retries:       2,
+			RetryOnFaults: []uint{9002},
+		}
+		sm := configuration.SequenceMap{"seq": {cmd}}
+		exec := &mockExecutor{executeFunc: alwaysFails(tt.err)}
+		if err := sm.Execute(exec, "seq"); err == nil {
+			t.Fatalf("%s: expected error, got nil", tt.name)
+		}
+		if exec.callCount != tt.wantCalls {
+			t.Errorf("%s: ExecuteCommand: expected %d calls, got %d", tt.name, tt.wantCalls, exec.callCount)
+		}
+	}
+}
+
+// TestExecute_AbortOnFaults verifies that a fault listed in AbortOnFaults stops the retries at once,
+// while other faults are retried.
+func TestExecute_AbortOnFaults(t *testing.T) {
+	cmd := configuration.SequenceCmd{
+		Cmd:           configuration.T("cmd"),
+		Retries:       2,
+		AbortOnFaults: []uint{9005},
+	}
+	sm := configuration.SequenceMap{"seq": {cmd}}
+	exec := &mockExecutor{executeFunc: alwaysFails(faultError(9005))}
+	if err := sm.Execute(exec, "seq"); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if exec.callCount != 1 {
+		t.Errorf("ExecuteCommand: expected 1 call, got %d", exec.callCount)
+	}
+
+	exec = &mockExecutor{executeFunc: succeedsAfter(2, faultError(9002))}
+	if err := sm.Execute(exec, "seq"); err != nil {
+		t.Fatalf("expected success after retries, got: %v", err)
+	}
+	if exec.callCount != 3 {
+		t.Errorf("ExecuteCommand: expected 3 calls, got %d", exec.callCount)
+	}
+}
+
+// TestSequenceCmd_UnmarshalFaultPolicy verifies that the fault code lists are read from YAML.
+func TestSequenceCmd_UnmarshalFaultPolicy(t *testing.T) {
+	var cmd configuration.SequenceCmd
+	input := "cmd: SetParameterValues\nretries: 3\nretryOnFaults: [9002, 9004]\nabortOnFaults: [9005]\n"
+	if err := yaml.Unmarshal([]byte(input), &cmd); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(cmd.RetryOnFaults, []uint{9002, 9004}) || !slices.Equal(cmd.AbortOnFaults, []uint{9005}) {
+		t.Errorf("unexpected fault policy: retryOnFaults %v, abortOnFaults %v", cmd.RetryOnFaults, cmd.AbortOnFaults)
+	}
+}
+
 // ---- IgnoreFailure tests ---------------------------------------------------------
 
 // TestExecute_IgnoreFailure_SkipsRetries verifies that when IgnoreFailure=true the command
@@ -418,3 +499,41 @@ func TestExecute_DefaultTimeout_UsedWhenUnset(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+// ---- Step result tests -----------------------------------------------------------
+
+// TestExecute_StepResult_StoredInContext verifies that the result of a step with an id is
+// stored in the command context and can be referenced by subsequent steps.
+func TestExecute_StepResult_StoredInContext(t *testing.T) {
+	configuration.ResetContext()
+	defer configuration.ResetContext()
+
+	type result struct {
+		InstanceNumber uint `yaml:"InstanceNumber"`
+	}
+	first := simpleStep("add", false)
+	first.ID = "rule"
+	second := simpleStep("use ${.steps.rule.InstanceNumber}", false)
+	sm := configuration.SequenceMap{"seq": {first, second}}
+
+	var rendered string
+	exec := &mockExecutor{
+		executeFunc: func(callIdx int, _ context.Context, cmd *configuration.SequenceCmd) (any, error) {
+			if callIdx == 0 {
+				return result{InstanceNumber: 7}, nil
+			}
+			rendered = cmd.Cmd.String()
+			return nil, nil
+		},
+	}
+
+	if err := sm.Execute(exec, "seq"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rendered != "use 7" {
+		t.Errorf("expected second step to render 'use 7', got '%s'", rendered)
+	}
+	if _, found := configuration.GetCmdContext().Steps["rule"]; !found {
+		t.Error("expected result of step 'rule' to be stored in context")
+	}
+}
@@ -0,0 +1,466 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"bytes"
+	"context"
+	"github.com/nokia/corteca-cli/internal/certs"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	RejectUnknownDevices = "reject"
+	ParkUnknownDevices   = "park"
+
+	DefaultParkTimeout = 30 * time.Second
+	acsSessionCookie   = "corteca-cwmp-session"
+)
+
+// request context key of the ACS session a request was routed to
+type acsSessionKey struct{}
+
+// ACS listeners, shared between all CWMP devices bound to the same address
+var (
+	acsServersMutex sync.Mutex
+	acsServers      = make(map[string]*acsServer)
+)
+
+// an ACS listener serving any number of CPEs; incoming sessions are routed to the CWMPDevice claiming
+// the CPE identity reported in the Inform (and subsequently by session cookie or remote address)
+type acsServer struct {
+	key            string
+	settings       serverSettings
+	server         *http.Server
+	url            *url.URL
+	unknownDevices string
+	parkTimeout    time.Duration
+
+	mu       sync.Mutex
+	refs     int
+	devices  []*CWMPDevice
+	sessions map[string]*acsSession
+	changed  chan struct{}
+}
+
+// a CWMP session in progress, routed to its device by cookie or (for CPEs ignoring cookies) remote address
+type acsSession struct {
+	device *CWMPDevice
+	addr   string
+	expiry time.Time
+}
+
+// obtain the ACS listener for the given configuration, starting it if not already running
+func acquireACSServer(config *CWMPServerConfig) (*acsServer, error) {
+	// TODO: if no url or server is empty we should assume listen on http://0.0.0.0:DefaultCWMPPort
+	u, err := url.Parse(config.Addr.String())
+	if err != nil {
+		return nil, err
+	}
+	if u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Host, strconv.Itoa(DefaultCWMPPort))
+	}
+
+	acsServersMutex.Lock()
+	defer acsServersMutex.Unlock()
+	if s, found := acsServers[u.Host]; found {
+		// the listener (with its authentication & policies) is shared; its devices cannot configure it differently
+		if diff := s.settings.diff(newServerSettings(config, u)); len(diff) > 0 {
+			return nil, fmt.Errorf("CWMP server %s is already in use with different settings (%s)", u.Host, strings.Join(diff, ", "))
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.refs++
+		return s, nil
+	}
+
+	s, err := newACSServer(config, u)
+	if err != nil {
+		return nil, err
+	}
+	acsServers[u.Host] = s
+	return s, nil
+}
+
+func newACSServer(config *CWMPServerConfig, u *url.URL) (*acsServer, error) {
+	s := &acsServer{
+		key:            u.Host,
+		settings:       newServerSettings(config, u),
+		unknownDevices: strings.ToLower(config.UnknownDevices),
+		parkTimeout:    config.ParkTimeout,
+		refs:           1,
+		sessions:       make(map[string]*acsSession),
+		changed:        make(chan struct{}),
+	}
+	switch s.unknownDevices {
+	case "":
+		s.unknownDevices = RejectUnknownDevices
+	case RejectUnknownDevices, ParkUnknownDevices:
+	default:
+		return nil, fmt.Errorf("unknown policy for unknown devices '%s'", config.UnknownDevices)
+	}
+	if s.parkTimeout == 0 {
+		s.parkTimeout = DefaultParkTimeout
+	}
+
+	auth, err := newServerAuth(config)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	if auth != nil {
+		mux.Handle("/", auth.wrap(http.HandlerFunc(s.handleHTTPRequest)))
+	} else {
+		mux.HandleFunc("/", s.handleHTTPRequest)
+	}
+	mux.HandleFunc(artifactsPath, s.handleArtifactRequest)
+	s.server = &http.Server{
+		Addr:    u.Host,
+		Handler: mux,
+	}
+	if auth != nil {
+		s.server.ConnContext = auth.connContext
+	}
+
+	var certFile, keyFile string
+	if u.Scheme == "https" {
+		// fall back to a generated self-signed certificate
+		if certFile, keyFile, err = certs.ResolveServerCertificate(config.Certificate.String(), config.Key.String()); err != nil {
+			return nil, err
+		}
+	}
+
+	listener, err := net.Listen("tcp", u.Host)
+	if err != nil {
+		return nil, err
+	}
+	// the actual address is only known once bound (e.g. for port 0)
+	s.server.Addr = listener.Addr().String()
+	bound := *u
+	bound.Host = s.server.Addr
+	s.url = connectableURL(&bound)
+	tui.DisplaySuccessMsg(fmt.Sprintf("Starting CWMP server on %s...", s.server.Addr))
+	// Run server in a goroutine
+	go func() {
+		var err error
+		if u.Scheme == "https" {
+			err = s.server.ServeTLS(listener, certFile, keyFile)
+		} else {
+			err = s.server.Serve(listener)
+		}
+		if err != nil && err != http.ErrServerClosed {
+			tui.LogError("CWMP server failed: %s", err)
+		}
+	}()
+	return s, nil
+}
+
+// the (rendered) server configuration of a listener
+type serverSettings struct {
+	scheme         string
+	certificate    string
+	key            string
+	auth           string
+	username       string
+	password       string
+	realm          string
+	unknownDevices string
+	parkTimeout    time.Duration
+}
+
+func newServerSettings(config *CWMPServerConfig, u *url.URL) serverSettings {
+	return serverSettings{
+		scheme:         u.Scheme,
+		certificate:    config.Certificate.String(),
+		key:            config.Key.String(),
+		auth:           strings.ToLower(config.Auth),
+		username:       config.Username.String(),
+		password:       config.Password.String(),
+		realm:          config.Realm,
+		unknownDevices: strings.ToLower(config.UnknownDevices),
+		parkTimeout:    config.ParkTimeout,
+	}
+}
+
+// the names of the settings that differ
+func (s serverSettings) diff(other serverSettings) []string {
+	var names []string
+	for _, field := range []struct {
+		name  string
+		equal bool
+	}{
+		{"scheme", s.scheme == other.scheme},
+		{"certificate", s.certificate == other.certificate},
+		{"key", s.key == other.key},
+		{"auth", s.auth == other.auth},
+		{"username", s.username == other.username},
+		{"password", s.password == other.password},
+		{"realm", s.realm == other.realm},
+		{"unknownDevices", s.unknownDevices == other.unknownDevices},
+		{"parkTimeout", s.parkTimeout == other.parkTimeout},
+	} {
+		if !field.equal {
+			names = append(names, field.name)
+		}
+	}
+	return names
+}
+
+// drop a reference to the listener; the last one shuts it down
+func (s *acsServer) release(d *CWMPDevice) {
+	acsServersMutex.Lock()
+	defer acsServersMutex.Unlock()
+	s.mu.Lock()
+	s.unregisterLocked(d)
+	s.refs--
+	refs := s.refs
+	s.mu.Unlock()
+	if refs > 0 {
+		return
+	}
+
+	delete(acsServers, s.key)
+	// Graceful shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		tui.LogError("Server Shutdown Failed: %s", err)
+		return
+	}
+	tui.LogNormal("Server stopped gracefully!")
+}
+
+func (s *acsServer) register(d *CWMPDevice) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.devices = append(s.devices, d)
+	s.notifyLocked()
+}
+
+func (s *acsServer) unregisterLocked(d *CWMPDevice) {
+	for i, dev := range s.devices {
+		if dev == d {
+			s.devices = append(s.devices[:i], s.devices[i+1:]...)
+			break
+		}
+	}
+	s.dropSessionsLocked(d)
+	s.notifyLocked()
+}
+
+// wake up parked requests, so that they re-evaluate whether a device claims them
+func (s *acsServer) notifyLocked() {
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// find the device that owns (or claims) the CPE with the given identity
+func (s *acsServer) claim(id messages.DeviceIDStruct) (*CWMPDevice, <-chan struct{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// prefer the device already bound to this CPE
+	for _, d := range s.devices {
+		if d.cpe != nil && *d.cpe == id {
+			return d, nil
+		}
+	}
+	for _, d := range s.devices {
+		if d.cpe == nil && d.filter.Matches(id) {
+			d.cpe = &id
+			tui.LogNormal("CPE '%s' connected", id.String())
+			return d, nil
+		}
+	}
+	return nil, s.changed
+}
+
+// start a new session for the device, replacing any previous one (a CPE has a single session at a time);
+// the session is tracked by cookie and, for CPEs that ignore cookies, by remote address
+func (s *acsServer) startSession(w http.ResponseWriter, r *http.Request, d *CWMPDevice) string {
+	id := randomHex(16)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.dropSessionsLocked(d)
+	// drop expired sessions
+	for sid, session := range s.sessions {
+		if time.Now().After(session.expiry) {
+			delete(s.sessions, sid)
+		}
+	}
+	s.sessions[id] = &acsSession{device: d, addr: remoteHost(r), expiry: time.Now().Add(sessionLifetime)}
+	http.SetCookie(w, &http.Cookie{
+		Name:     acsSessionCookie,
+		Value:    id,
+		Path:     "/",
+		MaxAge:   int(sessionLifetime.Seconds()),
+		HttpOnly: true,
+	})
+	return id
+}
+
+// find the session of the request, extending its lifetime; the remote address is only used when the request
+// carries no cookie and no other session shares that address (e.g. CPEs behind the same NAT)
+func (s *acsServer) findSessionLocked(r *http.Request) (string, *acsSession) {
+	var id string
+	var found *acsSession
+	if cookie, err := r.Cookie(acsSessionCookie); err == nil {
+		id, found = cookie.Value, s.sessions[cookie.Value]
+	} else {
+		addr := remoteHost(r)
+		for sid, session := range s.sessions {
+			if session.addr != addr {
+				continue
+			}
+			if found != nil {
+				return "", nil
+			}
+			id, found = sid, session
+		}
+	}
+	if found == nil {
+		return "", nil
+	}
+	if time.Now().After(found.expiry) {
+		delete(s.sessions, id)
+		return "", nil
+	}
+	found.expiry = time.Now().Add(sessionLifetime)
+	return id, found
+}
+
+func (s *acsServer) lookupSession(r *http.Request) (string, *CWMPDevice) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id, session := s.findSessionLocked(r); session != nil {
+		return id, session.device
+	}
+	return "", nil
+}
+
+// end the session the request was routed to, along with its cookie; to be called before the empty response
+// of the ACS
+func (s *acsServer) endSession(w http.ResponseWriter, r *http.Request) {
+	id, _ := r.Context().Value(acsSessionKey{}).(string)
+	s.mu.Lock()
+	delete(s.sessions, id)
+	s.mu.Unlock()
+	http.SetCookie(w, &http.Cookie{Name: acsSessionCookie, Path: "/", MaxAge: -1, HttpOnly: true})
+}
+
+func (s *acsServer) dropSessionsLocked(d *CWMPDevice) {
+	for id, session := range s.sessions {
+		if session.device == d {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+// determine the device & session responsible for the request; writes an error response & returns nil if there
+// is none
+func (s *acsServer) route(w http.ResponseWriter, r *http.Request, env *messages.Envelope) (*CWMPDevice, string) {
+	inform := findInform(env)
+	if inform == nil {
+		if id, d := s.lookupSession(r); d != nil {
+			return d, id
+		}
+		tui.LogWarning("Request from %s outside of a CWMP session; rejecting", r.RemoteAddr)
+		http.Error(w, "Forbidden; no CWMP session", http.StatusForbidden)
+		return nil, ""
+	}
+
+	id := inform.DeviceId
+	ctx, cancel := context.WithTimeout(r.Context(), s.parkTimeout)
+	defer cancel()
+	for {
+		d, changed := s.claim(id)
+		if d != nil {
+			return d, s.startSession(w, r, d)
+		}
+		if s.unknownDevices == RejectUnknownDevices {
+			tui.LogWarning("Rejecting session of unknown CPE '%s' (%s)", id.String(), r.RemoteAddr)
+			http.Error(w, "Forbidden; unknown device", http.StatusForbidden)
+			return nil, ""
+		}
+		// park the request until a device claims the CPE
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			tui.LogWarning("No device claimed CPE '%s' (%s); rejecting", id.String(), r.RemoteAddr)
+			w.Header().Set("Retry-After", strconv.Itoa(int(s.parkTimeout.Seconds())))
+			http.Error(w, "Service unavailable; unknown device", http.StatusServiceUnavailable)
+			return nil, ""
+		}
+	}
+}
+
+func (s *acsServer) handleHTTPRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Bad request: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+	// an empty post has no envelope
+	env, err := messages.ParseEnvelopeXML(bytes.NewReader(body))
+	if errors.Is(err, io.EOF) {
+		env = nil
+	} else if err != nil {
+		tui.LogError("Malformed request received: %s", err.Error())
+		http.Error(w, fmt.Sprintf("Bad request: %s", err.Error()), http.StatusBadRequest)
+		return
+	} else if len(env.Body.Messages) == 0 {
+		tui.LogError("No message received")
+		http.Error(w, "Bad request; no message received", http.StatusBadRequest)
+		return
+	}
+
+	if d, id := s.route(w, r, env); d != nil {
+		r = r.WithContext(context.WithValue(r.Context(), acsSessionKey{}, id))
+		d.handleSessionRequest(w, r, body, env)
+	}
+}
+
+func (s *acsServer) handleArtifactRequest(w http.ResponseWriter, r *http.Request) {
+	if _, d := s.lookupSession(r); d != nil {
+		d.handleArtifactRequest(w, r)
+	} else {
+		serveArtifactFile(w, r)
+	}
+}
+
+func findInform(env *messages.Envelope) *messages.Inform {
+	if env == nil {
+		return nil
+	}
+	for _, msg := range env.Body.Messages {
+		if inform, ok := msg.(messages.Inform); ok {
+			return &inform
+		}
+	}
+	return nil
+}
+
+func remoteHost(r *http.Request) string {
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
@@ -0,0 +1,590 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"bytes"
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// a minimal CPE, opening sessions towards the ACS listener
+type testCPE struct {
+	id     messages.DeviceIDStruct
+	client *http.Client
+}
+
+func newTestCPE(t *testing.T, serial string) *testCPE {
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	return &testCPE{
+		id:     messages.DeviceIDStruct{OUI: "ABCDEF", ProductClass: "Router", SerialNumber: serial},
+		client: &http.Client{Jar: jar},
+	}
+}
+
+func (c *testCPE) post(t *testing.T, url string, env *messages.Envelope) (*http.Response, []byte) {
+	resp, data, err := c.send(url, env)
+	require.NoError(t, err)
+	return resp, data
+}
+
+// post without failing the test; for use outside of the test goroutine
+func (c *testCPE) send(url string, env *messages.Envelope) (*http.Response, []byte, error) {
+	var body []byte
+	if env != nil {
+		var err error
+		if body, err = xml.Marshal(env); err != nil {
+			return nil, nil, err
+		}
+	}
+	resp, err := c.client.Post(url, "text/xml", bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	return resp, data, err
+}
+
+func (c *testCPE) inform(t *testing.T, url string) (*http.Response, []byte) {
+	return c.informEvent(t, url, messages.EventConnectionRequest)
+}
+
+func (c *testCPE) informEvent(t *testing.T, url string, eventCode string) (*http.Response, []byte) {
+	env := c.informEnvelope(eventCode)
+	return c.post(t, url, &env)
+}
+
+func (c *testCPE) informEnvelope(eventCode string) messages.Envelope {
+	return messages.NewEnvelope("1", messages.Inform{
+		DeviceId: c.id,
+		Event:    messages.EventList{Events: []messages.EventStruct{{EventCode: eventCode}}},
+	})
+}
+
+func newTestServerConfig(config CWMPServerConfig) *CWMPServerConfig {
+	config.Addr = configuration.T("http://127.0.0.1:0")
+	return &config
+}
+
+// create a device on the (shared) listener, the same way NewCWMPDevice does
+func newTestDevice(t *testing.T, config *CWMPServerConfig, filter DeviceFilter) *CWMPDevice {
+	s, err := acquireACSServer(config)
+	require.NoError(t, err)
+	d := &CWMPDevice{
+		acs:            s,
+		filter:         filter,
+		in:             make(chan *cpePost),
+		log:            io.Discard,
+		requestTimeout: DefaultRequestTimeout,
+		closed:         make(chan struct{}),
+	}
+	s.register(d)
+	t.Cleanup(d.Close)
+	return d
+}
+
+// run a complete (empty) CWMP session of the CPE against the device
+func runSession(t *testing.T, d *CWMPDevice, cpe *testCPE) {
+	done := make(chan error)
+	go func() {
+		if err := d.BeginSequence(); err != nil {
+			done <- err
+			return
+		}
+		done <- d.EndSequence()
+	}()
+
+	resp, body := cpe.inform(t, d.acs.url.String())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "InformResponse")
+	resp, body = cpe.post(t, d.acs.url.String(), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, body)
+	require.NoError(t, <-done)
+}
+
+func TestACSRoutesSessionsByDeviceId(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	devA := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("A")})
+	devB := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("B")})
+	cpeA := newTestCPE(t, "A")
+	cpeB := newTestCPE(t, "B")
+
+	runSession(t, devB, cpeB)
+	runSession(t, devA, cpeA)
+	assert.Equal(t, cpeA.id, *devA.cpe)
+	assert.Equal(t, cpeB.id, *devB.cpe)
+}
+
+func TestACSConcurrentSessions(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	devA := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("A")})
+	devB := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("B")})
+
+	t.Run("A", func(t *testing.T) {
+		t.Parallel()
+		runSession(t, devA, newTestCPE(t, "A"))
+	})
+	t.Run("B", func(t *testing.T) {
+		t.Parallel()
+		runSession(t, devB, newTestCPE(t, "B"))
+	})
+}
+
+func TestACSRejectsUnknownDevice(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("A")})
+
+	resp, _ := newTestCPE(t, "X").inform(t, dev.acs.url.String())
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// a post outside of any session is rejected as well
+	resp, _ = newTestCPE(t, "X").post(t, dev.acs.url.String(), nil)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestACSFirstDeviceWithoutFilterClaimsCPE(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{})
+	cpe := newTestCPE(t, "A")
+
+	runSession(t, dev, cpe)
+	assert.Equal(t, cpe.id, *dev.cpe)
+
+	// a stray Inform of another CPE does not hijack the device
+	resp, _ := newTestCPE(t, "B").inform(t, dev.acs.url.String())
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// while the claimed CPE can start new sessions
+	runSession(t, dev, cpe)
+}
+
+func TestACSParksUnknownDevice(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{UnknownDevices: ParkUnknownDevices, ParkTimeout: 5 * time.Second})
+	dev := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("A")})
+	cpe := newTestCPE(t, "B")
+
+	// the device claiming the CPE is only created while its Inform is parked
+	var resp *http.Response
+	var body []byte
+	informed := make(chan error)
+	go func() {
+		env := cpe.informEnvelope(messages.EventConnectionRequest)
+		var err error
+		resp, body, err = cpe.send(dev.acs.url.String(), &env)
+		informed <- err
+	}()
+	time.Sleep(100 * time.Millisecond)
+	d := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("B")})
+	served := make(chan struct{})
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		d.expectRPC(ctx, func(m messages.Message) bool { return m == nil })
+		d.pushEnvelope(nil)
+		close(served)
+	}()
+
+	require.NoError(t, <-informed)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "InformResponse")
+	resp, _ = cpe.post(t, dev.acs.url.String(), nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	<-served
+	assert.Equal(t, cpe.id, *d.cpe)
+}
+
+func TestACSEndsSessionWithEmptyResponse(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("A")})
+	cpe := newTestCPE(t, "A")
+
+	runSession(t, dev, cpe)
+	assert.Empty(t, dev.acs.sessions)
+	assert.Empty(t, cpe.client.Jar.Cookies(dev.acs.url))
+
+	// a new session of the CPE replaces its previous one
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	dev.acs.startSession(httptest.NewRecorder(), req, dev)
+	dev.acs.startSession(httptest.NewRecorder(), req, dev)
+	assert.Len(t, dev.acs.sessions, 1)
+}
+
+func TestACSRoutesByAddressOnlyWhenUnambiguous(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	devA := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("A")})
+	devB := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("B")})
+	s := devA.acs
+
+	// CPEs behind the same address
+	reqA := httptest.NewRequest(http.MethodPost, "/", nil)
+	reqA.RemoteAddr = "10.0.0.1:1000"
+	reqB := httptest.NewRequest(http.MethodPost, "/", nil)
+	reqB.RemoteAddr = "10.0.0.1:2000"
+
+	rec := httptest.NewRecorder()
+	s.startSession(rec, reqA, devA)
+	cookie := rec.Result().Cookies()[0]
+	assert.Positive(t, cookie.MaxAge)
+	_, d := s.lookupSession(reqA)
+	assert.Same(t, devA, d)
+
+	// once another session shares the address, only the cookie identifies the session
+	s.startSession(httptest.NewRecorder(), reqB, devB)
+	_, d = s.lookupSession(reqA)
+	assert.Nil(t, d)
+	withCookie := httptest.NewRequest(http.MethodPost, "/", nil)
+	withCookie.RemoteAddr = reqB.RemoteAddr
+	withCookie.AddCookie(cookie)
+	_, d = s.lookupSession(withCookie)
+	assert.Same(t, devA, d)
+
+	// an unknown cookie is never routed by address
+	stale := httptest.NewRequest(http.MethodPost, "/", nil)
+	stale.RemoteAddr = "10.0.0.2:1000"
+	s.startSession(httptest.NewRecorder(), stale, devB)
+	stale.AddCookie(&http.Cookie{Name: acsSessionCookie, Value: "stale"})
+	_, d = s.lookupSession(stale)
+	assert.Nil(t, d)
+}
+
+func TestACSParkTimeout(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{UnknownDevices: ParkUnknownDevices, ParkTimeout: 100 * time.Millisecond})
+	dev := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("A")})
+
+	resp, _ := newTestCPE(t, "B").inform(t, dev.acs.url.String())
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.NotEmpty(t, resp.Header.Get("Retry-After"))
+}
+
+func TestACSSharedListenerSettings(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{Username: configuration.T("cpe"), Password: configuration.T("secret")})
+	dev := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("A")})
+
+	// devices with the same settings share the listener
+	same := *config
+	s, err := acquireACSServer(&same)
+	require.NoError(t, err)
+	assert.Same(t, dev.acs, s)
+	s.release(nil)
+
+	// while differing ones cannot silently reuse it
+	other := CWMPServerConfig{Username: configuration.T("cpe"), Password: configuration.T("other"), ParkTimeout: time.Second}
+	other.Addr = config.Addr
+	_, err = acquireACSServer(&other)
+	assert.ErrorContains(t, err, "different settings (password, parkTimeout)")
+}
+
+func TestACSInvalidUnknownDevicesPolicy(t *testing.T) {
+	_, err := acquireACSServer(newTestServerConfig(CWMPServerConfig{UnknownDevices: "ignore"}))
+	assert.Error(t, err)
+}
+
+func TestPassiveDeviceWaitsForPeriodicInform(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{SerialNumber: configuration.T("A")})
+	dev.passive = true
+	dev.passiveTimeout = 5 * time.Second
+	cpe := newTestCPE(t, "A")
+
+	done := make(chan error)
+	go func() {
+		if err := dev.BeginSequence(); err != nil {
+			done <- err
+			return
+		}
+		done <- dev.EndSequence()
+	}()
+
+	// a session opened for another event is answered and closed
+	resp, body := cpe.informEvent(t, dev.acs.url.String(), messages.EventValueChange)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "InformResponse")
+	resp, body = cpe.post(t, dev.acs.url.String(), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, body)
+	select {
+	case err := <-done:
+		t.Fatalf("sequence started on a VALUE CHANGE Inform: %v", err)
+	default:
+	}
+
+	resp, body = cpe.informEvent(t, dev.acs.url.String(), messages.EventPeriodic)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "InformResponse")
+	resp, _ = cpe.post(t, dev.acs.url.String(), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.NoError(t, <-done)
+}
+
+func TestPassiveDeviceTimeout(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{})
+	dev.passive = true
+	dev.passiveTimeout = 100 * time.Millisecond
+
+	err := dev.BeginSequence()
+	assert.ErrorContains(t, err, "no periodic or boot Inform received")
+}
+
+func TestACSAnswersCPERequests(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{})
+	cpe := newTestCPE(t, "A")
+
+	done := make(chan error)
+	go func() {
+		if err := dev.BeginSequence(); err != nil {
+			done <- err
+			return
+		}
+		done <- dev.EndSequence()
+	}()
+
+	resp, body := cpe.inform(t, dev.acs.url.String())
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "InformResponse")
+
+	// requests of the CPE within its session are all answered, none with a fault
+	env := messages.NewEnvelope("2",
+		messages.GetRPCMethods{},
+		messages.TransferComplete{CommandKey: "fw"},
+		messages.AutonomousTransferComplete{TransferURL: "http://example.com/fw.bin", IsDownload: true},
+		messages.AutonomousDUStateChangeComplete{Results: []messages.AutonOpResultStruct{{UUID: "1234", OperationPerformed: "Install"}}},
+		messages.RequestDownload{FileType: "2 Web Content", FileTypeArg: messages.FileTypeArgStruct{Args: []messages.ArgStruct{{Name: "Version", Value: "2.0"}}}},
+	)
+	resp, body = cpe.post(t, dev.acs.url.String(), &env)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	reply, err := messages.ParseEnvelopeXML(bytes.NewReader(body))
+	require.NoError(t, err)
+	var names []string
+	for _, msg := range reply.Body.Messages {
+		names = append(names, msg.GetName())
+	}
+	assert.Equal(t, []string{
+		"GetRPCMethodsResponse",
+		"TransferCompleteResponse",
+		"AutonomousTransferCompleteResponse",
+		"AutonomousDUStateChangeCompleteResponse",
+		"RequestDownloadResponse",
+	}, names)
+	methods := reply.Body.Messages[0].(messages.GetRPCMethodsResponse).MethodList.Methods
+	assert.Contains(t, methods, "AutonomousDUStateChangeComplete")
+	assert.Contains(t, methods, "RequestDownload")
+
+	resp, body = cpe.post(t, dev.acs.url.String(), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, body)
+	require.NoError(t, <-done)
+
+	// the requested download is left to the following steps
+	assert.Equal(t, "2 Web Content", configuration.T("${ .device.requestDownload.FileType }").String())
+	assert.Equal(t, "2.0", configuration.T("${ .device.requestDownload.FileTypeArg.Version }").String())
+}
+
+func TestACSSeparatesRepliesByID(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{})
+	cpe := newTestCPE(t, "A")
+	url := dev.acs.url.String()
+
+	done := make(chan error, 1)
+	go func() {
+		if err := dev.BeginSequence(); err != nil {
+			done <- err
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		for _, step := range []string{"cmd: GetRPCMethods", "cmd: GetRPCMethods"} {
+			if _, err := dev.ExecuteCommand(ctx, newTestStep(t, step)); err != nil {
+				done <- err
+				return
+			}
+		}
+		done <- dev.EndSequence()
+	}()
+	resp, _ := cpe.inform(t, url)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	_, body := cpe.post(t, url, nil)
+	first, err := messages.ParseEnvelopeXML(bytes.NewReader(body))
+	require.NoError(t, err)
+	assert.True(t, first.GetHoldRequests())
+
+	// a CPE request along with the response: the next ACS request does not share the envelope (and cwmp:ID) of
+	// the answer to the CPE request, but is sent upon the next empty post
+	env := messages.NewEnvelope(first.GetID(), messages.TransferComplete{CommandKey: "fw"}, messages.GetRPCMethodsResponse{})
+	env.Header.NoMoreRequests = &messages.BoolHeaderStruct{Value: true}
+	resp, body = cpe.post(t, url, &env)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	reply, err := messages.ParseEnvelopeXML(bytes.NewReader(body))
+	require.NoError(t, err)
+	require.Len(t, reply.Body.Messages, 1)
+	assert.Equal(t, "TransferCompleteResponse", reply.Body.Messages[0].GetName())
+	assert.Equal(t, first.GetID(), reply.GetID())
+
+	resp, body = cpe.post(t, url, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	second, err := messages.ParseEnvelopeXML(bytes.NewReader(body))
+	require.NoError(t, err)
+	require.Len(t, second.Body.Messages, 1)
+	assert.Equal(t, "GetRPCMethods", second.Body.Messages[0].GetName())
+	assert.NotEqual(t, first.GetID(), second.GetID())
+	// no need to hold requests the CPE no longer sends
+	assert.False(t, second.GetHoldRequests())
+
+	resp, body = cpe.post(t, url, respondTo(t, body, "", messages.GetRPCMethodsResponse{}))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, body)
+	require.NoError(t, <-done)
+}
+
+// start a sequence of a single step on the device; the step is only executed once proceed is closed
+func runStepAfter(t *testing.T, d *CWMPDevice, step string, proceed <-chan struct{}) (<-chan error, <-chan error) {
+	began := make(chan error, 1)
+	done := make(chan error, 1)
+	go func() {
+		if err := d.BeginSequence(); err != nil {
+			began <- err
+			return
+		}
+		began <- nil
+		<-proceed
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := d.ExecuteCommand(ctx, newTestStep(t, step)); err != nil {
+			done <- err
+			return
+		}
+		done <- d.EndSequence()
+	}()
+	return began, done
+}
+
+// respond to the ACS request in body with the given cwmp:ID (the one of the request if empty)
+func respondTo(t *testing.T, body []byte, id string, resp messages.Message) *messages.Envelope {
+	request, err := messages.ParseEnvelopeXML(bytes.NewReader(body))
+	require.NoError(t, err)
+	if len(id) == 0 {
+		id = request.GetID()
+	}
+	env := messages.NewEnvelope(id, resp)
+	return &env
+}
+
+func TestACSRequestTimeout(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{})
+	dev.requestTimeout = 100 * time.Millisecond
+	cpe := newTestCPE(t, "A")
+
+	// no sequence is running; the session is ended instead of blocking forever
+	resp, body := cpe.inform(t, dev.acs.url.String())
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Empty(t, body)
+	assert.Equal(t, sessionClosed, dev.sessionState())
+
+	// the empty response ended the session, so further posts are no longer routed to the device
+	resp, _ = cpe.post(t, dev.acs.url.String(), nil)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestACSCPEDisconnectMidSession(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{})
+	cpe := newTestCPE(t, "A")
+	url := dev.acs.url.String()
+
+	proceed := make(chan struct{})
+	began, done := runStepAfter(t, dev, "cmd: GetRPCMethods", proceed)
+	resp, _ := cpe.inform(t, url)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// the CPE hands over control, then drops the connection while the sequence is busy
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	require.NoError(t, err)
+	posted := make(chan error)
+	go func() {
+		_, err := cpe.client.Do(req)
+		posted <- err
+	}()
+	require.NoError(t, <-began)
+	cancel()
+	assert.Error(t, <-posted)
+	require.Eventually(t, func() bool { return dev.sessionState() == sessionClosed }, 5*time.Second, 10*time.Millisecond)
+
+	// the step waits for the CPE to open a new session
+	close(proceed)
+	resp, body := cpe.inform(t, url)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "InformResponse")
+	resp, body = cpe.post(t, url, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "GetRPCMethods")
+	resp, body = cpe.post(t, url, respondTo(t, body, "", messages.GetRPCMethodsResponse{}))
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, body)
+	require.NoError(t, <-done)
+	assert.Equal(t, sessionClosed, dev.sessionState())
+}
+
+func TestACSResponseIDMismatch(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{})
+	dev.requestTimeout = 200 * time.Millisecond
+	cpe := newTestCPE(t, "A")
+	url := dev.acs.url.String()
+
+	proceed := make(chan struct{})
+	close(proceed)
+	_, done := runStepAfter(t, dev, "cmd: GetRPCMethods", proceed)
+	resp, _ := cpe.inform(t, url)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	_, body := cpe.post(t, url, nil)
+	require.Contains(t, string(body), "GetRPCMethods")
+
+	// the response of another request fails the step, and the session ends once the post times out
+	resp, body = cpe.post(t, url, respondTo(t, body, "bogus", messages.GetRPCMethodsResponse{}))
+	assert.ErrorContains(t, <-done, "cwmp:ID 'bogus'")
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Empty(t, body)
+	assert.Equal(t, sessionClosed, dev.sessionState())
+}
+
+func TestACSNewSessionAbandonsRequest(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{})
+	cpe := newTestCPE(t, "A")
+	url := dev.acs.url.String()
+
+	proceed := make(chan struct{})
+	close(proceed)
+	_, done := runStepAfter(t, dev, "cmd: GetRPCMethods", proceed)
+	resp, _ := cpe.inform(t, url)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	_, body := cpe.post(t, url, nil)
+	require.Contains(t, string(body), "GetRPCMethods")
+
+	// the CPE lost its session (e.g. rebooted) and opens a new one instead of responding
+	resp, body = cpe.informEvent(t, url, messages.EventBoot)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "InformResponse")
+	assert.ErrorContains(t, <-done, "without responding to 'GetRPCMethods'")
+	assert.Equal(t, sessionCPERequests, dev.sessionState())
+}
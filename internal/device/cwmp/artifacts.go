@@ -0,0 +1,81 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+)
+
+const artifactsPath = "/artifacts/"
+
+// serve the current build artifact through the CWMP listener and point the Download RPC to it
+func (d *CWMPDevice) serveArtifact(m *messages.Download) error {
+	artifact := configuration.GetCmdContext().Artifact
+	if len(artifact) == 0 {
+		return errors.New("no build artifact available to serve")
+	}
+	info, err := os.Stat(artifact)
+	if err != nil {
+		return fmt.Errorf("cannot serve artifact: %w", err)
+	}
+	if d.acs == nil {
+		return errors.New("cannot serve artifact without an ACS listener")
+	}
+	u := *d.acs.url
+	u.Path = path.Join(artifactsPath, filepath.Base(artifact))
+	m.URL = configuration.T(u.String())
+	m.FileSize = uint(info.Size())
+	tui.LogNormal("Serving artifact '%s' on %s", artifact, u.String())
+	return nil
+}
+
+func (d *CWMPDevice) handleArtifactRequest(w http.ResponseWriter, r *http.Request) {
+	d.log.Write(fmt.Appendf([]byte(""), "[%s] ARTIFACT: %s %s %s\n",
+		time.Now().Format(time.DateTime),
+		r.Method,
+		r.RequestURI,
+		r.RemoteAddr))
+	serveArtifactFile(w, r)
+}
+
+// serve the current build artifact, if requested by its name
+func serveArtifactFile(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	artifact := configuration.GetCmdContext().Artifact
+	if len(artifact) == 0 || path.Base(r.URL.Path) != filepath.Base(artifact) {
+		http.NotFound(w, r)
+		return
+	}
+	http.ServeFile(w, r, artifact)
+}
+
+// determine a URL under which the CPE can reach a listener bound to the given address
+func connectableURL(listener *url.URL) *url.URL {
+	u := url.URL{Scheme: listener.Scheme, Host: listener.Host}
+	host, port, err := net.SplitHostPort(listener.Host)
+	if err != nil {
+		return &u
+	}
+	if ip := net.ParseIP(host); len(host) == 0 || (ip != nil && ip.IsUnspecified()) {
+		if hostAddr := configuration.GetCmdContext().Host.Addr; len(hostAddr) > 0 {
+			u.Host = net.JoinHostPort(hostAddr, port)
+		}
+	}
+	return &u
+}
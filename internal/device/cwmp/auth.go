@@ -0,0 +1,340 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	BasicServerAuth  = "basic"
+	DigestServerAuth = "digest"
+
+	DefaultAuthRealm = "corteca"
+	sessionCookie    = "corteca-acs-session"
+	nonceLifetime    = 5 * time.Minute
+	// an authenticated session cookie expires once unused for this long
+	sessionLifetime = 30 * time.Minute
+)
+
+type connStateKey struct{}
+
+// per TCP connection state; CPEs commonly authenticate only the first request of a connection
+type connState struct {
+	authenticated bool
+	// the session cookie issued on the connection, if any
+	session string
+}
+
+type nonceState struct {
+	issued time.Time
+	count  uint64
+}
+
+// authenticates the CPE on the ACS listener using HTTP basic or digest authentication
+type serverAuth struct {
+	method   string
+	username string
+	password string
+	realm    string
+
+	mu     sync.Mutex
+	nonces map[string]*nonceState
+	// expiry of the authenticated session cookies
+	sessions map[string]time.Time
+}
+
+func newServerAuth(config *CWMPServerConfig) (*serverAuth, error) {
+	username := config.Username.String()
+	password := config.Password.String()
+	method := strings.ToLower(config.Auth)
+	switch method {
+	case "":
+		if len(username) == 0 && len(password) == 0 {
+			// no authentication configured
+			return nil, nil
+		}
+		method = BasicServerAuth
+	case BasicServerAuth, DigestServerAuth:
+	default:
+		return nil, fmt.Errorf("unknown server authentication '%s'", config.Auth)
+	}
+	if len(username) == 0 {
+		return nil, fmt.Errorf("server authentication '%s' requires a username", method)
+	}
+	realm := config.Realm
+	if len(realm) == 0 {
+		realm = DefaultAuthRealm
+	}
+	return &serverAuth{
+		method:   method,
+		username: username,
+		password: password,
+		realm:    realm,
+		nonces:   make(map[string]*nonceState),
+		sessions: make(map[string]time.Time),
+	}, nil
+}
+
+// attach connection state to every request context; to be used as http.Server.ConnContext
+func (a *serverAuth) connContext(ctx context.Context, c net.Conn) context.Context {
+	return context.WithValue(ctx, connStateKey{}, &connState{})
+}
+
+// an empty response of the ACS ends the CWMP session, along with its session cookie
+func (a *serverAuth) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session, ok := a.isAuthenticated(w, r)
+		if !ok {
+			return
+		}
+		rw := &bodyTracker{ResponseWriter: w}
+		next.ServeHTTP(rw, r)
+		if !rw.written && len(session) > 0 {
+			a.endSession(r, session)
+		}
+	})
+}
+
+// records whether a body was written to the response
+type bodyTracker struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (t *bodyTracker) Write(b []byte) (int, error) {
+	if len(b) > 0 {
+		t.written = true
+	}
+	return t.ResponseWriter.Write(b)
+}
+
+// check the request credentials and return the session cookie the request belongs to (if any); on failure,
+// a challenge is written to the response. A cookie is only issued to requests that carry no valid one.
+func (a *serverAuth) isAuthenticated(w http.ResponseWriter, r *http.Request) (string, bool) {
+	conn, _ := r.Context().Value(connStateKey{}).(*connState)
+	if cookie, err := r.Cookie(sessionCookie); err == nil && a.hasSession(cookie.Value) {
+		return cookie.Value, true
+	}
+	if conn != nil && conn.authenticated && len(r.Header.Get("Authorization")) == 0 {
+		return conn.session, true
+	}
+
+	var ok, stale bool
+	switch a.method {
+	case BasicServerAuth:
+		ok = a.checkBasic(r)
+	case DigestServerAuth:
+		ok, stale = a.checkDigest(r)
+	}
+	if !ok {
+		a.challenge(w, stale)
+		return "", false
+	}
+
+	if conn != nil {
+		conn.authenticated = true
+		// CPEs that ignore cookies but send their credentials along with every request get a single one
+		if a.hasSession(conn.session) {
+			return conn.session, true
+		}
+	}
+	session := a.newSession()
+	if conn != nil {
+		conn.session = session
+	}
+	http.SetCookie(w, &http.Cookie{Name: sessionCookie, Value: session, Path: "/", HttpOnly: true})
+	return session, true
+}
+
+func (a *serverAuth) challenge(w http.ResponseWriter, stale bool) {
+	switch a.method {
+	case BasicServerAuth:
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Basic realm="%s"`, a.realm))
+	case DigestServerAuth:
+		value := fmt.Sprintf(`Digest realm="%s", qop="auth", nonce="%s", opaque="%s", algorithm=MD5`,
+			a.realm, a.newNonce(), md5Hex(a.realm))
+		if stale {
+			value += ", stale=true"
+		}
+		w.Header().Set("WWW-Authenticate", value)
+	}
+	http.Error(w, "Unauthorized", http.StatusUnauthorized)
+}
+
+func (a *serverAuth) checkBasic(r *http.Request) bool {
+	username, password, ok := r.BasicAuth()
+	return ok && secureEqual(username, a.username) && secureEqual(password, a.password)
+}
+
+// verify a digest response (RFC 2617, MD5 with optional qop=auth); returns whether the nonce was stale
+func (a *serverAuth) checkDigest(r *http.Request) (ok bool, stale bool) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Digest ") {
+		return false, false
+	}
+	params := parseAuthParams(strings.TrimPrefix(header, "Digest "))
+	if params["username"] != a.username || params["realm"] != a.realm || params["uri"] != r.RequestURI {
+		return false, false
+	}
+	if alg, found := params["algorithm"]; found && !strings.EqualFold(alg, "MD5") {
+		return false, false
+	}
+
+	ha1 := md5Hex(a.username + ":" + a.realm + ":" + a.password)
+	ha2 := md5Hex(r.Method + ":" + params["uri"])
+	var expected string
+	switch params["qop"] {
+	case "auth":
+		if len(params["nc"]) == 0 || len(params["cnonce"]) == 0 {
+			return false, false
+		}
+		expected = md5Hex(strings.Join([]string{ha1, params["nonce"], params["nc"], params["cnonce"], "auth", ha2}, ":"))
+	case "":
+		expected = md5Hex(ha1 + ":" + params["nonce"] + ":" + ha2)
+	default:
+		return false, false
+	}
+	if !secureEqual(params["response"], expected) {
+		return false, false
+	}
+	return a.useNonce(params["nonce"], params["nc"])
+}
+
+// validate nonce freshness and that the nonce count is increasing, to prevent replays; without a nonce count
+// (no qop) the nonce can only be used once
+func (a *serverAuth) useNonce(nonce, nc string) (ok bool, stale bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	state, found := a.nonces[nonce]
+	if !found {
+		return false, true
+	}
+	if time.Since(state.issued) > nonceLifetime {
+		delete(a.nonces, nonce)
+		return false, true
+	}
+	if len(nc) > 0 {
+		var count uint64
+		if _, err := fmt.Sscanf(nc, "%x", &count); err != nil || count <= state.count {
+			return false, false
+		}
+		state.count = count
+	} else {
+		delete(a.nonces, nonce)
+	}
+	return true, false
+}
+
+func (a *serverAuth) newNonce() string {
+	nonce := randomHex(16)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	// drop expired nonces
+	for n, state := range a.nonces {
+		if time.Since(state.issued) > nonceLifetime {
+			delete(a.nonces, n)
+		}
+	}
+	a.nonces[nonce] = &nonceState{issued: time.Now()}
+	return nonce
+}
+
+func (a *serverAuth) newSession() string {
+	id := randomHex(16)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	// drop expired sessions
+	for s, expiry := range a.sessions {
+		if time.Now().After(expiry) {
+			delete(a.sessions, s)
+		}
+	}
+	a.sessions[id] = time.Now().Add(sessionLifetime)
+	return id
+}
+
+// check whether the session is valid, extending its lifetime if so
+func (a *serverAuth) hasSession(id string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	expiry, found := a.sessions[id]
+	if !found {
+		return false
+	}
+	if time.Now().After(expiry) {
+		delete(a.sessions, id)
+		return false
+	}
+	a.sessions[id] = time.Now().Add(sessionLifetime)
+	return true
+}
+
+// forget the session cookie; the next session of the CPE has to authenticate again, even on the same connection
+func (a *serverAuth) endSession(r *http.Request, id string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.sessions, id)
+	if conn, _ := r.Context().Value(connStateKey{}).(*connState); conn != nil && conn.session == id {
+		conn.session = ""
+		conn.authenticated = false
+	}
+}
+
+// parse the comma separated key=value (optionally quoted) parameters of an Authorization header
+func parseAuthParams(s string) map[string]string {
+	params := make(map[string]string)
+	for len(s) > 0 {
+		s = strings.TrimLeft(s, " ,")
+		eq := strings.IndexByte(s, '=')
+		if eq < 0 {
+			break
+		}
+		key := strings.ToLower(strings.TrimSpace(s[:eq]))
+		s = strings.TrimLeft(s[eq+1:], " ")
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			end := strings.IndexByte(s[1:], '"')
+			if end < 0 {
+				value, s = s[1:], ""
+			} else {
+				value, s = s[1:end+1], s[end+2:]
+			}
+		} else if comma := strings.IndexByte(s, ','); comma >= 0 {
+			value, s = strings.TrimSpace(s[:comma]), s[comma+1:]
+		} else {
+			value, s = strings.TrimSpace(s), ""
+		}
+		params[key] = value
+	}
+	return params
+}
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) string {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buf)
+}
+
+func secureEqual(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
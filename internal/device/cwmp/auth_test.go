@@ -0,0 +1,252 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/icholy/digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testUsername = "cpe"
+	testPassword = "secret"
+	informBody   = `<soap-env:Envelope xmlns:soap-env="http://schemas.xmlsoap.org/soap/envelope/"><soap-env:Body></soap-env:Body></soap-env:Envelope>`
+)
+
+// start a test ACS listener that plays back 200 for every authenticated request; posted envelopes are echoed,
+// while an empty post is answered with an empty response (ending the session)
+func startAuthTestServer(t *testing.T, config CWMPServerConfig) (*httptest.Server, *int) {
+	auth, err := newServerAuth(&config)
+	require.NoError(t, err)
+	require.NotNil(t, auth)
+
+	served := 0
+	srv := httptest.NewUnstartedServer(auth.wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served++
+		w.WriteHeader(http.StatusOK)
+		io.Copy(w, r.Body)
+	})))
+	srv.Config.ConnContext = auth.connContext
+	srv.Start()
+	t.Cleanup(srv.Close)
+	return srv, &served
+}
+
+func newServerConfig(auth string) CWMPServerConfig {
+	return CWMPServerConfig{
+		Auth:     auth,
+		Username: configuration.T(testUsername),
+		Password: configuration.T(testPassword),
+	}
+}
+
+func post(t *testing.T, client *http.Client, url string) *http.Response {
+	return postBody(t, client, url, informBody)
+}
+
+func postBody(t *testing.T, client *http.Client, url, body string) *http.Response {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	// drain the body, so that the connection is reused
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	return resp
+}
+
+func TestNewServerAuth(t *testing.T) {
+	auth, err := newServerAuth(&CWMPServerConfig{})
+	assert.NoError(t, err)
+	assert.Nil(t, auth, "no authentication expected without credentials")
+
+	auth, err = newServerAuth(&CWMPServerConfig{Username: configuration.T(testUsername)})
+	assert.NoError(t, err)
+	assert.Equal(t, BasicServerAuth, auth.method)
+	assert.Equal(t, DefaultAuthRealm, auth.realm)
+
+	_, err = newServerAuth(&CWMPServerConfig{Auth: "ntlm", Username: configuration.T(testUsername)})
+	assert.Error(t, err)
+
+	_, err = newServerAuth(&CWMPServerConfig{Auth: DigestServerAuth})
+	assert.Error(t, err)
+}
+
+func TestBasicAuth(t *testing.T) {
+	srv, served := startAuthTestServer(t, newServerConfig(BasicServerAuth))
+
+	resp := post(t, srv.Client(), srv.URL)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, `Basic realm="corteca"`, resp.Header.Get("WWW-Authenticate"))
+
+	client := &http.Client{Transport: &configuration.BasicAuthTransport{Username: testUsername, Password: "wrong", Transport: srv.Client().Transport}}
+	resp = post(t, client, srv.URL)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	client = &http.Client{Transport: &configuration.BasicAuthTransport{Username: testUsername, Password: testPassword, Transport: srv.Client().Transport}}
+	resp = post(t, client, srv.URL)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 1, *served)
+}
+
+func TestDigestAuth(t *testing.T) {
+	srv, served := startAuthTestServer(t, newServerConfig(DigestServerAuth))
+
+	resp := post(t, srv.Client(), srv.URL)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	challenge := resp.Header.Get("WWW-Authenticate")
+	assert.True(t, strings.HasPrefix(challenge, "Digest "))
+	params := parseAuthParams(strings.TrimPrefix(challenge, "Digest "))
+	assert.Equal(t, "corteca", params["realm"])
+	assert.Equal(t, "auth", params["qop"])
+	assert.NotEmpty(t, params["nonce"])
+
+	client := &http.Client{Transport: &digest.Transport{Username: testUsername, Password: "wrong", Transport: srv.Client().Transport}}
+	resp = post(t, client, srv.URL)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+	// the digest client retries with the credentials and then re-uses the challenge (nc incremented)
+	client = &http.Client{Transport: &digest.Transport{Username: testUsername, Password: testPassword, Transport: srv.Client().Transport}}
+	for i := 0; i < 3; i++ {
+		resp = post(t, client, srv.URL)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+	assert.Equal(t, 3, *served)
+}
+
+func TestDigestAuthRejectsReplay(t *testing.T) {
+	auth, err := newServerAuth(&CWMPServerConfig{Auth: DigestServerAuth, Username: configuration.T(testUsername), Password: configuration.T(testPassword)})
+	require.NoError(t, err)
+
+	nonce := auth.newNonce()
+	ok, _ := auth.useNonce(nonce, "00000001")
+	assert.True(t, ok)
+	ok, stale := auth.useNonce(nonce, "00000001")
+	assert.False(t, ok)
+	assert.False(t, stale)
+	ok, stale = auth.useNonce("unknown", "00000001")
+	assert.False(t, ok)
+	assert.True(t, stale)
+
+	// without qop there is no nonce count, so the nonce is single-use
+	nonce = auth.newNonce()
+	ok, _ = auth.useNonce(nonce, "")
+	assert.True(t, ok)
+	ok, _ = auth.useNonce(nonce, "")
+	assert.False(t, ok)
+}
+
+func TestDigestAuthRequiresNonceCount(t *testing.T) {
+	auth, err := newServerAuth(&CWMPServerConfig{Auth: DigestServerAuth, Username: configuration.T(testUsername), Password: configuration.T(testPassword)})
+	require.NoError(t, err)
+
+	// a qop=auth response computed without nc and cnonce
+	nonce := auth.newNonce()
+	ha1 := md5Hex(testUsername + ":" + auth.realm + ":" + testPassword)
+	ha2 := md5Hex(http.MethodPost + ":/")
+	response := md5Hex(strings.Join([]string{ha1, nonce, "", "", "auth", ha2}, ":"))
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Authorization", fmt.Sprintf(`Digest username="%s", realm="%s", nonce="%s", uri="/", qop=auth, response="%s"`,
+		testUsername, auth.realm, nonce, response))
+	ok, _ := auth.checkDigest(req)
+	assert.False(t, ok)
+}
+
+func TestAuthenticatedSessionCookie(t *testing.T) {
+	srv, served := startAuthTestServer(t, newServerConfig(BasicServerAuth))
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	client := &http.Client{
+		Jar:       jar,
+		Transport: &configuration.BasicAuthTransport{Username: testUsername, Password: testPassword, Transport: srv.Client().Transport},
+	}
+	resp := post(t, client, srv.URL)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// follow-up (empty) posts of the session carry only the cookie, on a fresh connection
+	anonymous := &http.Client{Jar: jar, Transport: &http.Transport{DisableKeepAlives: true}}
+	resp = post(t, anonymous, srv.URL)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, *served)
+
+	// without the cookie and on a new connection, the request is challenged
+	resp = post(t, &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}, srv.URL)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestSessionCookieEndsWithSession(t *testing.T) {
+	srv, _ := startAuthTestServer(t, newServerConfig(BasicServerAuth))
+	auth := &configuration.BasicAuthTransport{Username: testUsername, Password: testPassword, Transport: srv.Client().Transport}
+
+	// a CPE ignoring cookies but sending its credentials along with every request gets a single cookie
+	resp := post(t, &http.Client{Transport: auth}, srv.URL)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	cookies := resp.Cookies()
+	require.Len(t, cookies, 1)
+	resp = post(t, &http.Client{Transport: auth}, srv.URL)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, resp.Cookies())
+
+	// the empty response to the empty post ends the session, and its cookie is no longer accepted
+	anonymous := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+	req, err := http.NewRequest(http.MethodPost, srv.URL, nil)
+	require.NoError(t, err)
+	req.AddCookie(cookies[0])
+	resp, err = anonymous.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	req, err = http.NewRequest(http.MethodPost, srv.URL, bytes.NewBufferString(informBody))
+	require.NoError(t, err)
+	req.AddCookie(cookies[0])
+	resp, err = anonymous.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestSessionCookieExpires(t *testing.T) {
+	auth, err := newServerAuth(&CWMPServerConfig{Username: configuration.T(testUsername)})
+	require.NoError(t, err)
+
+	session := auth.newSession()
+	assert.True(t, auth.hasSession(session))
+	auth.sessions[session] = time.Now().Add(-time.Second)
+	assert.False(t, auth.hasSession(session))
+	assert.NotContains(t, auth.sessions, session)
+}
+
+func TestAuthenticatedConnection(t *testing.T) {
+	srv, served := startAuthTestServer(t, newServerConfig(DigestServerAuth))
+
+	client := srv.Client()
+	digestClient := &http.Client{Transport: &digest.Transport{Username: testUsername, Password: testPassword, Transport: client.Transport}}
+	resp := post(t, digestClient, srv.URL)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// CPEs that ignore cookies keep using the same (authenticated) connection without credentials
+	resp = post(t, client, srv.URL)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, *served)
+
+	// until the session ends; the next one has to authenticate again
+	resp = postBody(t, client, srv.URL, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp = post(t, client, srv.URL)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
@@ -0,0 +1,448 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+// Package cpesim simulates a TR-069 CPE, to develop and test CWMP sequences without hardware
+package cpesim
+
+import (
+	"bytes"
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultListenAddr = "0.0.0.0:7547"
+
+	maxRetryInterval = 1 * time.Minute
+)
+
+type Config struct {
+	ACS configuration.HttpClientEndpoint `yaml:"acs"`
+	// address of the connection request listener
+	Listen string `yaml:"listen,omitempty"`
+	// connection request URL reported to the ACS; derived from the listener if empty
+	ConnectionRequestURL string                  `yaml:"connectionRequestURL,omitempty"`
+	DeviceId             messages.DeviceIDStruct `yaml:"deviceId"`
+	Parameters           map[string]Parameter    `yaml:"parameters,omitempty"`
+	PeriodicInterval     time.Duration           `yaml:"periodicInterval,omitempty"`
+	DUStateChange        DUStateChangeConfig     `yaml:"duStateChange,omitempty"`
+	// highest CWMP version supported; versions from 1.4 on are announced with the SupportedCWMPVersions header
+	CWMPVersion messages.CWMPVersion `yaml:"cwmpVersion,omitempty"`
+}
+
+// outcome of simulated ChangeDUState operations
+type DUStateChangeConfig struct {
+	// a non-zero fault code fails every operation
+	FaultCode   uint          `yaml:"faultCode,omitempty"`
+	FaultString string        `yaml:"faultString,omitempty"`
+	Delay       time.Duration `yaml:"delay,omitempty"`
+}
+
+type Simulator struct {
+	config   Config
+	params   *ParameterTree
+	client   *http.Client
+	acsURL   string
+	listener net.Listener
+	server   *http.Server
+
+	mu sync.Mutex
+	// events and CPE requests to deliver in the next session
+	events   []messages.EventStruct
+	requests []messages.SyncRPC
+	// whether the current session ends with a reboot
+	reboot    bool
+	rebootKey string
+	wakeup    chan struct{}
+	// CWMP version selected by the ACS for the current session
+	version messages.CWMPVersion
+}
+
+func New(config Config) (*Simulator, error) {
+	if len(config.DeviceId.SerialNumber) == 0 {
+		return nil, fmt.Errorf("a serial number is required")
+	}
+	acsURL := config.ACS.Addr.String()
+	if len(acsURL) == 0 {
+		return nil, fmt.Errorf("no ACS URL specified")
+	}
+	client, err := config.ACS.NewHttpClient()
+	if err != nil {
+		return nil, err
+	}
+	// ACSs track sessions with cookies
+	if client.Jar, err = cookiejar.New(nil); err != nil {
+		return nil, err
+	}
+	if len(config.Listen) == 0 {
+		config.Listen = DefaultListenAddr
+	}
+	return &Simulator{
+		config: config,
+		params: NewParameterTree(config.Parameters),
+		client: client,
+		acsURL: acsURL,
+		// the first session reports the boot
+		events: []messages.EventStruct{{EventCode: messages.EventBoot}},
+		wakeup: make(chan struct{}, 1),
+	}, nil
+}
+
+// the simulated data model
+func (s *Simulator) Parameters() *ParameterTree {
+	return s.params
+}
+
+// start the connection request listener; called by Run if not already started
+func (s *Simulator) Listen() error {
+	if s.listener != nil {
+		return nil
+	}
+	listener, err := net.Listen("tcp", s.config.Listen)
+	if err != nil {
+		return err
+	}
+	s.listener = listener
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", s.handleConnectionRequest)
+	s.server = &http.Server{Handler: mux}
+	go func() {
+		if err := s.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			tui.LogError("Connection request listener failed: %s", err)
+		}
+	}()
+	s.initParameters()
+	tui.DisplaySuccessMsg(fmt.Sprintf("CPE '%s' listening for connection requests on %s", s.config.DeviceId.String(), s.ConnectionRequestURL()))
+	return nil
+}
+
+func (s *Simulator) ConnectionRequestURL() string {
+	if len(s.config.ConnectionRequestURL) > 0 {
+		return s.config.ConnectionRequestURL
+	}
+	host, port, _ := net.SplitHostPort(s.listener.Addr().String())
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		host = "127.0.0.1"
+	}
+	return fmt.Sprintf("http://%s/", net.JoinHostPort(host, port))
+}
+
+// default TR-181 parameters, unless overridden by the configuration
+func (s *Simulator) initParameters() {
+	id := s.config.DeviceId
+	defaults := map[string]Parameter{
+		"Device.RootDataModelVersion":                        {Value: "2.15"},
+		"Device.DeviceInfo.Manufacturer":                     {Value: id.Manufacturer},
+		"Device.DeviceInfo.ManufacturerOUI":                  {Value: id.OUI},
+		"Device.DeviceInfo.ProductClass":                     {Value: id.ProductClass},
+		"Device.DeviceInfo.SerialNumber":                     {Value: id.SerialNumber},
+		"Device.DeviceInfo.HardwareVersion":                  {Value: "1.0"},
+		"Device.DeviceInfo.SoftwareVersion":                  {Value: "1.0.0"},
+		"Device.DeviceInfo.ProvisioningCode":                 {Writable: true},
+		"Device.ManagementServer.URL":                        {Value: s.acsURL, Writable: true},
+		"Device.ManagementServer.ConnectionRequestURL":       {Value: s.ConnectionRequestURL()},
+		"Device.ManagementServer.ParameterKey":               {},
+		"Device.ManagementServer.PeriodicInformEnable":       {Value: strconv.FormatBool(s.config.PeriodicInterval > 0), Type: messages.XsdBoolean, Writable: true},
+		"Device.ManagementServer.PeriodicInformInterval":     {Value: strconv.Itoa(int(s.config.PeriodicInterval.Seconds())), Type: messages.XsdUnsignedint, Writable: true},
+		"Device.SoftwareModules.ExecutionEnv.1.Name":         {Value: "Corteca"},
+		"Device.SoftwareModules.ExecutionEnv.1.Enable":       {Value: "true", Type: messages.XsdBoolean, Writable: true},
+		"Device.SoftwareModules.ExecutionEnv.1.Status":       {Value: "Up"},
+		"Device.SoftwareModules.ExecutionEnvNumberOfEntries": {Value: "1", Type: messages.XsdUnsignedint},
+	}
+	for name, p := range defaults {
+		if _, found := s.config.Parameters[name]; !found {
+			s.params.Put(name, p)
+		}
+	}
+	s.params.mu.Lock()
+	s.params.addObjectLocked(deploymentUnitTable)
+	s.params.addObjectLocked(executionUnitTable)
+	s.params.mu.Unlock()
+}
+
+// run the CPE until the context is cancelled: boot, then open a session whenever a connection request
+// arrives, the periodic interval expires or a notification is pending
+func (s *Simulator) Run(ctx context.Context) error {
+	if err := s.Listen(); err != nil {
+		return err
+	}
+	defer s.server.Close()
+
+	var periodic <-chan time.Time
+	if s.config.PeriodicInterval > 0 {
+		ticker := time.NewTicker(s.config.PeriodicInterval)
+		defer ticker.Stop()
+		periodic = ticker.C
+	}
+
+	retryInterval := time.Second
+	for {
+		var retry <-chan time.Time
+		if err := s.Session(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tui.LogError("CWMP session failed: %s; retrying in %s", err, retryInterval)
+			retry = time.After(retryInterval)
+			retryInterval = min(2*retryInterval, maxRetryInterval)
+		} else {
+			retryInterval = time.Second
+			if s.hasPending() {
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-retry:
+		case <-s.wakeup:
+		case <-periodic:
+			s.queueEvent(messages.EventPeriodic, "")
+		}
+	}
+}
+
+func (s *Simulator) handleConnectionRequest(w http.ResponseWriter, r *http.Request) {
+	tui.LogNormal("Connection request received from %s", r.RemoteAddr)
+	s.queueEvent(messages.EventConnectionRequest, "")
+	w.WriteHeader(http.StatusOK)
+}
+
+// add an event for the next Inform & trigger a session; single events (those not starting with "M ") are
+// reported only once
+func (s *Simulator) queueEvent(code, commandKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queueEventLocked(code, commandKey)
+}
+
+func (s *Simulator) queueEventLocked(code, commandKey string) {
+	if strings.HasPrefix(code, "M ") || !(messages.EventList{Events: s.events}).Contains(code, "") {
+		s.events = append(s.events, messages.EventStruct{EventCode: code, CommandKey: commandKey})
+	}
+	s.notifyLocked()
+}
+
+// queue a CPE request (along with the events announcing it) for the next session
+func (s *Simulator) queueRequest(rpc messages.SyncRPC, events ...messages.EventStruct) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, rpc)
+	for _, ev := range events {
+		s.queueEventLocked(ev.EventCode, ev.CommandKey)
+	}
+}
+
+func (s *Simulator) notifyLocked() {
+	select {
+	case s.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Simulator) hasPending() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.events) > 0 || len(s.requests) > 0
+}
+
+func (s *Simulator) takePending() ([]messages.EventStruct, []messages.SyncRPC) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	events, requests := s.events, s.requests
+	s.events, s.requests = nil, nil
+	// the session about to start delivers everything queued so far
+	select {
+	case <-s.wakeup:
+	default:
+	}
+	return events, requests
+}
+
+// put back whatever could not be delivered in a failed session
+func (s *Simulator) requeue(events []messages.EventStruct, requests []messages.SyncRPC) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(events, s.events...)
+	s.requests = append(requests, s.requests...)
+}
+
+// run a single CWMP session: Inform, pending CPE requests, then serve the ACS requests until it closes the session
+func (s *Simulator) Session(ctx context.Context) error {
+	events, requests := s.takePending()
+	if len(events) == 0 {
+		// nothing to report; the session was only triggered by pending requests
+		events = []messages.EventStruct{{EventCode: messages.EventConnectionRequest}}
+	}
+	if err := s.deliver(ctx, events, requests); err != nil {
+		s.requeue(events, requests)
+		return err
+	}
+
+	// hand control over to the ACS
+	resp, err := s.post(ctx, nil)
+	for err == nil && resp != nil {
+		var replies []messages.Message
+		for _, msg := range resp.Body.Messages {
+			if _, ok := msg.(messages.SyncRPC); ok {
+				tui.LogNormal("Received '%s' RPC", msg.GetName())
+				replies = append(replies, s.handleRPC(msg))
+			}
+		}
+		var reply *messages.Envelope
+		if len(replies) > 0 {
+			env := s.newEnvelope(resp.GetID(), replies...)
+			reply = &env
+		}
+		resp, err = s.post(ctx, reply)
+	}
+	if err != nil {
+		return err
+	}
+	tui.LogNormal("Session closed by ACS")
+	s.afterSession()
+	return nil
+}
+
+func (s *Simulator) deliver(ctx context.Context, events []messages.EventStruct, requests []messages.SyncRPC) error {
+	// the Inform is sent with the highest supported version; the ACS selects the version of the session
+	s.version = s.config.CWMPVersion
+	env := s.newEnvelope(newRequestID(), s.newInform(events))
+	if s.version >= messages.CWMPVersion14 {
+		env.SetSupportedCWMPVersions(s.version)
+	}
+	tui.LogNormal("Sending Inform (%s) to %s", eventCodes(events), s.acsURL)
+	resp, err := s.request(ctx, env, messages.ExpectMessage[messages.InformResponse])
+	if err != nil {
+		return err
+	}
+	if version, found := resp.GetUseCWMPVersion(); found {
+		s.version = version
+	} else {
+		s.version = min(resp.Version, s.version)
+	}
+
+	for _, rpc := range requests {
+		tui.LogNormal("Sending '%s'", rpc.GetName())
+		if _, err := s.request(ctx, s.newEnvelope(newRequestID(), rpc), rpc.ValidateResponse); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Simulator) newEnvelope(id string, msg ...messages.Message) messages.Envelope {
+	env := messages.NewEnvelope(id, msg...)
+	env.Version = s.version
+	return env
+}
+
+func newRequestID() string {
+	return strconv.FormatInt(time.Now().UnixNano(), 16)
+}
+
+// post a CPE request and validate the ACS response
+func (s *Simulator) request(ctx context.Context, env messages.Envelope, validate func(messages.Message) error) (*messages.Envelope, error) {
+	name := env.Body.Messages[0].GetName()
+	resp, err := s.post(ctx, &env)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || len(resp.Body.Messages) == 0 {
+		return nil, fmt.Errorf("ACS closed the session without responding to '%s'", name)
+	}
+	msg := resp.Body.Messages[0]
+	if fault, ok := msg.(messages.Fault); ok {
+		return nil, fault.Detail
+	}
+	return resp, validate(msg)
+}
+
+// post an envelope (nil for an empty post) & parse the ACS reply (nil if empty)
+func (s *Simulator) post(ctx context.Context, env *messages.Envelope) (*messages.Envelope, error) {
+	var body []byte
+	if env != nil {
+		var err error
+		if body, err = xml.Marshal(env); err != nil {
+			return nil, err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.acsURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if env != nil {
+		req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+		req.Header.Set("SOAPAction", "")
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return nil, fmt.Errorf("ACS responded with '%s'", resp.Status)
+	}
+	reply, err := messages.ParseEnvelopeXML(bytes.NewReader(data))
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	return reply, err
+}
+
+func (s *Simulator) newInform(events []messages.EventStruct) messages.Inform {
+	values, _ := s.params.Get([]string{
+		"Device.RootDataModelVersion",
+		"Device.DeviceInfo.HardwareVersion",
+		"Device.DeviceInfo.SoftwareVersion",
+		"Device.DeviceInfo.ProvisioningCode",
+		"Device.ManagementServer.ConnectionRequestURL",
+		"Device.ManagementServer.ParameterKey",
+	})
+	return messages.Inform{
+		DeviceId:      s.config.DeviceId,
+		Event:         messages.EventList{Events: events},
+		MaxEnvelopes:  1,
+		CurrentTime:   time.Now().Format(time.RFC3339),
+		ParameterList: messages.ParameterValueListStruct{Params: values},
+	}
+}
+
+// perform the actions deferred until the end of the session
+func (s *Simulator) afterSession() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.reboot {
+		tui.LogNormal("Rebooting...")
+		s.reboot = false
+		s.queueEventLocked(messages.EventBoot, "")
+		s.queueEventLocked(messages.EventMReboot, s.rebootKey)
+	}
+}
+
+func eventCodes(events []messages.EventStruct) string {
+	codes := make([]string, len(events))
+	for i, ev := range events {
+		codes[i] = ev.EventCode
+	}
+	return strings.Join(codes, ", ")
+}
@@ -0,0 +1,361 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cpesim
+
+import (
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device"
+	_ "github.com/nokia/corteca-cli/internal/device/cwmp"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func newTestTree() *ParameterTree {
+	return NewParameterTree(map[string]Parameter{
+		"Device.DeviceInfo.SerialNumber":     {Value: "A"},
+		"Device.DeviceInfo.ProvisioningCode": {Value: "code", Writable: true},
+		"Device.IP.Interface.1.Enable":       {Value: "true", Type: "xsd:boolean", Writable: true},
+		"Device.IP.Interface.2.Enable":       {Value: "false", Type: "xsd:boolean", Writable: true},
+	})
+}
+
+func parameterValue(name, value string) messages.ParameterValueStruct {
+	return messages.ParameterValueStruct{
+		Name:    configuration.T(name),
+		Content: messages.NodeStruct{Type: messages.XsdString, Value: configuration.T(value)},
+	}
+}
+
+func TestParameterUnmarshalYAML(t *testing.T) {
+	var params map[string]Parameter
+	require.NoError(t, yaml.Unmarshal([]byte(`
+Device.DeviceInfo.ProvisioningCode: abc
+Device.DeviceInfo.SerialNumber:
+    value: "123"
+    writable: false
+Device.ManagementServer.PeriodicInformInterval:
+    value: "60"
+    type: xsd:unsignedInt
+`), &params))
+	assert.Equal(t, Parameter{Value: "abc", Writable: true}, params["Device.DeviceInfo.ProvisioningCode"])
+	assert.Equal(t, Parameter{Value: "123"}, params["Device.DeviceInfo.SerialNumber"])
+	assert.Equal(t, Parameter{Value: "60", Type: "xsd:unsignedInt", Writable: true}, params["Device.ManagementServer.PeriodicInformInterval"])
+}
+
+func TestParameterTreeGet(t *testing.T) {
+	tree := newTestTree()
+	values, err := tree.Get([]string{"Device.IP.Interface.", "Device.DeviceInfo.SerialNumber"})
+	require.NoError(t, err)
+	require.Len(t, values, 3)
+	assert.Equal(t, "Device.IP.Interface.1.Enable", values[0].Name.RawTemplate)
+	assert.Equal(t, "xsd:boolean", values[0].Content.Type)
+	assert.Equal(t, "A", values[2].Content.Value.RawTemplate)
+
+	_, err = tree.Get([]string{"Device.DeviceInfo.Unknown"})
+	var fault *Fault
+	require.ErrorAs(t, err, &fault)
+	assert.Equal(t, FaultInvalidParameterName, fault.Code)
+}
+
+func TestParameterTreeSet(t *testing.T) {
+	tree := newTestTree()
+	require.NoError(t, tree.Set([]messages.ParameterValueStruct{parameterValue("Device.DeviceInfo.ProvisioningCode", "new")}))
+	assert.Equal(t, "new", tree.Value("Device.DeviceInfo.ProvisioningCode"))
+
+	// the update is atomic; nothing is set if any parameter is not writable
+	err := tree.Set([]messages.ParameterValueStruct{
+		parameterValue("Device.DeviceInfo.ProvisioningCode", "other"),
+		parameterValue("Device.DeviceInfo.SerialNumber", "B"),
+	})
+	var fault *Fault
+	require.ErrorAs(t, err, &fault)
+	assert.Equal(t, FaultNonWritableParameter, fault.Code)
+	assert.Equal(t, "new", tree.Value("Device.DeviceInfo.ProvisioningCode"))
+}
+
+func TestParameterTreeSetTypes(t *testing.T) {
+	tree := newTestTree()
+	enable := messages.ParameterValueStruct{
+		Name:    configuration.T("Device.IP.Interface.1.Enable"),
+		Content: messages.NodeStruct{Type: messages.XsdBoolean, Value: configuration.T("false")},
+	}
+	require.NoError(t, tree.Set([]messages.ParameterValueStruct{enable}))
+	assert.Equal(t, "false", tree.Value("Device.IP.Interface.1.Enable"))
+
+	var fault *Fault
+	err := tree.Set([]messages.ParameterValueStruct{parameterValue("Device.IP.Interface.1.Enable", "true")})
+	require.ErrorAs(t, err, &fault)
+	assert.Equal(t, FaultInvalidParameterType, fault.Code)
+
+	enable.Content.Value = configuration.T("yes")
+	err = tree.Set([]messages.ParameterValueStruct{enable})
+	require.ErrorAs(t, err, &fault)
+	assert.Equal(t, FaultInvalidParameterValue, fault.Code)
+	assert.Equal(t, "false", tree.Value("Device.IP.Interface.1.Enable"))
+}
+
+func TestParameterTreeNames(t *testing.T) {
+	tree := newTestTree()
+	infos, err := tree.Names("Device.IP.Interface.", true)
+	require.NoError(t, err)
+	assert.Equal(t, []messages.ParameterInfoStruct{
+		{Name: "Device.IP.Interface.1."},
+		{Name: "Device.IP.Interface.2."},
+	}, infos)
+
+	infos, err = tree.Names("Device.DeviceInfo.", false)
+	require.NoError(t, err)
+	assert.Equal(t, []messages.ParameterInfoStruct{
+		{Name: "Device.DeviceInfo."},
+		{Name: "Device.DeviceInfo.ProvisioningCode", Writable: true},
+		{Name: "Device.DeviceInfo.SerialNumber"},
+	}, infos)
+
+	infos, err = tree.Names("Device.", true)
+	require.NoError(t, err)
+	assert.Equal(t, []messages.ParameterInfoStruct{
+		{Name: "Device.DeviceInfo."},
+		{Name: "Device.IP."},
+	}, infos)
+
+	_, err = tree.Names("Device.DeviceInfo.SerialNumber", true)
+	assert.Error(t, err)
+}
+
+func TestParameterTreeObjects(t *testing.T) {
+	tree := newTestTree()
+	instance, err := tree.AddObject("Device.IP.Interface.")
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), instance)
+
+	require.NoError(t, tree.DeleteObject("Device.IP.Interface.1."))
+	_, err = tree.Get([]string{"Device.IP.Interface.1.Enable"})
+	assert.Error(t, err)
+	assert.Error(t, tree.DeleteObject("Device.IP.Interface.1."))
+
+	// instance numbers are not reused
+	instance, err = tree.AddObject("Device.IP.Interface.")
+	require.NoError(t, err)
+	assert.Equal(t, uint(4), instance)
+}
+
+func TestParameterTreeDeploymentUnits(t *testing.T) {
+	tree := newTestTree()
+	result, err := tree.ApplyDUOperation(messages.InstallOpStruct{
+		URL:  configuration.T("http://example.com/app.tar.gz"),
+		UUID: configuration.T("1234"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Device.SoftwareModules.DeploymentUnit.1", result.DeploymentUnitRef)
+	assert.Equal(t, "Installed", result.CurrentState)
+	assert.Equal(t, "app.tar.gz", tree.Value("Device.SoftwareModules.DeploymentUnit.1.Name"))
+
+	_, err = tree.ApplyDUOperation(messages.InstallOpStruct{UUID: configuration.T("1234")})
+	var fault *Fault
+	require.ErrorAs(t, err, &fault)
+	assert.Equal(t, FaultDuplicateDeploymentUnit, fault.Code)
+
+	// requesting a state of the execution unit changes its status
+	require.NoError(t, tree.Set([]messages.ParameterValueStruct{parameterValue("Device.SoftwareModules.ExecutionUnit.1.RequestedState", "Active")}))
+	assert.Equal(t, "Active", tree.Value("Device.SoftwareModules.ExecutionUnit.1.Status"))
+
+	result, err = tree.ApplyDUOperation(messages.UninstallOpStruct{UUID: configuration.T("1234")})
+	require.NoError(t, err)
+	assert.Equal(t, "Uninstalled", result.CurrentState)
+	assert.Empty(t, tree.Value("Device.SoftwareModules.ExecutionUnit.1.Status"))
+
+	_, err = tree.ApplyDUOperation(messages.UninstallOpStruct{UUID: configuration.T("1234")})
+	require.ErrorAs(t, err, &fault)
+	assert.Equal(t, FaultUnknownDeploymentUnit, fault.Code)
+}
+
+func freeTestPort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+// start a simulator along with a CWMP device acting as its ACS
+func newTestSetup(t *testing.T, duConfig DUStateChangeConfig) device.Device {
+	_, dev := newTestSimulator(t, Config{DUStateChange: duConfig})
+	return dev
+}
+
+func newTestSimulator(t *testing.T, config Config) (*Simulator, device.Device) {
+	acsPort := freeTestPort(t)
+	config.ACS = configuration.HttpClientEndpoint{Endpoint: configuration.Endpoint{Addr: configuration.T(fmt.Sprintf("http://127.0.0.1:%d/", acsPort))}}
+	config.Listen = "127.0.0.1:0"
+	config.DeviceId = messages.DeviceIDStruct{OUI: "ABCDEF", ProductClass: "Router", SerialNumber: "A"}
+	sim, err := New(config)
+	require.NoError(t, err)
+	require.NoError(t, sim.Listen())
+
+	var devConfig configuration.DeviceConfig
+	require.NoError(t, yaml.Unmarshal([]byte(fmt.Sprintf(`
+addr: cwmp://%s
+server:
+    addr: http://127.0.0.1:%d
+`, sim.listener.Addr().String(), acsPort)), &devConfig))
+	dev, err := device.NewDevice(&devConfig, io.Discard)
+	require.NoError(t, err)
+	t.Cleanup(dev.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- sim.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		assert.NoError(t, <-done)
+	})
+	return sim, dev
+}
+
+func executeStep(t *testing.T, dev device.Device, step string) (any, error) {
+	var cmd configuration.SequenceCmd
+	require.NoError(t, yaml.Unmarshal([]byte(step), &cmd))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return dev.ExecuteCommand(ctx, &cmd)
+}
+
+const installStep = `
+cmd: ChangeDUState
+CommandKey: install
+Operations:
+    - !InstallOpStruct
+      URL: http://example.com/app.tar.gz
+      UUID: "1234"
+`
+
+func TestSimulatorSession(t *testing.T) {
+	dev := newTestSetup(t, DUStateChangeConfig{})
+	require.NoError(t, dev.BeginSequence())
+
+	// the Inform opening the session is exposed to templates
+	serial, err := configuration.ReadField(configuration.GetCmdContext(), ".device.inform.SerialNumber")
+	require.NoError(t, err)
+	assert.Equal(t, "A", serial)
+	assert.Equal(t, "1.0.0", configuration.T("${.device.inform.Device.DeviceInfo.SoftwareVersion}").String())
+
+	res, err := executeStep(t, dev, `
+cmd: GetParameterValues
+ParameterNames:
+    - Device.DeviceInfo.SerialNumber
+`)
+	require.NoError(t, err)
+	values := res.(messages.GetParameterValuesResponse).ParameterList.Params
+	require.Len(t, values, 1)
+	assert.Equal(t, "A", values[0].Content.Value.RawTemplate)
+
+	res, err = executeStep(t, dev, installStep)
+	require.NoError(t, err)
+	complete := res.(messages.DUStateChangeComplete)
+	require.Len(t, complete.Results, 1)
+	assert.Equal(t, "Installed", complete.Results[0].CurrentState)
+	assert.Equal(t, []string{"Device.SoftwareModules.ExecutionUnit.1"}, complete.Results[0].ExecutionUnitRefs)
+
+	res, err = executeStep(t, dev, `
+cmd: GetParameterValues
+ParameterNames:
+    - Device.SoftwareModules.DeploymentUnit.1.UUID
+`)
+	require.NoError(t, err)
+	assert.Equal(t, "1234", res.(messages.GetParameterValuesResponse).ParameterList.Params[0].Content.Value.RawTemplate)
+	assert.NoError(t, dev.EndSequence())
+}
+
+func TestSimulatorCWMPVersionNegotiation(t *testing.T) {
+	sim, dev := newTestSimulator(t, Config{CWMPVersion: messages.CWMPVersion14})
+	require.NoError(t, dev.BeginSequence())
+
+	res, err := executeStep(t, dev, `
+cmd: GetParameterValues
+ParameterNames:
+    - Device.DeviceInfo.SerialNumber
+`)
+	require.NoError(t, err)
+	assert.Len(t, res.(messages.GetParameterValuesResponse).ParameterList.Params, 1)
+	// selected by the ACS with UseCWMPVersion
+	assert.Equal(t, messages.CWMPVersion14, sim.version)
+	assert.NoError(t, dev.EndSequence())
+}
+
+func TestSimulatorDUStateChangeFault(t *testing.T) {
+	dev := newTestSetup(t, DUStateChangeConfig{FaultCode: FaultUnknownDeploymentUnit, FaultString: "no such DU"})
+	require.NoError(t, dev.BeginSequence())
+
+	res, err := executeStep(t, dev, installStep)
+	assert.Error(t, err)
+	complete := res.(messages.DUStateChangeComplete)
+	require.Len(t, complete.Results, 1)
+	assert.Equal(t, messages.FaultStruct{FaultCode: FaultUnknownDeploymentUnit, FaultString: "no such DU"}, complete.Results[0].Fault)
+	var fault configuration.FaultCoder
+	require.ErrorAs(t, err, &fault)
+	assert.Equal(t, FaultUnknownDeploymentUnit, fault.GetFaultCode())
+	assert.NoError(t, dev.EndSequence())
+}
+
+func TestSimulatorSetParameterValuesFault(t *testing.T) {
+	dev := newTestSetup(t, DUStateChangeConfig{})
+	require.NoError(t, dev.BeginSequence())
+
+	_, err := executeStep(t, dev, `
+cmd: SetParameterValues
+ParameterList:
+    - Name: Device.DeviceInfo.SerialNumber
+      Value: B
+    - Name: Device.ManagementServer.PeriodicInformEnable
+      Type: string
+      Value: "true"
+`)
+	var fault messages.CwmpFaultStruct
+	require.ErrorAs(t, err, &fault)
+	assert.Equal(t, FaultInvalidArguments, fault.FaultCode)
+	require.Len(t, fault.SetParameterValuesFault, 2)
+	assert.Equal(t, "Device.DeviceInfo.SerialNumber", fault.SetParameterValuesFault[0].ParameterName)
+	assert.Equal(t, FaultNonWritableParameter, fault.SetParameterValuesFault[0].FaultCode)
+	assert.Equal(t, "Device.ManagementServer.PeriodicInformEnable", fault.SetParameterValuesFault[1].ParameterName)
+	assert.Equal(t, FaultInvalidParameterType, fault.SetParameterValuesFault[1].FaultCode)
+	assert.ErrorContains(t, err, "(faultcode: 9006, Invalid parameter type)")
+	assert.NoError(t, dev.EndSequence())
+}
+
+func TestSimulatorReadDataModel(t *testing.T) {
+	dev := newTestSetup(t, DUStateChangeConfig{})
+	require.NoError(t, dev.BeginSequence())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	params, err := dev.(device.DataModelReader).ReadDataModel(ctx, "Device.DeviceInfo.", 0)
+	require.NoError(t, err)
+	assert.Contains(t, params, device.Parameter{Name: "Device.DeviceInfo."})
+	assert.Contains(t, params, device.Parameter{Name: "Device.DeviceInfo.SerialNumber", Value: "A"})
+	assert.Contains(t, params, device.Parameter{Name: "Device.DeviceInfo.ProductClass", Value: "Router"})
+
+	params, err = dev.(device.DataModelReader).ReadDataModel(ctx, "Device.", 1)
+	require.NoError(t, err)
+	assert.Contains(t, params, device.Parameter{Name: "Device.DeviceInfo."})
+	for _, p := range params {
+		assert.LessOrEqual(t, strings.Count(p.Name, "."), 2, p.Name)
+	}
+
+	// a single GetParameterValues of the partial path
+	params, err = device.ReadParameterValues(ctx, dev, "Device.DeviceInfo.")
+	require.NoError(t, err)
+	assert.Contains(t, params, device.Parameter{Name: "Device.DeviceInfo.SerialNumber", Value: "A"})
+	assert.NotContains(t, params, device.Parameter{Name: "Device.DeviceInfo."})
+	assert.NoError(t, dev.EndSequence())
+}
@@ -0,0 +1,440 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cpesim
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	deploymentUnitTable = "Device.SoftwareModules.DeploymentUnit."
+	executionUnitTable  = "Device.SoftwareModules.ExecutionUnit."
+	defaultExecEnvRef   = "Device.SoftwareModules.ExecutionEnv.1"
+)
+
+type Parameter struct {
+	Value    string `yaml:"value"`
+	Type     string `yaml:"type,omitempty"`
+	Writable bool   `yaml:"writable"`
+}
+
+// a parameter is either given as a scalar (a writable string) or as a mapping
+func (p *Parameter) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*p = Parameter{Value: node.Value, Writable: true}
+		return nil
+	}
+	type Alias Parameter
+	alias := Alias{Writable: true}
+	if err := node.Decode(&alias); err != nil {
+		return err
+	}
+	*p = Parameter(alias)
+	return nil
+}
+
+// error raised by an operation on the parameter tree; reported to the ACS as a CWMP fault
+type Fault struct {
+	Code    uint
+	Message string
+	// faults of the individual parameters of a SetParameterValues
+	Parameters []messages.SetParameterValuesFaultStruct
+}
+
+func (f *Fault) Error() string {
+	return fmt.Sprintf("%s (faultcode: %d)", f.Message, f.Code)
+}
+
+func newFault(code uint, format string, args ...any) *Fault {
+	return &Fault{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// in-memory TR-181 data model; objects are kept explicitly so that (multi-instance) objects without
+// parameters can exist
+type ParameterTree struct {
+	mu      sync.Mutex
+	params  map[string]*Parameter
+	objects map[string]bool
+	// next instance number per multi-instance object
+	instances map[string]uint
+}
+
+func NewParameterTree(params map[string]Parameter) *ParameterTree {
+	t := &ParameterTree{
+		params:    make(map[string]*Parameter),
+		objects:   make(map[string]bool),
+		instances: make(map[string]uint),
+	}
+	for name, p := range params {
+		t.setLocked(name, p)
+	}
+	return t
+}
+
+func (t *ParameterTree) setLocked(name string, p Parameter) {
+	if len(p.Type) == 0 {
+		p.Type = messages.XsdString
+	}
+	t.params[name] = &p
+	t.addParentsLocked(name)
+}
+
+// register every object on the path of the given parameter (or object)
+func (t *ParameterTree) addParentsLocked(name string) {
+	for i := strings.Index(name, "."); i >= 0 && i < len(name); {
+		t.objects[name[:i+1]] = true
+		next := strings.Index(name[i+1:], ".")
+		if next < 0 {
+			break
+		}
+		i += next + 1
+	}
+}
+
+func (t *ParameterTree) addObjectLocked(path string) {
+	t.objects[path] = true
+	t.addParentsLocked(path)
+}
+
+// value of a single parameter; empty if not present
+func (t *ParameterTree) Value(name string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if p, found := t.params[name]; found {
+		return p.Value
+	}
+	return ""
+}
+
+// set a parameter, creating it (and its objects) if needed
+func (t *ParameterTree) Put(name string, p Parameter) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.setLocked(name, p)
+}
+
+// values of the given parameters; names ending with '.' select every parameter of the (partial) path
+func (t *ParameterTree) Get(names []string) ([]messages.ParameterValueStruct, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var selected []string
+	for _, name := range names {
+		if len(name) == 0 || strings.HasSuffix(name, ".") {
+			if len(name) > 0 && !t.objects[name] {
+				return nil, newFault(FaultInvalidParameterName, "Invalid parameter name '%s'", name)
+			}
+			selected = append(selected, t.paramsUnderLocked(name)...)
+		} else if _, found := t.params[name]; found {
+			selected = append(selected, name)
+		} else {
+			return nil, newFault(FaultInvalidParameterName, "Invalid parameter name '%s'", name)
+		}
+	}
+	values := make([]messages.ParameterValueStruct, 0, len(selected))
+	for _, name := range selected {
+		p := t.params[name]
+		values = append(values, messages.ParameterValueStruct{
+			Name:    configuration.T(name),
+			Content: messages.NodeStruct{Type: p.Type, Value: configuration.T(p.Value)},
+		})
+	}
+	return values, nil
+}
+
+func (t *ParameterTree) paramsUnderLocked(path string) []string {
+	var names []string
+	for name := range t.params {
+		if strings.HasPrefix(name, path) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// set the given parameters atomically; all of them must exist, be writable and be given valid values of their type.
+// The failure of each parameter is reported in the fault; a single failure also sets the code of the fault
+func (t *ParameterTree) Set(values []messages.ParameterValueStruct) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var faults []messages.SetParameterValuesFaultStruct
+	for _, v := range values {
+		if fault := t.checkValueLocked(v); fault != nil {
+			faults = append(faults, messages.SetParameterValuesFaultStruct{
+				ParameterName: v.Name.RawTemplate,
+				FaultCode:     fault.Code,
+				FaultString:   fault.Message,
+			})
+		}
+	}
+	if len(faults) > 0 {
+		fault := newFault(FaultInvalidArguments, "Invalid arguments")
+		if len(faults) == 1 {
+			fault = newFault(faults[0].FaultCode, "%s", faults[0].FaultString)
+		}
+		fault.Parameters = faults
+		return fault
+	}
+	for _, v := range values {
+		name := v.Name.RawTemplate
+		p := t.params[name]
+		p.Value = v.Content.Value.RawTemplate
+		t.applySideEffectsLocked(name, p.Value)
+	}
+	return nil
+}
+
+// the fault to report for setting the given value, nil if valid
+func (t *ParameterTree) checkValueLocked(v messages.ParameterValueStruct) *Fault {
+	name := v.Name.RawTemplate
+	p, found := t.params[name]
+	if !found {
+		return newFault(FaultInvalidParameterName, "Invalid parameter name '%s'", name)
+	}
+	if !p.Writable {
+		return newFault(FaultNonWritableParameter, "Attempt to set a non-writable parameter '%s'", name)
+	}
+	if len(v.Content.Type) > 0 && v.Content.Type != p.Type {
+		return newFault(FaultInvalidParameterType, "Invalid type '%s' for parameter '%s' of type '%s'", v.Content.Type, name, p.Type)
+	}
+	if err := messages.ValidateParameterValue(p.Type, v.Content.Value.RawTemplate); err != nil {
+		return newFault(FaultInvalidParameterValue, "Invalid value for parameter '%s': %s", name, err.Error())
+	}
+	return nil
+}
+
+// simulate the state transitions triggered by writing a parameter
+func (t *ParameterTree) applySideEffectsLocked(name, value string) {
+	if eu, found := strings.CutSuffix(name, ".RequestedState"); found && strings.HasPrefix(name, executionUnitTable) {
+		if status, found := t.params[eu+".Status"]; found && len(value) > 0 {
+			status.Value = value
+		}
+	}
+}
+
+// names of the parameters and objects below the given path; with nextLevel, only the direct children
+func (t *ParameterTree) Names(path string, nextLevel bool) ([]messages.ParameterInfoStruct, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(path) > 0 && !strings.HasSuffix(path, ".") {
+		p, found := t.params[path]
+		if !found {
+			return nil, newFault(FaultInvalidParameterName, "Invalid parameter name '%s'", path)
+		}
+		if nextLevel {
+			return nil, newFault(FaultInvalidArguments, "NextLevel is not allowed for parameter '%s'", path)
+		}
+		return []messages.ParameterInfoStruct{{Name: path, Writable: p.Writable}}, nil
+	}
+	if len(path) > 0 && !t.objects[path] {
+		return nil, newFault(FaultInvalidParameterName, "Invalid parameter name '%s'", path)
+	}
+
+	var infos []messages.ParameterInfoStruct
+	for name := range t.objects {
+		if strings.HasPrefix(name, path) && (!nextLevel || isChild(path, name)) {
+			infos = append(infos, messages.ParameterInfoStruct{Name: name, Writable: t.isTableLocked(name)})
+		}
+	}
+	for name, p := range t.params {
+		if strings.HasPrefix(name, path) && (!nextLevel || isChild(path, name)) {
+			infos = append(infos, messages.ParameterInfoStruct{Name: name, Writable: p.Writable})
+		}
+	}
+	slices.SortFunc(infos, func(a, b messages.ParameterInfoStruct) int { return strings.Compare(a.Name, b.Name) })
+	return infos, nil
+}
+
+// whether name is a direct child (parameter or object) of the object path
+func isChild(path, name string) bool {
+	rest := strings.TrimSuffix(strings.TrimPrefix(name, path), ".")
+	return len(rest) > 0 && !strings.Contains(rest, ".")
+}
+
+// a multi-instance object is one with numbered instances (or known to be a table)
+func (t *ParameterTree) isTableLocked(path string) bool {
+	if _, found := t.instances[path]; found {
+		return true
+	}
+	for name := range t.objects {
+		if isChild(path, name) {
+			if _, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, path), "."), 10, 32); err == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// create a new instance of a multi-instance object; returns the instance number
+func (t *ParameterTree) AddObject(path string) (uint, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !strings.HasSuffix(path, ".") || !t.objects[path] {
+		return 0, newFault(FaultInvalidParameterName, "Invalid object name '%s'", path)
+	}
+	return t.addInstanceLocked(path), nil
+}
+
+func (t *ParameterTree) addInstanceLocked(path string) uint {
+	next, found := t.instances[path]
+	if !found {
+		next = 1
+		for name := range t.objects {
+			if isChild(path, name) {
+				if n, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, path), "."), 10, 32); err == nil && uint(n) >= next {
+					next = uint(n) + 1
+				}
+			}
+		}
+	}
+	t.instances[path] = next + 1
+	t.addObjectLocked(fmt.Sprintf("%s%d.", path, next))
+	return next
+}
+
+// remove an object instance, along with everything below it
+func (t *ParameterTree) DeleteObject(path string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !strings.HasSuffix(path, ".") || !t.objects[path] {
+		return newFault(FaultInvalidParameterName, "Invalid object name '%s'", path)
+	}
+	t.deleteLocked(path)
+	return nil
+}
+
+func (t *ParameterTree) deleteLocked(path string) {
+	for name := range t.objects {
+		if strings.HasPrefix(name, path) {
+			delete(t.objects, name)
+		}
+	}
+	for name := range t.params {
+		if strings.HasPrefix(name, path) {
+			delete(t.params, name)
+		}
+	}
+}
+
+// locate the deployment unit with the given UUID; returns its object path (without trailing '.')
+func (t *ParameterTree) findDeploymentUnitLocked(uuid string) string {
+	for name, p := range t.params {
+		if strings.HasPrefix(name, deploymentUnitTable) && strings.HasSuffix(name, ".UUID") && p.Value == uuid {
+			return strings.TrimSuffix(name, ".UUID")
+		}
+	}
+	return ""
+}
+
+// apply a single ChangeDUState operation to the SoftwareModules objects
+func (t *ParameterTree) ApplyDUOperation(op messages.DUOperation) (messages.OpResultStruct, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch o := op.(type) {
+	case messages.InstallOpStruct:
+		return t.installLocked(o)
+	case messages.UpdateOpStruct:
+		return t.updateLocked(o)
+	case messages.UninstallOpStruct:
+		return t.uninstallLocked(o)
+	default:
+		return messages.OpResultStruct{}, newFault(FaultInvalidArguments, "Unsupported operation '%s'", op.GetOpType())
+	}
+}
+
+func (t *ParameterTree) installLocked(op messages.InstallOpStruct) (messages.OpResultStruct, error) {
+	uuid := op.UUID.RawTemplate
+	result := messages.OpResultStruct{UUID: uuid, CurrentState: "Failed"}
+	if len(uuid) > 0 && len(t.findDeploymentUnitLocked(uuid)) > 0 {
+		return result, newFault(FaultDuplicateDeploymentUnit, "Duplicate deployment unit '%s'", uuid)
+	}
+	execEnv := op.ExecutionEnvRef.RawTemplate
+	if len(execEnv) == 0 {
+		execEnv = defaultExecEnvRef
+	}
+	name := op.URL.RawTemplate[strings.LastIndex(op.URL.RawTemplate, "/")+1:]
+
+	t.addObjectLocked(deploymentUnitTable)
+	t.addObjectLocked(executionUnitTable)
+	du := fmt.Sprintf("%s%d", deploymentUnitTable, t.addInstanceLocked(deploymentUnitTable))
+	eu := fmt.Sprintf("%s%d", executionUnitTable, t.addInstanceLocked(executionUnitTable))
+	readOnly := func(value string) Parameter { return Parameter{Value: value} }
+	t.setLocked(du+".UUID", readOnly(uuid))
+	t.setLocked(du+".DUID", readOnly(strings.TrimPrefix(du, deploymentUnitTable)))
+	t.setLocked(du+".Name", readOnly(name))
+	t.setLocked(du+".Status", readOnly("Installed"))
+	t.setLocked(du+".Resolved", Parameter{Value: "true", Type: messages.XsdBoolean})
+	t.setLocked(du+".URL", readOnly(op.URL.RawTemplate))
+	t.setLocked(du+".Version", readOnly("1.0"))
+	t.setLocked(du+".ExecutionUnitList", readOnly(eu))
+	t.setLocked(du+".ExecutionEnvRef", readOnly(execEnv))
+	t.setLocked(eu+".EUID", readOnly(strings.TrimPrefix(eu, executionUnitTable)))
+	t.setLocked(eu+".Name", readOnly(name))
+	t.setLocked(eu+".Status", readOnly("Idle"))
+	t.setLocked(eu+".RequestedState", Parameter{Writable: true})
+	t.setLocked(eu+".AutoStart", Parameter{Value: "false", Type: messages.XsdBoolean, Writable: true})
+	t.setLocked(eu+".ExecutionEnvRef", readOnly(execEnv))
+
+	result.DeploymentUnitRef = du
+	result.Version = "1.0"
+	result.CurrentState = "Installed"
+	result.Resolved = true
+	result.ExecutionUnitRefList = eu
+	return result, nil
+}
+
+func (t *ParameterTree) updateLocked(op messages.UpdateOpStruct) (messages.OpResultStruct, error) {
+	uuid := op.UUID.RawTemplate
+	du := t.findDeploymentUnitLocked(uuid)
+	if len(du) == 0 {
+		return messages.OpResultStruct{UUID: uuid, CurrentState: "Failed"}, newFault(FaultUnknownDeploymentUnit, "Unknown deployment unit '%s'", uuid)
+	}
+	version := op.Version.RawTemplate
+	if len(version) == 0 {
+		version = t.params[du+".Version"].Value
+	}
+	if len(op.URL.RawTemplate) > 0 {
+		t.params[du+".URL"].Value = op.URL.RawTemplate
+	}
+	t.params[du+".Version"].Value = version
+	return messages.OpResultStruct{
+		UUID:                 uuid,
+		DeploymentUnitRef:    du,
+		Version:              version,
+		CurrentState:         "Installed",
+		Resolved:             true,
+		ExecutionUnitRefList: t.params[du+".ExecutionUnitList"].Value,
+	}, nil
+}
+
+func (t *ParameterTree) uninstallLocked(op messages.UninstallOpStruct) (messages.OpResultStruct, error) {
+	uuid := op.UUID.RawTemplate
+	du := t.findDeploymentUnitLocked(uuid)
+	if len(du) == 0 {
+		return messages.OpResultStruct{UUID: uuid, CurrentState: "Failed"}, newFault(FaultUnknownDeploymentUnit, "Unknown deployment unit '%s'", uuid)
+	}
+	result := messages.OpResultStruct{
+		UUID:              uuid,
+		DeploymentUnitRef: du,
+		Version:           t.params[du+".Version"].Value,
+		CurrentState:      "Uninstalled",
+	}
+	for _, eu := range strings.Split(t.params[du+".ExecutionUnitList"].Value, ",") {
+		if eu = strings.TrimSpace(eu); len(eu) > 0 {
+			t.deleteLocked(eu + ".")
+		}
+	}
+	t.deleteLocked(du + ".")
+	return result, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cpesim
+
+import (
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"errors"
+	"time"
+)
+
+// CWMP fault codes reported by the simulator
+const (
+	FaultMethodNotSupported      uint = 9000
+	FaultInternalError           uint = 9002
+	FaultInvalidArguments        uint = 9003
+	FaultInvalidParameterName    uint = 9005
+	FaultInvalidParameterType    uint = 9006
+	FaultInvalidParameterValue   uint = 9007
+	FaultNonWritableParameter    uint = 9008
+	FaultDuplicateDeploymentUnit uint = 9026
+	FaultUnknownDeploymentUnit   uint = 9028
+)
+
+const defaultDUFaultString = "Simulated deployment unit failure"
+
+// methods supported by the simulated CPE
+var supportedMethods = []string{
+	"GetRPCMethods",
+	"GetParameterNames",
+	"GetParameterValues",
+	"SetParameterValues",
+	"AddObject",
+	"DeleteObject",
+	"Reboot",
+	"ScheduleInform",
+	"ChangeDUState",
+}
+
+// answer an ACS request; failures are reported as CWMP faults
+func (s *Simulator) handleRPC(msg messages.Message) messages.Message {
+	resp, err := s.dispatchRPC(msg)
+	if err != nil {
+		var fault *Fault
+		if !errors.As(err, &fault) {
+			fault = newFault(FaultInternalError, "%s", err.Error())
+		}
+		tui.LogWarning("'%s' failed: %s", msg.GetName(), fault.Error())
+		resp := messages.NewFault(fault.Code, fault.Message)
+		resp.Detail.SetParameterValuesFault = fault.Parameters
+		return resp
+	}
+	return resp
+}
+
+func (s *Simulator) dispatchRPC(msg messages.Message) (messages.Message, error) {
+	switch m := msg.(type) {
+	case messages.GetRPCMethods:
+		return messages.GetRPCMethodsResponse{MethodList: messages.MethodListStruct{Methods: supportedMethods}}, nil
+	case messages.GetParameterNames:
+		infos, err := s.params.Names(m.ParameterPath.RawTemplate, m.NextLevel)
+		return messages.GetParameterNamesResponse{ParameterList: infos}, err
+	case messages.GetParameterValues:
+		names := make([]string, len(m.ParameterNames.Params))
+		for i, name := range m.ParameterNames.Params {
+			names[i] = name.RawTemplate
+		}
+		values, err := s.params.Get(names)
+		return messages.GetParameterValuesResponse{ParameterList: messages.ParameterValueListStruct{Params: values}}, err
+	case messages.SetParameterValues:
+		if err := s.params.Set(m.ParameterList.Params); err != nil {
+			return nil, err
+		}
+		s.params.Put("Device.ManagementServer.ParameterKey", Parameter{Value: m.ParameterKey})
+		return messages.SetParameterValuesResponse{Status: 0}, nil
+	case messages.AddObject:
+		instance, err := s.params.AddObject(m.ObjectName.RawTemplate)
+		return messages.AddObjectResponse{InstanceNumber: instance}, err
+	case messages.DeleteObject:
+		return messages.DeleteObjectResponse{}, s.params.DeleteObject(m.ObjectName.RawTemplate)
+	case messages.Reboot:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.reboot = true
+		s.rebootKey = m.CommandKey.RawTemplate
+		return messages.RebootResponse{}, nil
+	case messages.ScheduleInform:
+		commandKey := m.CommandKey.RawTemplate
+		time.AfterFunc(time.Duration(m.DelaySeconds)*time.Second, func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.queueEventLocked(messages.EventScheduled, "")
+			s.queueEventLocked(messages.EventMScheduleInform, commandKey)
+		})
+		return messages.ScheduleInformResponse{}, nil
+	case messages.ChangeDUState:
+		s.scheduleDUStateChange(m)
+		return messages.ChangeDUStateResponse{}, nil
+	default:
+		return nil, newFault(FaultMethodNotSupported, "Method not supported")
+	}
+}
+
+// apply the operations after the configured delay & report the outcome in a new session
+func (s *Simulator) scheduleDUStateChange(m messages.ChangeDUState) {
+	commandKey := m.CommandKey.RawTemplate
+	time.AfterFunc(s.config.DUStateChange.Delay, func() {
+		complete := messages.DUStateChangeComplete{CommandKey: commandKey}
+		for _, op := range m.Operations.Op {
+			complete.Results = append(complete.Results, s.applyDUOperation(op))
+		}
+		tui.LogNormal("DU state change '%s' completed", commandKey)
+		s.queueRequest(complete,
+			messages.EventStruct{EventCode: messages.EventDUStateChangeComplete},
+			messages.EventStruct{EventCode: messages.EventMChangeDUState, CommandKey: commandKey})
+	})
+}
+
+func (s *Simulator) applyDUOperation(op messages.DUOperation) messages.OpResultStruct {
+	start := time.Now().Format(time.RFC3339)
+	var result messages.OpResultStruct
+	var err error
+	if code := s.config.DUStateChange.FaultCode; code != 0 {
+		faultString := s.config.DUStateChange.FaultString
+		if len(faultString) == 0 {
+			faultString = defaultDUFaultString
+		}
+		result.UUID = duOperationUUID(op)
+		result.CurrentState = "Failed"
+		err = newFault(code, "%s", faultString)
+	} else {
+		result, err = s.params.ApplyDUOperation(op)
+	}
+	result.StartTime = start
+	result.CompleteTime = time.Now().Format(time.RFC3339)
+	if fault, ok := err.(*Fault); ok {
+		result.Fault = messages.FaultStruct{FaultCode: fault.Code, FaultString: fault.Message}
+	}
+	return result
+}
+
+func duOperationUUID(op messages.DUOperation) string {
+	switch o := op.(type) {
+	case messages.InstallOpStruct:
+		return o.UUID.RawTemplate
+	case messages.UpdateOpStruct:
+		return o.UUID.RawTemplate
+	case messages.UninstallOpStruct:
+		return o.UUID.RawTemplate
+	}
+	return ""
+}
@@ -12,55 +12,155 @@ import (
 	"github.com/nokia/corteca-cli/internal/tui"
 	"encoding/xml"
 	"errors"
-
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"net/url"
-	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 const (
-	DefaultCWMPPort = 7547
+	DefaultCWMPPort       = 7547
+	DefaultPassiveTimeout = 1 * time.Hour
 )
 
+// events of the Informs a passive device waits for to start its sequence
+var passiveEvents = []string{messages.EventPeriodic, messages.EventBoot, messages.EventBootStrap}
+
 func init() {
 	device.RegisterDeviceType("cwmp", NewCWMPDevice)
 	device.RegisterDeviceType("cwmps", NewCWMPDevice)
 }
 
 type CWMPDevice struct {
-	server    *http.Server
-	in        chan messages.Message
-	out       chan *messages.Envelope
+	acs      *acsServer
+	filter   DeviceFilter
+	cpe      *messages.DeviceIDStruct
+	endpoint *configuration.HttpClientEndpoint
+	in       chan *cpePost
+	// the post of the CPE the sequence has to reply to (only accessed by the sequence)
+	pending   *cpePost
 	log       io.Writer
+	idMutex   sync.Mutex
 	currentID string
+	// state of the CWMP session (guarded by idMutex)
+	state sessionState
+	// the CPE signalled NoMoreRequests within the session (guarded by idMutex)
+	noMoreRequests bool
+	// replies that could not share the envelope of a post, sent upon the next empty posts (guarded by idMutex)
+	deferred []*messages.Envelope
+	// contents of the latest Inform and of the RequestDownload within its session (guarded by idMutex); published
+	// to the template context by the sequence
+	inform          map[string]any
+	requestDownload map[string]any
+	// CWMP version negotiated upon the latest Inform (guarded by idMutex); announced in the InformResponse
+	// if the CPE listed its supported versions
+	version         messages.CWMPVersion
+	announceVersion bool
+	passive         bool
+	passiveTimeout  time.Duration
+	requestTimeout  time.Duration
+	uploadFolder    string
+	upload          *uploadReceiver
+	recorder        *sessionRecorder
+	// closed when the device is closed
+	closed chan struct{}
+	// closed when the CPE side can no longer send (or accept) messages, i.e. a replay ended
+	halted  chan struct{}
+	haltErr error
 }
 
 type CWMPConfig struct {
 	configuration.HttpClientEndpoint `yaml:",inline"`
-	Server                           configuration.HttpServerEndpoint `yaml:"server"`
+	DeviceId                         DeviceFilter                `yaml:"deviceId,omitempty"`
+	Passive                          bool                        `yaml:"passive,omitempty"`
+	PassiveTimeout                   time.Duration               `yaml:"passiveTimeout,omitempty"`
+	RequestTimeout                   time.Duration               `yaml:"requestTimeout,omitempty"`
+	UploadFolder                     configuration.TemplateField `yaml:"uploadFolder,omitempty"`
+	Record                           configuration.TemplateField `yaml:"record,omitempty"`
+	Server                           CWMPServerConfig            `yaml:"server"`
+}
+
+type CWMPServerConfig struct {
+	configuration.HttpServerEndpoint `yaml:",inline"`
+	Auth                             string                      `yaml:"auth,omitempty"`
+	Username                         configuration.TemplateField `yaml:"username,omitempty"`
+	Password                         configuration.TemplateField `yaml:"password,omitempty"`
+	Realm                            string                      `yaml:"realm,omitempty"`
+	UnknownDevices                   string                      `yaml:"unknownDevices,omitempty"`
+	ParkTimeout                      time.Duration               `yaml:"parkTimeout,omitempty"`
 }
 
 func (d *CWMPDevice) NewSessionID() {
 	if uuid, err := uuid.NewV7(); err != nil {
 		panic(err)
 	} else {
-		d.currentID = uuid.String()
+		d.SetSessionID(uuid.String())
 	}
 }
 
+// the session ID is also set by the HTTP handler, upon incoming requests
 func (d *CWMPDevice) SetSessionID(id string) {
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
 	d.currentID = id
 }
 
 func (d *CWMPDevice) ResetSessionID() {
-	d.currentID = ""
+	d.SetSessionID("")
+}
+
+func (d *CWMPDevice) sessionID() string {
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	return d.currentID
+}
+
+func (d *CWMPDevice) setInform(inform map[string]any) {
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	d.inform = inform
+	d.requestDownload = nil
+}
+
+func (d *CWMPDevice) setRequestDownload(request map[string]any) {
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	d.requestDownload = request
+}
+
+// let the following steps refer to the CPE identity, events and parameters of its latest Inform, and to the download
+// it requested; only called by the sequence, so that the Inform of another device cannot replace it in between
+func (d *CWMPDevice) publishInform() {
+	d.idMutex.Lock()
+	inform, requestDownload := d.inform, d.requestDownload
+	d.idMutex.Unlock()
+	if inform != nil {
+		configuration.GetCmdContext().SetDeviceSession(inform, requestDownload)
+	}
+}
+
+// select the version used for the rest of the session opened by the given Inform envelope
+func (d *CWMPDevice) negotiateVersion(inform *messages.Envelope) {
+	version := messages.NegotiateCWMPVersion(inform)
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	if version != d.version {
+		tui.LogNormal("Using CWMP version %s", version)
+	}
+	d.version = version
+	d.announceVersion = len(inform.GetSupportedCWMPVersions()) > 0
+}
+
+func (d *CWMPDevice) sessionVersion() (messages.CWMPVersion, bool) {
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	return d.version, d.announceVersion
 }
 
 func NewCWMPDevice(c *configuration.DeviceConfig, log io.Writer) (device.Device, error) {
@@ -70,15 +170,45 @@ func NewCWMPDevice(c *configuration.DeviceConfig, log io.Writer) (device.Device,
 	}
 
 	d := CWMPDevice{
-		log:       log,
-		in:        make(chan messages.Message),
-		out:       make(chan *messages.Envelope),
-		currentID: "",
+		filter:         cwmpconfig.DeviceId,
+		log:            log,
+		in:             make(chan *cpePost),
+		passive:        cwmpconfig.Passive,
+		passiveTimeout: cwmpconfig.PassiveTimeout,
+		requestTimeout: cwmpconfig.RequestTimeout,
+		uploadFolder:   cwmpconfig.UploadFolder.String(),
+		closed:         make(chan struct{}),
+	}
+	if d.passiveTimeout == 0 {
+		d.passiveTimeout = DefaultPassiveTimeout
+	}
+	if d.requestTimeout == 0 {
+		d.requestTimeout = DefaultRequestTimeout
+	}
+	if len(d.uploadFolder) == 0 {
+		d.uploadFolder = DefaultUploadFolder
+	}
+	if record := cwmpconfig.Record.String(); len(record) > 0 {
+		recorder, err := newSessionRecorder(record)
+		if err != nil {
+			return nil, err
+		}
+		d.recorder = recorder
 	}
-	if err := d.initServer(&cwmpconfig.Server); err != nil {
+	acs, err := acquireACSServer(&cwmpconfig.Server)
+	if err != nil {
+		d.recorder.close()
 		return nil, err
 	}
-	if err := d.sendConnectionRequest(&cwmpconfig.HttpClientEndpoint); err != nil {
+	d.acs = acs
+	acs.register(&d)
+	if d.passive {
+		// CPEs behind NAT cannot be reached; wait for them to connect on their own
+		tui.DisplaySuccessMsg("Waiting for CPE to send a periodic or boot Inform...")
+		return &d, nil
+	}
+	d.endpoint = &cwmpconfig.HttpClientEndpoint
+	if err := d.sendConnectionRequest(d.endpoint); err != nil {
 		tui.LogError("Failed sending connection request: %s", err.Error())
 	}
 	tui.DisplaySuccessMsg("Waiting for CPE to establish connection...")
@@ -87,72 +217,139 @@ func NewCWMPDevice(c *configuration.DeviceConfig, log io.Writer) (device.Device,
 
 func (d *CWMPDevice) BeginSequence() error {
 	d.ResetSessionID()
+	if d.passive {
+		return d.awaitPassiveSession()
+	}
 	ctx, cancel := context.WithTimeout(context.Background(), configuration.DefaultMaxTimeout)
 	defer cancel()
 	tui.LogNormal("Waiting for (ready) message...")
 	if _, err := d.expectRPC(ctx, func(m messages.Message) bool { return m == nil }); err != nil {
 		return err
 	}
+	d.publishInform()
 	return nil
 }
 
+// wait for a session opened by a periodic, boot or bootstrap Inform; sessions opened for other events are
+// answered and closed
+func (d *CWMPDevice) awaitPassiveSession() error {
+	ctx, cancel := context.WithTimeout(context.Background(), d.passiveTimeout)
+	defer cancel()
+	inform, err := d.expectRPC(ctx, isPassiveInform)
+	if err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("no periodic or boot Inform received within %s", d.passiveTimeout)
+		}
+		return err
+	}
+	tui.LogNormal("Received Inform from '%s'", inform.(messages.Inform).DeviceId.String())
+	if err := d.pushEnvelope(d.respondToRPC(inform)); err != nil {
+		return err
+	}
+	tui.LogNormal("Waiting for (ready) message...")
+	if _, err = d.expectRPC(ctx, func(m messages.Message) bool { return m == nil }); err != nil {
+		return err
+	}
+	d.publishInform()
+	return nil
+}
+
+func isPassiveInform(m messages.Message) bool {
+	inform, ok := m.(messages.Inform)
+	if !ok {
+		return false
+	}
+	for _, event := range passiveEvents {
+		if inform.Event.Contains(event, "") {
+			return true
+		}
+	}
+	return false
+}
+
 func (d *CWMPDevice) ExecuteCommand(ctx context.Context, cmd *configuration.SequenceCmd) (any, error) {
 	rpc, err := d.createRPCFromCmd(cmd)
+	if d.upload != nil {
+		defer d.closeUpload()
+	}
 	if err != nil {
 		return nil, err
-	} else {
-		d.NewSessionID()
-		tui.LogNormal("Sending '%s' RPC...", rpc.GetName())
-		env := d.newEnvelope(rpc)
-		if err := d.pushEnvelope(ctx, &env); err != nil {
-			return nil, err
-		}
+	}
+	resp, err := d.executeRPC(ctx, rpc)
+	if err != nil {
+		return resp, err
+	}
+	if d.upload != nil {
+		return d.upload.result(resp)
+	}
+	return resp, nil
+}
+
+// send an RPC and wait for its response; for asynchronous RPCs, the notification of their completion is returned
+func (d *CWMPDevice) executeRPC(ctx context.Context, rpc messages.SyncRPC) (messages.Message, error) {
+	if err := d.awaitControl(ctx); err != nil {
+		return nil, err
+	}
+	d.NewSessionID()
+	tui.LogNormal("Sending '%s' RPC...", rpc.GetName())
+	env := d.newEnvelope(rpc)
+	// the CPE must not interleave its own requests until the RPC has been answered (unless it has none left)
+	env.SetHoldRequests(!d.cpeHasNoMoreRequests())
+	if err := d.pushRequest(&env); err != nil {
+		return nil, err
 	}
 
 	tui.LogNormal("Waiting for response...")
-	resp, err := d.pullMessage(ctx)
+	resp, err := d.pullResponse(ctx, rpc, env.GetID())
 	if err != nil {
 		return nil, err
 	}
-	if fault, ok := resp.(messages.Fault); ok {
-		return nil, fmt.Errorf("%s (faultcode: %d)", fault.Detail.FaultString, fault.Detail.FaultCode)
+	if resp == nil {
+		return nil, fmt.Errorf("CPE closed the session without responding to '%s'", rpc.GetName())
+	} else if fault, ok := resp.(messages.Fault); ok {
+		return nil, fault.Detail
 	} else if err := rpc.ValidateResponse(resp); err != nil {
 		return nil, err
 	}
 
 	d.ResetSessionID()
-	if async, ok := rpc.(messages.AsyncRPC); ok {
+	if async, ok := rpc.(messages.AsyncRPC); ok && messages.IsPending(async, resp) {
 		return d.handleAsyncRPC(ctx, async)
 	}
 	return resp, nil
 }
 
+func (d *CWMPDevice) closeUpload() {
+	d.upload.close()
+	d.upload = nil
+}
+
 func (d *CWMPDevice) EndSequence() error {
-	ctx, cancel := context.WithTimeout(context.Background(), configuration.DefaultMaxTimeout)
-	defer cancel()
-	return d.pushEnvelope(ctx, nil)
+	if d.pending == nil || d.pending.isAbandoned() {
+		// the CPE awaits no reply; its session is over, any further post of it is answered with an empty response
+		d.pending = nil
+		d.terminateSession("sequence ended")
+		return nil
+	}
+	return d.pushEnvelope(nil)
 }
 
 func (d *CWMPDevice) GetProtocol() string {
 	return "cwmp"
 }
 
-func (c *CWMPDevice) Close() {
-	// Graceful shutdown
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-
-	if err := c.server.Shutdown(ctx); err != nil {
-		tui.LogError("Server Shutdown Failed: %s", err)
-		return
+func (d *CWMPDevice) Close() {
+	close(d.closed)
+	if d.acs != nil {
+		d.acs.release(d)
 	}
-	tui.LogNormal("Server stopped gracefully!")
+	d.recorder.close()
 }
 
 func (d *CWMPDevice) handleAsyncRPC(ctx context.Context, rpc messages.AsyncRPC) (messages.Message, error) {
 	tui.LogNormal("Expecting async notification for '%s'...", rpc.GetName())
 	// send blank response to end session
-	if err := d.pushEnvelope(ctx, nil); err != nil {
+	if err := d.pushEnvelope(nil); err != nil {
 		return nil, err
 	}
 	// wait until an RPC with the same command key arrives
@@ -161,7 +358,7 @@ func (d *CWMPDevice) handleAsyncRPC(ctx context.Context, rpc messages.AsyncRPC)
 		return nil, err
 	}
 	// respond to the notification RPC
-	if err := d.pushEnvelope(ctx, d.respondToRPC(notif)); err != nil {
+	if err := d.pushEnvelope(d.respondToRPC(notif)); err != nil {
 		return nil, err
 	}
 	// wait until a "ready" message arrives
@@ -204,96 +401,161 @@ func (c *CWMPDevice) sendConnectionRequest(cpe *configuration.HttpClientEndpoint
 	return nil
 }
 
-func (d *CWMPDevice) initServer(config *configuration.HttpServerEndpoint) error {
-
-	// TODO: if no url or server is empty we should assume listen on http://0.0.0.0:DefaultCWMPPort
-	u, err := url.Parse(config.Addr.String())
-	if err != nil {
-		return err
+// serve a request of the CPE's session (already parsed; env is nil for an empty post)
+func (d *CWMPDevice) handleSessionRequest(w http.ResponseWriter, r *http.Request, body []byte, env *messages.Envelope) {
+	d.log.Write(fmt.Appendf([]byte(""), "[%s] IN: %s %s %s\n",
+		time.Now().Format(time.DateTime),
+		r.Method,
+		r.RequestURI,
+		r.Proto))
+	d.log.Write(body)
+	if env != nil {
+		d.log.Write([]byte("\n"))
 	}
+	d.recorder.record(RecordedIn, env, body)
 
-	if u.Port() == "" {
-		u.Host = net.JoinHostPort(u.Host, strconv.Itoa(DefaultCWMPPort))
+	ctx, cancel := context.WithTimeout(r.Context(), d.requestTimeout)
+	defer cancel()
+	reply, err := d.processEnvelope(ctx, env)
+	statusCode := http.StatusOK
+	switch {
+	case errors.Is(err, errDeviceClosed):
+		http.Error(w, "Service unavailable; device closed", http.StatusServiceUnavailable)
+		return
+	case r.Context().Err() != nil:
+		// the CPE disconnected; there is nobody left to respond to
+		return
+	case err != nil:
+		// an empty response ends the session cleanly
+		tui.LogWarning("Closing CWMP session of %s: %s", r.RemoteAddr, err.Error())
+		statusCode = http.StatusNoContent
 	}
 
-	mux := http.NewServeMux()
-	mux.HandleFunc("/", d.handleHTTPRequest)
-	d.server = &http.Server{
-		Addr:    u.Host,
-		Handler: mux,
+	if reply == nil && d.acs != nil {
+		// an empty response ends the CWMP session
+		d.acs.endSession(w, r)
 	}
+	d.log.Write(fmt.Appendf([]byte(""), "[%s] OUT:\n", time.Now().Format(time.DateTime)))
+	d.writeHTTPResponse(w, statusCode, reply)
+	d.log.Write([]byte("\n--------------------------------------------------------------------------------\n"))
+}
 
-	tui.DisplaySuccessMsg(fmt.Sprintf("Starting CWMP server on %s...", d.server.Addr))
-	// Run server in a goroutine
-	go func() {
-		var err error
-		if u.Scheme == "http" {
-			err = d.server.ListenAndServe()
-		} else if u.Scheme == "https" {
-			err = d.server.ListenAndServeTLS(config.Certificate.String(), config.Key.String())
+// queue every message of an incoming envelope (nil for an empty post) to the sequence and collect the replies
+// into a single envelope; replies carrying another cwmp:ID are deferred to the next empty posts. Once ctx is done
+// (the CPE disconnected or the request deadline was exceeded), the session is terminated
+func (d *CWMPDevice) processEnvelope(ctx context.Context, env *messages.Envelope) (*messages.Envelope, error) {
+	if env == nil {
+		if deferred := d.popDeferred(); deferred != nil {
+			// already sent by the sequence as far as it knows; the CPE only gets it now
+			return deferred, nil
 		}
-		if err != nil && err != http.ErrServerClosed {
-			tui.LogError("Failed to start server: %s", err)
-			os.Exit(1)
+	}
+	if err := d.enterPost(env); err != nil {
+		return nil, err
+	}
+	// an empty post is queued as a single nil ("ready") message
+	incoming := []messages.Message{nil}
+	sessionID := ""
+	if env != nil {
+		incoming = orderIncomingMessages(env.Body.Messages)
+		sessionID = env.GetID()
+		if findInform(env) != nil {
+			d.negotiateVersion(env)
 		}
-	}()
-
-	return nil
-}
-
-func (d *CWMPDevice) handleHTTPRequest(w http.ResponseWriter, r *http.Request) {
-	// TODO: implement pullMessage and pullEnvelope to be context cancellation aware
-	if r.Method != "POST" {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
 	}
 
-	d.log.Write(fmt.Appendf([]byte(""), "[%s] IN: %s %s %s\n",
-		time.Now().Format(time.DateTime),
-		r.Method,
-		r.RequestURI,
-		r.Proto))
-	// parse response while logging it
-	tee := io.TeeReader(r.Body, d.log)
-	if env, err := messages.ParseEnvelopeXML(tee); err != nil {
-		if errors.Is(err, io.EOF) {
-			d.in <- nil
-		} else {
-			tui.LogError("Malformed request received: %s", err.Error())
-			http.Error(w, fmt.Sprintf("Bad request: %s", err.Error()), http.StatusBadRequest)
-			return
+	// CPE requests go first so they are answered immediately, while the last reply may carry the next ACS request
+	replies := make([]*messages.Envelope, 0, len(incoming))
+	for _, msg := range incoming {
+		post := &cpePost{msg: msg, id: sessionID, reply: make(chan *messages.Envelope, 1), abandoned: ctx.Done()}
+		select {
+		case d.in <- post:
+		case <-d.closed:
+			return nil, errDeviceClosed
+		case <-ctx.Done():
+			return nil, d.abandonPost(ctx, "the sequence did not accept it")
 		}
-	} else {
-		d.log.Write([]byte("\n"))
-		if len(env.Body.Messages) > 1 {
-			tui.LogNormal("%d messages received; ignoring all but first", len(env.Body.Messages))
-		} else if len(env.Body.Messages) == 0 {
-			tui.LogError("No message received")
-			http.Error(w, "Bad request; no message received", http.StatusBadRequest)
-			return
+		select {
+		case reply := <-post.reply:
+			replies = append(replies, reply)
+		case <-d.closed:
+			return nil, errDeviceClosed
+		case <-ctx.Done():
+			return nil, d.abandonPost(ctx, "the sequence did not reply")
 		}
-		d.in <- env.Body.Messages[0]
-		d.SetSessionID(env.GetID())
 	}
+	merged := mergeEnvelopes(replies)
+	if len(merged) == 0 {
+		return nil, nil
+	}
+	d.deferReplies(merged[1:])
+	return merged[0], nil
+}
 
-	d.log.Write(fmt.Appendf([]byte(""), "[%s] OUT:\n", time.Now().Format(time.DateTime)))
-	resp := <-d.out
-	d.writeHTTPResponse(w, http.StatusOK, resp)
-	d.log.Write([]byte("\n--------------------------------------------------------------------------------\n"))
+// terminate the session of a post that is abandoned because ctx is done
+func (d *CWMPDevice) abandonPost(ctx context.Context, reason string) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		err := fmt.Errorf("%s within %s", reason, d.requestTimeout)
+		d.terminateSession("%s", err.Error())
+		return err
+	}
+	d.terminateSession("CPE disconnected")
+	return ctx.Err()
 }
 
 // write a reply to the response
 func (d *CWMPDevice) writeHTTPResponse(w http.ResponseWriter, statusCode int, resp *messages.Envelope) {
-	w.WriteHeader(statusCode)
+	var body []byte
 	if resp != nil {
-		w.Header().Set("Content-Type", "text/xml; charset=utf-8")
-		tee := io.MultiWriter(w, d.log)
-		enc := xml.NewEncoder(tee)
-		enc.Indent("", "\t")
-		if err := enc.Encode(resp); err != nil {
+		var err error
+		if body, err = xml.MarshalIndent(resp, "", "\t"); err != nil {
 			panic(err)
 		}
+		w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	}
+	w.WriteHeader(statusCode)
+	w.Write(body)
+	d.log.Write(body)
+	d.recorder.record(RecordedOut, resp, body)
+}
+
+// move CPE-initiated requests ahead of responses to ACS-initiated RPCs, preserving relative order
+func orderIncomingMessages(msgs []messages.Message) []messages.Message {
+	ordered := make([]messages.Message, 0, len(msgs))
+	for _, m := range msgs {
+		if _, ok := m.(messages.ACSMethod); ok {
+			ordered = append(ordered, m)
+		}
+	}
+	for _, m := range msgs {
+		if _, ok := m.(messages.ACSMethod); !ok {
+			ordered = append(ordered, m)
+		}
 	}
+	return ordered
+}
+
+// combine the consecutive replies to the messages of a single HTTP post that carry the same cwmp:ID into one
+// envelope each (none if there is nothing to send); e.g. the responses to the CPE requests of the post share its
+// cwmp:ID, while the next ACS request carries its own
+func mergeEnvelopes(envs []*messages.Envelope) []*messages.Envelope {
+	var merged []*messages.Envelope
+	for _, env := range envs {
+		if env == nil {
+			continue
+		}
+		if last := len(merged) - 1; last >= 0 && merged[last].GetID() == env.GetID() {
+			merged[last].Body.Messages = append(merged[last].Body.Messages, env.Body.Messages...)
+			if env.GetHoldRequests() {
+				merged[last].SetHoldRequests(true)
+			}
+			continue
+		}
+		e := *env
+		e.Body.Messages = append([]messages.Message{}, env.Body.Messages...)
+		merged = append(merged, &e)
+	}
+	return merged
 }
 
 func (d *CWMPDevice) respondToRPC(r messages.Message) *messages.Envelope {
@@ -304,6 +566,35 @@ func (d *CWMPDevice) respondToRPC(r messages.Message) *messages.Envelope {
 	} else {
 		env = d.newEnvelope(messages.NewFault(8000, "Method not supported"))
 	}
+	switch m := r.(type) {
+	case messages.Inform:
+		d.setInform(m.TemplateContext())
+		if version, announce := d.sessionVersion(); announce {
+			env.SetUseCWMPVersion(version)
+		}
+	case messages.AutonomousTransferComplete:
+		transfer := "upload"
+		if m.IsDownload {
+			transfer = "download"
+		}
+		if m.FaultStruct.FaultCode != 0 {
+			tui.LogWarning("CPE reported a failed autonomous %s of '%s': %s", transfer, m.TransferURL, m.FaultStruct.Error())
+		} else {
+			tui.LogNormal("CPE reported an autonomous %s of '%s'", transfer, m.TransferURL)
+		}
+	case messages.AutonomousDUStateChangeComplete:
+		for _, result := range m.Results {
+			if result.Fault.FaultCode != 0 {
+				tui.LogWarning("CPE reported a failed autonomous %s of DU '%s': %s", result.OperationPerformed, result.UUID, result.Fault.Error())
+			} else {
+				tui.LogNormal("CPE reported an autonomous %s of DU '%s' (state: %s)", result.OperationPerformed, result.UUID, result.CurrentState)
+			}
+		}
+	case messages.RequestDownload:
+		// never acted upon by corteca; the following steps may react to it with a Download
+		tui.LogNormal("CPE requested the download of a '%s' file", m.FileType)
+		d.setRequestDownload(m.TemplateContext())
+	}
 	return &env
 }
 
@@ -324,6 +615,49 @@ func (d *CWMPDevice) createRPCFromCmd(cmd *configuration.SequenceCmd) (messages.
 		return m, cmd.Decode(&m)
 	case messages.SetParameterValues{}.GetName():
 		var m messages.SetParameterValues
+		if err := cmd.Decode(&m); err != nil {
+			return nil, err
+		}
+		return m, m.ValidateValues()
+	case messages.AddObject{}.GetName():
+		var m messages.AddObject
+		return m, cmd.Decode(&m)
+	case messages.DeleteObject{}.GetName():
+		var m messages.DeleteObject
+		return m, cmd.Decode(&m)
+	case messages.Download{}.GetName():
+		var m messages.Download
+		if err := cmd.Decode(&m); err != nil {
+			return nil, err
+		}
+		if m.ServeArtifact {
+			return m, d.serveArtifact(&m)
+		}
+		return m, nil
+	case messages.Upload{}.GetName():
+		var m messages.Upload
+		if err := cmd.Decode(&m); err != nil {
+			return nil, err
+		}
+		if len(m.URL.String()) == 0 {
+			// no URL given; corteca receives the upload
+			return m, d.receiveUpload(&m)
+		}
+		return m, nil
+	case messages.ScheduleInform{}.GetName():
+		var m messages.ScheduleInform
+		return m, cmd.Decode(&m)
+	case messages.Reboot{}.GetName():
+		var m messages.Reboot
+		return m, cmd.Decode(&m)
+	case messages.FactoryReset{}.GetName():
+		var m messages.FactoryReset
+		return m, cmd.Decode(&m)
+	case messages.GetParameterAttributes{}.GetName():
+		var m messages.GetParameterAttributes
+		return m, cmd.Decode(&m)
+	case messages.SetParameterAttributes{}.GetName():
+		var m messages.SetParameterAttributes
 		return m, cmd.Decode(&m)
 	default:
 		return nil, fmt.Errorf("unknown RPC '%s'", rpcName)
@@ -339,38 +673,77 @@ func (d *CWMPDevice) expectRPC(ctx context.Context, matcher func(messages.Messag
 		}
 		if matcher(rpc) {
 			return rpc, nil
+		} else if rpc == nil {
+			// CPE has nothing more to send; end its session and keep waiting
+			if err := d.pushEnvelope(nil); err != nil {
+				return nil, err
+			}
 		} else {
 			env := d.respondToRPC(rpc)
-			if err := d.pushEnvelope(ctx, env); err != nil {
+			if err := d.pushEnvelope(env); err != nil {
 				return nil, err
 			}
 		}
 	}
 }
 
+// pull the response to an ACS-initiated RPC, sent with the given cwmp:ID; CPE requests arriving in the meantime
+// (despite HoldRequests) are answered
+func (d *CWMPDevice) pullResponse(ctx context.Context, rpc messages.SyncRPC, id string) (messages.Message, error) {
+	for {
+		msg, err := d.pullMessage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if inform, ok := msg.(messages.Inform); ok {
+			// the session carrying the request is gone along with it
+			if err := d.pushEnvelope(d.respondToRPC(inform)); err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("CPE opened a new session without responding to '%s'", rpc.GetName())
+		}
+		if _, ok := msg.(messages.ACSMethod); !ok {
+			if msg != nil && len(d.pending.id) > 0 && d.pending.id != id {
+				return nil, fmt.Errorf("'%s' carries cwmp:ID '%s' instead of '%s' of the '%s' request", msg.GetName(), d.pending.id, id, rpc.GetName())
+			}
+			return msg, nil
+		}
+		tui.LogWarning("CPE sent '%s' while requests were held", msg.GetName())
+		if err := d.pushEnvelope(d.respondToRPC(msg)); err != nil {
+			return nil, err
+		}
+	}
+}
+
 func (d *CWMPDevice) newEnvelope(msg ...messages.Message) messages.Envelope {
 	env := messages.Envelope{}
 	env.Header = &messages.EnvelopeHeader{
-		ID: messages.IDStruct{MustUnderstand: "1", Value: d.currentID},
+		ID: messages.IDStruct{MustUnderstand: "1", Value: d.sessionID()},
 	}
 	env.Body = messages.EnvelopeBody{Messages: msg}
+	env.Version, _ = d.sessionVersion()
 	return env
 }
 
+// pull the next message posted by the CPE, which becomes the pending post to reply to
 func (d *CWMPDevice) pullMessage(ctx context.Context) (messages.Message, error) {
-	select {
-	case rpc := <-d.in:
-		return rpc, nil
-	case <-ctx.Done():
-		return nil, fmt.Errorf("timeout while waiting for incoming message")
-	}
-}
-
-func (d *CWMPDevice) pushEnvelope(ctx context.Context, env *messages.Envelope) error {
-	select {
-	case d.out <- env:
-		return nil
-	case <-ctx.Done():
-		return fmt.Errorf("timeout while sending message")
+	for {
+		select {
+		case post := <-d.in:
+			if post.isAbandoned() {
+				// the CPE gave up on this post meanwhile
+				continue
+			}
+			d.pending = post
+			if _, ok := post.msg.(messages.ACSMethod); ok {
+				// the response to a CPE request carries the same cwmp:ID
+				d.SetSessionID(post.id)
+			}
+			return post.msg, nil
+		case <-d.halted:
+			return nil, d.haltErr
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timeout while waiting for incoming message")
+		}
 	}
 }
@@ -0,0 +1,77 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"strings"
+)
+
+// walk the data model one object level at a time with GetParameterNames, reading the parameters of each
+// object with a single GetParameterValues
+func (d *CWMPDevice) ReadDataModel(ctx context.Context, path string, depth int) ([]device.Parameter, error) {
+	if !strings.HasSuffix(path, ".") {
+		return d.readParameterValues(ctx, []string{path})
+	}
+	resp, err := d.executeRPC(ctx, messages.GetParameterNames{ParameterPath: configuration.T(path), NextLevel: true})
+	if err != nil {
+		return nil, err
+	}
+	params := []device.Parameter{{Name: path}}
+	var names, objects []string
+	for _, info := range resp.(messages.GetParameterNamesResponse).ParameterList {
+		switch {
+		case info.Name == path:
+			// some CPEs include the object itself
+		case strings.HasSuffix(info.Name, "."):
+			objects = append(objects, info.Name)
+		default:
+			names = append(names, info.Name)
+		}
+	}
+	if len(names) > 0 {
+		values, err := d.readParameterValues(ctx, names)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, values...)
+	}
+	for _, object := range objects {
+		if depth == 1 {
+			params = append(params, device.Parameter{Name: object})
+			continue
+		}
+		children, err := d.ReadDataModel(ctx, object, max(depth-1, 0))
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, children...)
+	}
+	return params, nil
+}
+
+// read the whole sub-tree with a single GetParameterValues of the partial path
+func (d *CWMPDevice) ReadParameterValues(ctx context.Context, path string) ([]device.Parameter, error) {
+	return d.readParameterValues(ctx, []string{path})
+}
+
+func (d *CWMPDevice) readParameterValues(ctx context.Context, names []string) ([]device.Parameter, error) {
+	var rpc messages.GetParameterValues
+	for _, name := range names {
+		rpc.ParameterNames.Params = append(rpc.ParameterNames.Params, configuration.T(name))
+	}
+	resp, err := d.executeRPC(ctx, rpc)
+	if err != nil {
+		return nil, err
+	}
+	var params []device.Parameter
+	for _, p := range resp.(messages.GetParameterValuesResponse).ParameterList.Params {
+		params = append(params, device.Parameter{Name: p.Name.RawTemplate, Value: p.Content.Value.RawTemplate})
+	}
+	return params, nil
+}
@@ -0,0 +1,26 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+)
+
+// identity of the CPE(s) a device accepts sessions from; empty fields match any value
+type DeviceFilter struct {
+	OUI          configuration.TemplateField `yaml:"oui,omitempty"`
+	ProductClass configuration.TemplateField `yaml:"productClass,omitempty"`
+	SerialNumber configuration.TemplateField `yaml:"serialNumber,omitempty"`
+}
+
+func (f DeviceFilter) Matches(id messages.DeviceIDStruct) bool {
+	return matchField(f.OUI, id.OUI) && matchField(f.ProductClass, id.ProductClass) && matchField(f.SerialNumber, id.SerialNumber)
+}
+
+func matchField(filter configuration.TemplateField, value string) bool {
+	expected := filter.String()
+	return len(expected) == 0 || expected == value
+}
@@ -0,0 +1,48 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"encoding/xml"
+	"fmt"
+)
+
+type AddObject struct {
+	XMLName      xml.Name                    `xml:"AddObject" yaml:"-"`
+	ObjectName   configuration.TemplateField `yaml:"ObjectName"`
+	ParameterKey configuration.TemplateField `yaml:"ParameterKey"`
+}
+
+func (msg AddObject) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "AddObject")
+	type Alias AddObject
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg AddObject) GetName() string { return "AddObject" }
+func (msg AddObject) ValidateResponse(resp Message) error {
+	if r, ok := resp.(AddObjectResponse); ok {
+		if r.InstanceNumber == 0 {
+			return fmt.Errorf("invalid instance number returned for object '%s'", msg.ObjectName.String())
+		}
+		return nil
+	}
+	return ExpectMessage[AddObjectResponse](resp)
+}
+
+type AddObjectResponse struct {
+	XMLName        xml.Name `xml:"AddObjectResponse" yaml:"-"`
+	InstanceNumber uint     `yaml:"InstanceNumber"`
+	Status         uint     `yaml:"Status"`
+}
+
+func (msg AddObjectResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "AddObjectResponse")
+	type Alias AddObjectResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg AddObjectResponse) GetName() string { return "AddObjectResponse" }
@@ -0,0 +1,150 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	AddObjectInputXML = `<cwmp:AddObject>
+  <ObjectName>Device.Firewall.Chain.1.Rule.</ObjectName>
+  <ParameterKey>addrule</ParameterKey>
+</cwmp:AddObject>`
+
+	AddObjectInputYAML = `ObjectName: Device.Firewall.Chain.1.Rule.
+ParameterKey: addrule
+`
+
+	AddObjectResponseInputXML = `<cwmp:AddObjectResponse>
+  <InstanceNumber>3</InstanceNumber>
+  <Status>0</Status>
+</cwmp:AddObjectResponse>`
+
+	AddObjectResponseInputYAML = `InstanceNumber: 3
+Status: 0
+`
+)
+
+var AddObjectInputMsg = messages.AddObject{
+	ObjectName:   configuration.T("Device.Firewall.Chain.1.Rule."),
+	ParameterKey: configuration.T("addrule"),
+}
+
+var AddObjectResponseInputMsg = messages.AddObjectResponse{
+	InstanceNumber: 3,
+	Status:         0,
+}
+
+func TestAddObjectParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(AddObjectInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.AddObject{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, "Device.Firewall.Chain.1.Rule.", msg.ObjectName.String())
+	assert.Equal(t, "addrule", msg.ParameterKey.String())
+}
+
+func TestAddObjectSerializeToXML(t *testing.T) {
+	msg := AddObjectInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, AddObjectInputXML, buf.String())
+}
+
+func TestAddObjectParseFromYAML(t *testing.T) {
+	buf := bytes.NewBufferString(AddObjectInputYAML)
+	dec := yaml.NewDecoder(buf)
+	msg := messages.AddObject{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing YAML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, "Device.Firewall.Chain.1.Rule.", msg.ObjectName.String())
+	assert.Equal(t, "addrule", msg.ParameterKey.String())
+}
+
+func TestAddObjectSerializeToYAML(t *testing.T) {
+	msg := AddObjectInputMsg
+	outbuf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := yaml.NewEncoder(outbuf)
+	enc.SetIndent(4)
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating YAML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, AddObjectInputYAML, outbuf.String())
+}
+
+func TestAddObjectResponseParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(AddObjectResponseInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.AddObjectResponse{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, uint(3), msg.InstanceNumber)
+	assert.Equal(t, uint(0), msg.Status)
+}
+
+func TestAddObjectResponseSerializeToXML(t *testing.T) {
+	msg := AddObjectResponseInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, AddObjectResponseInputXML, buf.String())
+}
+
+func TestAddObjectResponseParseFromYAML(t *testing.T) {
+	buf := bytes.NewBufferString(AddObjectResponseInputYAML)
+	dec := yaml.NewDecoder(buf)
+	msg := messages.AddObjectResponse{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing YAML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, uint(3), msg.InstanceNumber)
+	assert.Equal(t, uint(0), msg.Status)
+}
+
+func TestAddObjectValidateResponse(t *testing.T) {
+	msg := AddObjectInputMsg
+	assert.NoError(t, msg.ValidateResponse(AddObjectResponseInputMsg))
+	assert.Error(t, msg.ValidateResponse(messages.AddObjectResponse{}))
+	assert.Error(t, msg.ValidateResponse(messages.DeleteObjectResponse{}))
+}
+
+func TestAddObjectResponseReadInstanceNumber(t *testing.T) {
+	configuration.ResetContext()
+	defer configuration.ResetContext()
+	configuration.GetCmdContext().SetStepResult("rule", AddObjectResponseInputMsg)
+
+	value := configuration.T("Device.Firewall.Chain.1.Rule.${.steps.rule.InstanceNumber}.")
+	assert.Equal(t, "Device.Firewall.Chain.1.Rule.3.", value.String())
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"encoding/xml"
+)
+
+// outcome of deployment unit operations not requested by the ACS
+type AutonomousDUStateChangeComplete struct {
+	XMLName xml.Name              `xml:"AutonomousDUStateChangeComplete" yaml:"-"`
+	Results []AutonOpResultStruct `xml:"Results>AutonOpResultStruct" yaml:"Results"`
+}
+
+func (msg AutonomousDUStateChangeComplete) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "AutonomousDUStateChangeComplete")
+	type Alias AutonomousDUStateChangeComplete
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+// OpResultStruct along with the operation performed (Install, Update or Uninstall)
+type AutonOpResultStruct struct {
+	XMLName              xml.Name    `xml:"AutonOpResultStruct" yaml:"-"`
+	UUID                 string      `yaml:"UUID"`
+	DeploymentUnitRef    string      `yaml:"DeploymentUnitRef"`
+	Version              string      `yaml:"Version"`
+	CurrentState         string      `yaml:"CurrentState"`
+	Resolved             bool        `yaml:"Resolved"`
+	ExecutionUnitRefList string      `yaml:"ExecutionUnitRefList"`
+	StartTime            string      `yaml:"StartTime"`
+	CompleteTime         string      `yaml:"CompleteTime"`
+	Fault                FaultStruct `yaml:"Fault"`
+	OperationPerformed   string      `yaml:"OperationPerformed"`
+	// ExecutionUnitRefList split into the individual references
+	ExecutionUnitRefs []string `xml:"-" yaml:"ExecutionUnitRefs,omitempty"`
+}
+
+func (r *AutonOpResultStruct) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	type Alias AutonOpResultStruct
+	if err := dec.DecodeElement((*Alias)(r), &start); err != nil {
+		return err
+	}
+	r.ExecutionUnitRefs = splitRefList(r.ExecutionUnitRefList)
+	return nil
+}
+
+func (m AutonomousDUStateChangeComplete) GetName() string { return "AutonomousDUStateChangeComplete" }
+func (m AutonomousDUStateChangeComplete) ValidateResponse(msg Message) error {
+	return ExpectMessage[AutonomousDUStateChangeCompleteResponse](msg)
+}
+func (m AutonomousDUStateChangeComplete) GenerateResponse() Message {
+	return AutonomousDUStateChangeCompleteResponse{}
+}
+
+type AutonomousDUStateChangeCompleteResponse struct {
+	XMLName xml.Name `xml:"AutonomousDUStateChangeCompleteResponse" yaml:"-"`
+}
+
+func (msg AutonomousDUStateChangeCompleteResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "AutonomousDUStateChangeCompleteResponse")
+	type Alias AutonomousDUStateChangeCompleteResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (m AutonomousDUStateChangeCompleteResponse) GetName() string {
+	return "AutonomousDUStateChangeCompleteResponse"
+}
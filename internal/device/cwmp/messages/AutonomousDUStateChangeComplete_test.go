@@ -0,0 +1,95 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	AutonomousDUStateChangeCompleteInputXML = `<cwmp:AutonomousDUStateChangeComplete>
+  <Results>
+    <AutonOpResultStruct>
+      <UUID>c0c4328b-18a4-4b3b-b1da-e8ea8d8f457d</UUID>
+      <DeploymentUnitRef>Device.SoftwareModules.DeploymentUnit.2</DeploymentUnitRef>
+      <Version>2.0.0</Version>
+      <CurrentState>Installed</CurrentState>
+      <Resolved>true</Resolved>
+      <ExecutionUnitRefList>Device.SoftwareModules.ExecutionUnit.3,Device.SoftwareModules.ExecutionUnit.4</ExecutionUnitRefList>
+      <StartTime>2026-04-08T10:00:00Z</StartTime>
+      <CompleteTime>2026-04-08T10:01:30Z</CompleteTime>
+      <Fault>
+        <FaultCode>0</FaultCode>
+        <FaultString></FaultString>
+      </Fault>
+      <OperationPerformed>Update</OperationPerformed>
+    </AutonOpResultStruct>
+  </Results>
+</cwmp:AutonomousDUStateChangeComplete>`
+
+	AutonomousDUStateChangeCompleteResponseInputXML = `<cwmp:AutonomousDUStateChangeCompleteResponse></cwmp:AutonomousDUStateChangeCompleteResponse>`
+)
+
+var AutonomousDUStateChangeCompleteInputMsg = messages.AutonomousDUStateChangeComplete{
+	Results: []messages.AutonOpResultStruct{{
+		UUID:                 "c0c4328b-18a4-4b3b-b1da-e8ea8d8f457d",
+		DeploymentUnitRef:    "Device.SoftwareModules.DeploymentUnit.2",
+		Version:              "2.0.0",
+		CurrentState:         "Installed",
+		Resolved:             true,
+		ExecutionUnitRefList: "Device.SoftwareModules.ExecutionUnit.3,Device.SoftwareModules.ExecutionUnit.4",
+		StartTime:            "2026-04-08T10:00:00Z",
+		CompleteTime:         "2026-04-08T10:01:30Z",
+		OperationPerformed:   "Update",
+	}},
+}
+
+func TestAutonomousDUStateChangeCompleteParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(AutonomousDUStateChangeCompleteInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.AutonomousDUStateChangeComplete{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	require.Len(t, msg.Results, 1)
+	result := msg.Results[0]
+	assert.Equal(t, "Update", result.OperationPerformed)
+	assert.Equal(t, "Device.SoftwareModules.DeploymentUnit.2", result.DeploymentUnitRef)
+	assert.True(t, result.Resolved)
+	assert.Equal(t, []string{"Device.SoftwareModules.ExecutionUnit.3", "Device.SoftwareModules.ExecutionUnit.4"}, result.ExecutionUnitRefs)
+}
+
+func TestAutonomousDUStateChangeCompleteSerializeToXML(t *testing.T) {
+	msg := AutonomousDUStateChangeCompleteInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, AutonomousDUStateChangeCompleteInputXML, buf.String())
+}
+
+func TestAutonomousDUStateChangeCompleteGenerateResponse(t *testing.T) {
+	resp := AutonomousDUStateChangeCompleteInputMsg.GenerateResponse()
+	assert.NoError(t, AutonomousDUStateChangeCompleteInputMsg.ValidateResponse(resp))
+
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	if err := enc.Encode(resp); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, AutonomousDUStateChangeCompleteResponseInputXML, buf.String())
+}
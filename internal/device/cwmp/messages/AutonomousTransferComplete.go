@@ -0,0 +1,51 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"encoding/xml"
+)
+
+// completion of a transfer not requested by the ACS (e.g. a firmware upgrade triggered locally)
+type AutonomousTransferComplete struct {
+	XMLName        xml.Name    `xml:"AutonomousTransferComplete" yaml:"-"`
+	AnnounceURL    string      `yaml:"AnnounceURL"`
+	TransferURL    string      `yaml:"TransferURL"`
+	IsDownload     bool        `yaml:"IsDownload"`
+	FileType       string      `yaml:"FileType"`
+	FileSize       uint        `yaml:"FileSize"`
+	TargetFileName string      `yaml:"TargetFileName"`
+	FaultStruct    FaultStruct `yaml:"FaultStruct"`
+	StartTime      string      `yaml:"StartTime"`
+	CompleteTime   string      `yaml:"CompleteTime"`
+}
+
+func (msg AutonomousTransferComplete) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "AutonomousTransferComplete")
+	type Alias AutonomousTransferComplete
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (m AutonomousTransferComplete) GetName() string { return "AutonomousTransferComplete" }
+func (m AutonomousTransferComplete) ValidateResponse(msg Message) error {
+	return ExpectMessage[AutonomousTransferCompleteResponse](msg)
+}
+func (m AutonomousTransferComplete) GenerateResponse() Message {
+	return AutonomousTransferCompleteResponse{}
+}
+
+type AutonomousTransferCompleteResponse struct {
+	XMLName xml.Name `xml:"AutonomousTransferCompleteResponse" yaml:"-"`
+}
+
+func (msg AutonomousTransferCompleteResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "AutonomousTransferCompleteResponse")
+	type Alias AutonomousTransferCompleteResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (m AutonomousTransferCompleteResponse) GetName() string {
+	return "AutonomousTransferCompleteResponse"
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	AutonomousTransferCompleteInputXML = `<cwmp:AutonomousTransferComplete>
+  <AnnounceURL></AnnounceURL>
+  <TransferURL>http://example.com/fw.bin</TransferURL>
+  <IsDownload>true</IsDownload>
+  <FileType>1 Firmware Upgrade Image</FileType>
+  <FileSize>1048576</FileSize>
+  <TargetFileName>fw.bin</TargetFileName>
+  <FaultStruct>
+    <FaultCode>0</FaultCode>
+    <FaultString></FaultString>
+  </FaultStruct>
+  <StartTime>2026-04-08T10:00:00Z</StartTime>
+  <CompleteTime>2026-04-08T10:01:30Z</CompleteTime>
+</cwmp:AutonomousTransferComplete>`
+
+	AutonomousTransferCompleteInputYAML = `AnnounceURL: ""
+TransferURL: http://example.com/fw.bin
+IsDownload: true
+FileType: 1 Firmware Upgrade Image
+FileSize: 1048576
+TargetFileName: fw.bin
+FaultStruct:
+    FaultCode: 0
+    FaultString: ""
+StartTime: "2026-04-08T10:00:00Z"
+CompleteTime: "2026-04-08T10:01:30Z"
+`
+
+	AutonomousTransferCompleteResponseInputXML = `<cwmp:AutonomousTransferCompleteResponse></cwmp:AutonomousTransferCompleteResponse>`
+)
+
+var AutonomousTransferCompleteInputMsg = messages.AutonomousTransferComplete{
+	TransferURL:    "http://example.com/fw.bin",
+	IsDownload:     true,
+	FileType:       "1 Firmware Upgrade Image",
+	FileSize:       1048576,
+	TargetFileName: "fw.bin",
+	StartTime:      "2026-04-08T10:00:00Z",
+	CompleteTime:   "2026-04-08T10:01:30Z",
+}
+
+func TestAutonomousTransferCompleteParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(AutonomousTransferCompleteInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.AutonomousTransferComplete{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	msg.XMLName = xml.Name{}
+	assert.Equal(t, AutonomousTransferCompleteInputMsg, msg)
+}
+
+func TestAutonomousTransferCompleteSerializeToXML(t *testing.T) {
+	msg := AutonomousTransferCompleteInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, AutonomousTransferCompleteInputXML, buf.String())
+}
+
+func TestAutonomousTransferCompleteSerializeToYAML(t *testing.T) {
+	msg := AutonomousTransferCompleteInputMsg
+	outbuf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := yaml.NewEncoder(outbuf)
+	enc.SetIndent(4)
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating YAML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, AutonomousTransferCompleteInputYAML, outbuf.String())
+}
+
+func TestAutonomousTransferCompleteGenerateResponse(t *testing.T) {
+	resp := AutonomousTransferCompleteInputMsg.GenerateResponse()
+	assert.NoError(t, AutonomousTransferCompleteInputMsg.ValidateResponse(resp))
+
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	if err := enc.Encode(resp); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, AutonomousTransferCompleteResponseInputXML, buf.String())
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// version of the CWMP protocol, i.e. the minor version x of the urn:dslforum-org:cwmp-1-x namespace
+type CWMPVersion int
+
+const (
+	CWMPVersion10 CWMPVersion = iota
+	CWMPVersion11
+	CWMPVersion12
+	CWMPVersion13
+	CWMPVersion14
+	// highest version supported by corteca
+	LatestCWMPVersion = CWMPVersion14
+)
+
+const cwmpNamespacePrefix = "urn:dslforum-org:cwmp-1-"
+
+func (v CWMPVersion) Namespace() string {
+	return fmt.Sprintf("%s%d", cwmpNamespacePrefix, v)
+}
+
+func (v CWMPVersion) String() string {
+	return fmt.Sprintf("1.%d", v)
+}
+
+// parse a version in the form "1.x"
+func ParseCWMPVersion(s string) (CWMPVersion, error) {
+	minor, found := strings.CutPrefix(strings.TrimSpace(s), "1.")
+	if v, err := strconv.Atoi(minor); found && err == nil && v >= 0 {
+		return CWMPVersion(v), nil
+	}
+	return 0, fmt.Errorf("invalid CWMP version '%s'", s)
+}
+
+// version of a urn:dslforum-org:cwmp-1-x namespace
+func parseCWMPNamespace(ns string) (CWMPVersion, bool) {
+	minor, found := strings.CutPrefix(ns, cwmpNamespacePrefix)
+	if v, err := strconv.Atoi(minor); found && err == nil && v >= 0 {
+		return CWMPVersion(v), true
+	}
+	return 0, false
+}
+
+func (v CWMPVersion) MarshalYAML() (any, error) {
+	return v.String(), nil
+}
+
+func (v *CWMPVersion) UnmarshalYAML(value *yaml.Node) error {
+	var err error
+	*v, err = ParseCWMPVersion(value.Value)
+	return err
+}
+
+// version to use for the rest of the session opened by the given Inform envelope: the highest version listed in
+// its SupportedCWMPVersions header (CWMP 1.4+), otherwise the version of its namespace, up to the latest version
+// supported by corteca
+func NegotiateCWMPVersion(inform *Envelope) CWMPVersion {
+	if supported := inform.GetSupportedCWMPVersions(); len(supported) > 0 {
+		negotiated := CWMPVersion(-1)
+		for _, v := range supported {
+			if v <= LatestCWMPVersion && v > negotiated {
+				negotiated = v
+			}
+		}
+		if negotiated >= 0 {
+			return negotiated
+		}
+	}
+	return min(inform.Version, LatestCWMPVersion)
+}
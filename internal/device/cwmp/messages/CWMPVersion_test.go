@@ -0,0 +1,124 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	CWMP12EnvelopeInputXML = `<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/" xmlns:cwmp="urn:dslforum-org:cwmp-1-2">
+  <soapenv:Header>
+    <cwmp:ID soapenv:mustUnderstand="1">1</cwmp:ID>
+  </soapenv:Header>
+  <soapenv:Body>
+    <cwmp:InformResponse><MaxEnvelopes>1</MaxEnvelopes></cwmp:InformResponse>
+  </soapenv:Body>
+</soapenv:Envelope>`
+
+	// namespace declared on the message only
+	CWMP13MessageInputXML = `<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/">
+  <soapenv:Body>
+    <InformResponse xmlns="urn:dslforum-org:cwmp-1-3"><MaxEnvelopes>1</MaxEnvelopes></InformResponse>
+  </soapenv:Body>
+</soapenv:Envelope>`
+
+	SupportedVersionsEnvelopeInputXML = `<soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/" xmlns:cwmp="urn:dslforum-org:cwmp-1-4">
+  <soapenv:Header>
+    <cwmp:ID soapenv:mustUnderstand="1">1</cwmp:ID>
+    <cwmp:SupportedCWMPVersions soapenv:mustUnderstand="0">1.0,1.1,1.2,1.3,1.4,1.5</cwmp:SupportedCWMPVersions>
+  </soapenv:Header>
+  <soapenv:Body>
+    <cwmp:InformResponse><MaxEnvelopes>1</MaxEnvelopes></cwmp:InformResponse>
+  </soapenv:Body>
+</soapenv:Envelope>`
+
+	UseCWMPVersionEnvelopeOutputXML = `<soap-env:Envelope xmlns:soap-env="http://schemas.xmlsoap.org/soap/envelope/" xmlns:soap-enc="http://schemas.xmlsoap.org/soap/encoding/" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:cwmp="urn:dslforum-org:cwmp-1-3">
+  <soap-env:Header>
+    <cwmp:ID soap-env:mustUnderstand="1">1</cwmp:ID>
+    <cwmp:UseCWMPVersion soap-env:mustUnderstand="1">1.3</cwmp:UseCWMPVersion>
+  </soap-env:Header>
+  <soap-env:Body>
+    <cwmp:InformResponse>
+      <MaxEnvelopes>1</MaxEnvelopes>
+    </cwmp:InformResponse>
+  </soap-env:Body>
+</soap-env:Envelope>`
+)
+
+func TestParseCWMPVersion(t *testing.T) {
+	v, err := messages.ParseCWMPVersion("1.2")
+	require.NoError(t, err)
+	assert.Equal(t, messages.CWMPVersion12, v)
+	assert.Equal(t, "urn:dslforum-org:cwmp-1-2", v.Namespace())
+	assert.Equal(t, "1.2", v.String())
+
+	for _, invalid := range []string{"", "2.0", "1.x", "1-2"} {
+		_, err := messages.ParseCWMPVersion(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestEnvelopeParseCWMPVersion(t *testing.T) {
+	for input, expected := range map[string]messages.CWMPVersion{
+		CWMP12EnvelopeInputXML:            messages.CWMPVersion12,
+		CWMP13MessageInputXML:             messages.CWMPVersion13,
+		SupportedVersionsEnvelopeInputXML: messages.CWMPVersion14,
+		EnvelopeInputXML:                  messages.CWMPVersion12,
+	} {
+		env, err := messages.ParseEnvelopeXML(bytes.NewBufferString(input))
+		require.NoError(t, err)
+		assert.Equal(t, expected, env.Version)
+	}
+}
+
+func TestNegotiateCWMPVersion(t *testing.T) {
+	// without SupportedCWMPVersions header, the version of the namespace is used
+	env, err := messages.ParseEnvelopeXML(bytes.NewBufferString(CWMP12EnvelopeInputXML))
+	require.NoError(t, err)
+	assert.Nil(t, env.GetSupportedCWMPVersions())
+	assert.Equal(t, messages.CWMPVersion12, messages.NegotiateCWMPVersion(env))
+
+	// the highest version supported by both sides is selected
+	env, err = messages.ParseEnvelopeXML(bytes.NewBufferString(SupportedVersionsEnvelopeInputXML))
+	require.NoError(t, err)
+	assert.Len(t, env.GetSupportedCWMPVersions(), 6)
+	assert.Equal(t, messages.LatestCWMPVersion, messages.NegotiateCWMPVersion(env))
+
+	// versions above the latest supported one are not used, even if announced by the namespace
+	env.Header.SupportedCWMPVersions = nil
+	env.Version = messages.LatestCWMPVersion + 1
+	assert.Equal(t, messages.LatestCWMPVersion, messages.NegotiateCWMPVersion(env))
+}
+
+func TestEnvelopeSerializeUseCWMPVersion(t *testing.T) {
+	env := messages.NewEnvelope("1", messages.InformResponse{MaxEnvelopes: 1})
+	env.Version = messages.CWMPVersion13
+	env.SetUseCWMPVersion(messages.CWMPVersion13)
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	require.NoError(t, enc.Encode(env))
+	assert.Equal(t, UseCWMPVersionEnvelopeOutputXML, buf.String())
+
+	parsed, err := messages.ParseEnvelopeXML(buf)
+	require.NoError(t, err)
+	v, found := parsed.GetUseCWMPVersion()
+	assert.True(t, found)
+	assert.Equal(t, messages.CWMPVersion13, v)
+}
+
+func TestEnvelopeSetSupportedCWMPVersions(t *testing.T) {
+	env := messages.NewEnvelope("1", messages.Inform{})
+	env.SetSupportedCWMPVersions(messages.CWMPVersion12)
+	assert.Equal(t, "1.0,1.1,1.2", env.Header.SupportedCWMPVersions.Value)
+	assert.Equal(t, []messages.CWMPVersion{messages.CWMPVersion10, messages.CWMPVersion11, messages.CWMPVersion12}, env.GetSupportedCWMPVersions())
+}
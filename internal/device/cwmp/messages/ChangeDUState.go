@@ -7,6 +7,7 @@ package messages
 import (
 	"github.com/nokia/corteca-cli/internal/configuration"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -145,14 +146,23 @@ type UninstallOpStruct struct {
 func (op UninstallOpStruct) GetOpType() string { return "UninstallOpStruct" }
 
 func (m ChangeDUState) GetName() string { return "ChangeDUState" }
+
+// returns a DUOperationFault for every failed operation reported in DUStateChangeComplete
 func (m ChangeDUState) ValidateResponse(msg Message) error {
 	if resp, ok := msg.(DUStateChangeComplete); ok {
-		for i := 0; i < len(resp.Results); i++ {
-			if resp.Results[i].Fault.FaultCode != 0 {
-				return fmt.Errorf("error(s) occured in DU operation(s)")
+		var faults []error
+		for i, result := range resp.Results {
+			if result.Fault.FaultCode == 0 {
+				continue
+			}
+			fault := DUOperationFault{Operation: i + 1, Result: result}
+			// results are reported in the order of the operations
+			if i < len(m.Operations.Op) {
+				fault.OpType = m.Operations.Op[i].GetOpType()
 			}
+			faults = append(faults, fault)
 		}
-		return nil
+		return errors.Join(faults...)
 	} else {
 		return ExpectMessage[ChangeDUStateResponse](msg)
 	}
@@ -168,8 +178,32 @@ func (m ChangeDUState) GenerateResponse() Message {
 	return ChangeDUStateResponse{}
 }
 
+// fault of a single operation of a ChangeDUState
+type DUOperationFault struct {
+	// position of the operation, starting from 1
+	Operation int
+	OpType    string
+	Result    OpResultStruct
+}
+
+func (f DUOperationFault) Error() string {
+	op := "DU operation"
+	if len(f.OpType) > 0 {
+		op = f.OpType
+	}
+	return fmt.Sprintf("%s #%d (UUID '%s') failed: %s", op, f.Operation, f.Result.UUID, f.Result.Fault.Error())
+}
+
+func (f DUOperationFault) GetFaultCode() uint { return f.Result.Fault.FaultCode }
+
 type ChangeDUStateResponse struct {
 	XMLName xml.Name `xml:"ChangeDUStateResponse"`
 }
 
+func (m ChangeDUStateResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "ChangeDUStateResponse")
+	type Alias ChangeDUStateResponse
+	return enc.EncodeElement(Alias(m), start)
+}
+
 func (m ChangeDUStateResponse) GetName() string { return "ChangeDUStateResponse" }
@@ -13,6 +13,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 )
 
@@ -186,3 +187,20 @@ func TestChangeDUStateMarshalToYaml(t *testing.T) {
 	}
 	assert.Equal(t, ChangeDUStateInputYaml, outbuf.String())
 }
+
+func TestChangeDUStateValidateResponse(t *testing.T) {
+	msg := ChangeDUStateInputMsg
+	assert.NoError(t, msg.ValidateResponse(messages.ChangeDUStateResponse{}))
+
+	// the second result reports a fault, for the update operation
+	err := msg.ValidateResponse(DUStateChangeCompleteInputMsg)
+	var fault messages.DUOperationFault
+	require.ErrorAs(t, err, &fault)
+	assert.Equal(t, 2, fault.Operation)
+	assert.Equal(t, "UpdateOpStruct", fault.OpType)
+	assert.Equal(t, "Device.SoftwareModules.DeploymentUnit.2", fault.Result.DeploymentUnitRef)
+	assert.Equal(t, "UpdateOpStruct #2 (UUID 'c0c4328b-18a4-4b3b-b1da-e8ea8d8f457d') failed: System Resources Exceeded (faultcode: 9027)", err.Error())
+
+	success := messages.DUStateChangeComplete{Results: DUStateChangeCompleteInputMsg.Results[:1]}
+	assert.NoError(t, msg.ValidateResponse(success))
+}
@@ -6,6 +6,7 @@ package messages
 
 import (
 	"encoding/xml"
+	"strings"
 )
 
 type DUStateChangeComplete struct {
@@ -31,6 +32,28 @@ type OpResultStruct struct {
 	StartTime            string      `yaml:"StartTime"`
 	CompleteTime         string      `yaml:"CompleteTime"`
 	Fault                FaultStruct `yaml:"Fault"`
+	// ExecutionUnitRefList split into the individual references, for use by subsequent steps
+	ExecutionUnitRefs []string `xml:"-" yaml:"ExecutionUnitRefs,omitempty"`
+}
+
+func (r *OpResultStruct) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	type Alias OpResultStruct
+	if err := dec.DecodeElement((*Alias)(r), &start); err != nil {
+		return err
+	}
+	r.ExecutionUnitRefs = splitRefList(r.ExecutionUnitRefList)
+	return nil
+}
+
+// split a comma-separated list of references, as used by TR-181
+func splitRefList(list string) []string {
+	var refs []string
+	for _, ref := range strings.Split(list, ",") {
+		if ref = strings.TrimSpace(ref); len(ref) > 0 {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
 }
 
 func (m DUStateChangeComplete) GetName() string { return "DUStateChangeComplete" }
@@ -6,6 +6,7 @@ package messages_test
 
 import (
 	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
 	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
 	"encoding/xml"
 	"testing"
@@ -197,3 +198,32 @@ func TestDUStateChangeCompleteSerializeToYAML(t *testing.T) {
 	}
 	assert.Equal(t, DUStateChangeCompleteInputYAML, outbuf.String())
 }
+
+func TestDUStateChangeCompleteExecutionUnitRefs(t *testing.T) {
+	input := `<cwmp:DUStateChangeComplete>
+  <CommandKey>install</CommandKey>
+  <Results>
+    <OpResultStruct>
+      <UUID>1234</UUID>
+      <DeploymentUnitRef>Device.SoftwareModules.DeploymentUnit.3</DeploymentUnitRef>
+      <ExecutionUnitRefList>Device.SoftwareModules.ExecutionUnit.4, Device.SoftwareModules.ExecutionUnit.5</ExecutionUnitRefList>
+    </OpResultStruct>
+    <OpResultStruct>
+      <UUID>5678</UUID>
+      <ExecutionUnitRefList></ExecutionUnitRefList>
+    </OpResultStruct>
+  </Results>
+</cwmp:DUStateChangeComplete>`
+	msg := messages.DUStateChangeComplete{}
+	if err := xml.Unmarshal([]byte(input), &msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, []string{"Device.SoftwareModules.ExecutionUnit.4", "Device.SoftwareModules.ExecutionUnit.5"}, msg.Results[0].ExecutionUnitRefs)
+	assert.Empty(t, msg.Results[1].ExecutionUnitRefs)
+
+	// the references are addressable by subsequent steps
+	ref, err := configuration.ReadField(msg, ".Results.0.ExecutionUnitRefs.1")
+	assert.NoError(t, err)
+	assert.Equal(t, "Device.SoftwareModules.ExecutionUnit.5", ref)
+}
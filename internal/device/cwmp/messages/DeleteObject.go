@@ -0,0 +1,40 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"encoding/xml"
+)
+
+type DeleteObject struct {
+	XMLName      xml.Name                    `xml:"DeleteObject" yaml:"-"`
+	ObjectName   configuration.TemplateField `yaml:"ObjectName"`
+	ParameterKey configuration.TemplateField `yaml:"ParameterKey"`
+}
+
+func (msg DeleteObject) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "DeleteObject")
+	type Alias DeleteObject
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg DeleteObject) GetName() string { return "DeleteObject" }
+func (msg DeleteObject) ValidateResponse(resp Message) error {
+	return ExpectMessage[DeleteObjectResponse](resp)
+}
+
+type DeleteObjectResponse struct {
+	XMLName xml.Name `xml:"DeleteObjectResponse" yaml:"-"`
+	Status  uint     `yaml:"Status"`
+}
+
+func (msg DeleteObjectResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "DeleteObjectResponse")
+	type Alias DeleteObjectResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg DeleteObjectResponse) GetName() string { return "DeleteObjectResponse" }
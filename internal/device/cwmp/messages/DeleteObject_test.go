@@ -0,0 +1,110 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DeleteObjectInputXML = `<cwmp:DeleteObject>
+  <ObjectName>Device.Firewall.Chain.1.Rule.3.</ObjectName>
+  <ParameterKey>delrule</ParameterKey>
+</cwmp:DeleteObject>`
+
+	DeleteObjectInputYAML = `ObjectName: Device.Firewall.Chain.1.Rule.3.
+ParameterKey: delrule
+`
+
+	DeleteObjectResponseInputXML = `<cwmp:DeleteObjectResponse>
+  <Status>1</Status>
+</cwmp:DeleteObjectResponse>`
+)
+
+var DeleteObjectInputMsg = messages.DeleteObject{
+	ObjectName:   configuration.T("Device.Firewall.Chain.1.Rule.3."),
+	ParameterKey: configuration.T("delrule"),
+}
+
+func TestDeleteObjectParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(DeleteObjectInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.DeleteObject{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, "Device.Firewall.Chain.1.Rule.3.", msg.ObjectName.String())
+	assert.Equal(t, "delrule", msg.ParameterKey.String())
+}
+
+func TestDeleteObjectSerializeToXML(t *testing.T) {
+	msg := DeleteObjectInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, DeleteObjectInputXML, buf.String())
+}
+
+func TestDeleteObjectParseFromYAML(t *testing.T) {
+	buf := bytes.NewBufferString(DeleteObjectInputYAML)
+	dec := yaml.NewDecoder(buf)
+	msg := messages.DeleteObject{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing YAML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, "Device.Firewall.Chain.1.Rule.3.", msg.ObjectName.String())
+	assert.Equal(t, "delrule", msg.ParameterKey.String())
+}
+
+func TestDeleteObjectSerializeToYAML(t *testing.T) {
+	msg := DeleteObjectInputMsg
+	outbuf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := yaml.NewEncoder(outbuf)
+	enc.SetIndent(4)
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating YAML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, DeleteObjectInputYAML, outbuf.String())
+}
+
+func TestDeleteObjectResponseParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(DeleteObjectResponseInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.DeleteObjectResponse{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, uint(1), msg.Status)
+}
+
+func TestDeleteObjectResponseSerializeToXML(t *testing.T) {
+	msg := messages.DeleteObjectResponse{Status: 1}
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, DeleteObjectResponseInputXML, buf.String())
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"encoding/xml"
+	"fmt"
+)
+
+const (
+	FileTypeFirmwareImage string = "1 Firmware Upgrade Image"
+	FileTypeWebContent    string = "2 Web Content"
+	FileTypeVendorConfig  string = "3 Vendor Configuration File"
+	FileTypeToneFile      string = "4 Tone File"
+	FileTypeRingerFile    string = "5 Ringer File"
+)
+
+type Download struct {
+	XMLName        xml.Name                    `xml:"Download" yaml:"-"`
+	CommandKey     configuration.TemplateField `yaml:"CommandKey"`
+	FileType       configuration.TemplateField `yaml:"FileType"`
+	URL            configuration.TemplateField `yaml:"URL,omitempty"`
+	Username       configuration.TemplateField `yaml:"Username,omitempty"`
+	Password       configuration.TemplateField `yaml:"Password,omitempty"`
+	FileSize       uint                        `yaml:"FileSize,omitempty"`
+	TargetFileName configuration.TemplateField `yaml:"TargetFileName,omitempty"`
+	DelaySeconds   uint                        `yaml:"DelaySeconds,omitempty"`
+	SuccessURL     configuration.TemplateField `yaml:"SuccessURL,omitempty"`
+	FailureURL     configuration.TemplateField `yaml:"FailureURL,omitempty"`
+	// when set, the ACS serves the current build artifact and fills in URL & FileSize
+	ServeArtifact bool `xml:"-" yaml:"ServeArtifact,omitempty"`
+}
+
+func (msg Download) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "Download")
+	type Alias Download
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg Download) GetName() string { return "Download" }
+func (msg Download) ValidateResponse(resp Message) error {
+	if r, ok := resp.(TransferComplete); ok {
+		if r.FaultStruct.FaultCode != 0 {
+			return fmt.Errorf("download failed: %w", r.FaultStruct)
+		}
+		return nil
+	}
+	return ExpectMessage[DownloadResponse](resp)
+}
+func (msg Download) Match(m Message) bool {
+	if r, ok := m.(TransferComplete); ok {
+		return r.CommandKey == msg.CommandKey.String()
+	}
+	return false
+}
+func (msg Download) AwaitNotification(resp Message) bool {
+	if r, ok := resp.(DownloadResponse); ok {
+		return r.Status == 1
+	}
+	return true
+}
+
+type DownloadResponse struct {
+	XMLName      xml.Name `xml:"DownloadResponse" yaml:"-"`
+	Status       uint     `yaml:"Status"`
+	StartTime    string   `yaml:"StartTime"`
+	CompleteTime string   `yaml:"CompleteTime"`
+}
+
+func (msg DownloadResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "DownloadResponse")
+	type Alias DownloadResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg DownloadResponse) GetName() string { return "DownloadResponse" }
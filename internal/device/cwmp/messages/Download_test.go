@@ -0,0 +1,164 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DownloadInputXML = `<cwmp:Download>
+  <CommandKey>fw-upgrade</CommandKey>
+  <FileType>1 Firmware Upgrade Image</FileType>
+  <URL>http://example.com/firmware.bin</URL>
+  <Username></Username>
+  <Password></Password>
+  <FileSize>1024</FileSize>
+  <TargetFileName></TargetFileName>
+  <DelaySeconds>0</DelaySeconds>
+  <SuccessURL></SuccessURL>
+  <FailureURL></FailureURL>
+</cwmp:Download>`
+
+	DownloadInputYAML = `CommandKey: fw-upgrade
+FileType: 1 Firmware Upgrade Image
+URL: http://example.com/firmware.bin
+FileSize: 1024
+`
+
+	DownloadServeArtifactInputYAML = `CommandKey: app-bundle
+FileType: 3 Vendor Configuration File
+ServeArtifact: true
+`
+
+	DownloadResponseInputXML = `<cwmp:DownloadResponse>
+  <Status>1</Status>
+  <StartTime>0001-01-01T00:00:00Z</StartTime>
+  <CompleteTime>0001-01-01T00:00:00Z</CompleteTime>
+</cwmp:DownloadResponse>`
+)
+
+var DownloadInputMsg = messages.Download{
+	CommandKey: configuration.T("fw-upgrade"),
+	FileType:   configuration.T(messages.FileTypeFirmwareImage),
+	URL:        configuration.T("http://example.com/firmware.bin"),
+	FileSize:   1024,
+}
+
+var DownloadResponseInputMsg = messages.DownloadResponse{
+	Status:       1,
+	StartTime:    "0001-01-01T00:00:00Z",
+	CompleteTime: "0001-01-01T00:00:00Z",
+}
+
+func TestDownloadParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(DownloadInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.Download{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, "fw-upgrade", msg.CommandKey.String())
+	assert.Equal(t, messages.FileTypeFirmwareImage, msg.FileType.String())
+	assert.Equal(t, "http://example.com/firmware.bin", msg.URL.String())
+	assert.Equal(t, uint(1024), msg.FileSize)
+}
+
+func TestDownloadSerializeToXML(t *testing.T) {
+	msg := DownloadInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, DownloadInputXML, buf.String())
+}
+
+func TestDownloadParseFromYAML(t *testing.T) {
+	buf := bytes.NewBufferString(DownloadServeArtifactInputYAML)
+	dec := yaml.NewDecoder(buf)
+	msg := messages.Download{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing YAML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, "app-bundle", msg.CommandKey.String())
+	assert.Equal(t, messages.FileTypeVendorConfig, msg.FileType.String())
+	assert.True(t, msg.ServeArtifact)
+	assert.Equal(t, "", msg.URL.String())
+}
+
+func TestDownloadSerializeToYAML(t *testing.T) {
+	msg := DownloadInputMsg
+	outbuf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := yaml.NewEncoder(outbuf)
+	enc.SetIndent(4)
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating YAML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, DownloadInputYAML, outbuf.String())
+}
+
+func TestDownloadResponseParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(DownloadResponseInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.DownloadResponse{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, uint(1), msg.Status)
+}
+
+func TestDownloadResponseSerializeToXML(t *testing.T) {
+	msg := DownloadResponseInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, DownloadResponseInputXML, buf.String())
+}
+
+func TestDownloadMatchesTransferComplete(t *testing.T) {
+	msg := DownloadInputMsg
+	assert.True(t, msg.Match(messages.TransferComplete{CommandKey: "fw-upgrade"}))
+	assert.False(t, msg.Match(messages.TransferComplete{CommandKey: "other"}))
+	assert.False(t, msg.Match(messages.DUStateChangeComplete{CommandKey: "fw-upgrade"}))
+}
+
+func TestDownloadIsPending(t *testing.T) {
+	msg := DownloadInputMsg
+	assert.True(t, messages.IsPending(msg, messages.DownloadResponse{Status: 1}))
+	assert.False(t, messages.IsPending(msg, messages.DownloadResponse{Status: 0}))
+	assert.True(t, messages.IsPending(ChangeDUStateInputMsg, messages.ChangeDUStateResponse{}))
+}
+
+func TestDownloadValidateResponse(t *testing.T) {
+	msg := DownloadInputMsg
+	assert.NoError(t, msg.ValidateResponse(DownloadResponseInputMsg))
+	assert.NoError(t, msg.ValidateResponse(messages.TransferComplete{CommandKey: "fw-upgrade"}))
+	assert.Error(t, msg.ValidateResponse(messages.TransferComplete{
+		CommandKey:  "fw-upgrade",
+		FaultStruct: messages.FaultStruct{FaultCode: 9010, FaultString: "Download failure"},
+	}))
+	assert.Error(t, msg.ValidateResponse(messages.ChangeDUStateResponse{}))
+}
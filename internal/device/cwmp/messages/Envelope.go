@@ -8,6 +8,7 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
+	"strings"
 )
 
 func ParseEnvelopeXML(input io.Reader) (*Envelope, error) {
@@ -20,6 +21,8 @@ type Envelope struct {
 	XMLName xml.Name        `xml:"Envelope"`
 	Header  *EnvelopeHeader `xml:",omitempty"`
 	Body    EnvelopeBody
+	// version of the cwmp namespace
+	Version CWMPVersion `xml:"-"`
 }
 
 func (e Envelope) GetID() string {
@@ -30,6 +33,69 @@ func (e Envelope) GetID() string {
 	}
 }
 
+// CPE must not send any requests in its next HTTP post (sent by the ACS)
+func (e Envelope) GetHoldRequests() bool {
+	return e.Header != nil && e.Header.HoldRequests != nil && e.Header.HoldRequests.Value
+}
+
+// CPE will not send any further requests in the current session (sent by the CPE; deprecated in CWMP 1-1+)
+func (e Envelope) GetNoMoreRequests() bool {
+	return e.Header != nil && e.Header.NoMoreRequests != nil && e.Header.NoMoreRequests.Value
+}
+
+func (e *Envelope) SetHoldRequests(hold bool) {
+	if e.Header == nil {
+		e.Header = &EnvelopeHeader{}
+	}
+	if hold {
+		e.Header.HoldRequests = &BoolHeaderStruct{MustUnderstand: "1", Value: true}
+	} else {
+		e.Header.HoldRequests = nil
+	}
+}
+
+// versions supported by the CPE (sent along with its Inform; CWMP 1.4+)
+func (e Envelope) GetSupportedCWMPVersions() []CWMPVersion {
+	if e.Header == nil || e.Header.SupportedCWMPVersions == nil {
+		return nil
+	}
+	var versions []CWMPVersion
+	for _, s := range strings.Split(e.Header.SupportedCWMPVersions.Value, ",") {
+		if v, err := ParseCWMPVersion(s); err == nil {
+			versions = append(versions, v)
+		}
+	}
+	return versions
+}
+
+// announce all versions up to the given one
+func (e *Envelope) SetSupportedCWMPVersions(highest CWMPVersion) {
+	if e.Header == nil {
+		e.Header = &EnvelopeHeader{}
+	}
+	versions := make([]string, 0, highest+1)
+	for v := CWMPVersion10; v <= highest; v++ {
+		versions = append(versions, v.String())
+	}
+	e.Header.SupportedCWMPVersions = &StringHeaderStruct{MustUnderstand: "0", Value: strings.Join(versions, ",")}
+}
+
+// version selected by the ACS for the session (sent along with the InformResponse; CWMP 1.4+)
+func (e Envelope) GetUseCWMPVersion() (CWMPVersion, bool) {
+	if e.Header == nil || e.Header.UseCWMPVersion == nil {
+		return 0, false
+	}
+	v, err := ParseCWMPVersion(e.Header.UseCWMPVersion.Value)
+	return v, err == nil
+}
+
+func (e *Envelope) SetUseCWMPVersion(v CWMPVersion) {
+	if e.Header == nil {
+		e.Header = &EnvelopeHeader{}
+	}
+	e.Header.UseCWMPVersion = &StringHeaderStruct{MustUnderstand: "1", Value: v.String()}
+}
+
 func (e Envelope) GetBody() []Message {
 	return e.Body.Messages
 }
@@ -55,14 +121,33 @@ func (e Envelope) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
 		XmlAttr("xmlns:soap-enc", "http://schemas.xmlsoap.org/soap/encoding/"),
 		XmlAttr("xmlns:xsd", "http://www.w3.org/2001/XMLSchema"),
 		XmlAttr("xmlns:xsi", "http://www.w3.org/2001/XMLSchema-instance"),
-		XmlAttr("xmlns:cwmp", "urn:dslforum-org:cwmp-1-0"),
+		XmlAttr("xmlns:cwmp", e.Version.Namespace()),
 	)
 	type Alias Envelope
 	return enc.EncodeElement(Alias(e), start)
 }
 
+// custom unmarshaller to determine the version from the cwmp namespace, declared on the envelope or on its messages
+func (e *Envelope) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	type Alias Envelope
+	if err := dec.DecodeElement((*Alias)(e), &start); err != nil {
+		return err
+	}
+	e.Version = e.Body.version
+	for _, attr := range start.Attr {
+		if v, ok := parseCWMPNamespace(attr.Value); ok && attr.Name.Space == "xmlns" {
+			e.Version = v
+		}
+	}
+	return nil
+}
+
 type EnvelopeHeader struct {
-	ID IDStruct `xml:"ID"`
+	ID                    IDStruct            `xml:"ID"`
+	HoldRequests          *BoolHeaderStruct   `xml:"HoldRequests,omitempty"`
+	NoMoreRequests        *BoolHeaderStruct   `xml:"NoMoreRequests,omitempty"`
+	SupportedCWMPVersions *StringHeaderStruct `xml:"SupportedCWMPVersions,omitempty"`
+	UseCWMPVersion        *StringHeaderStruct `xml:"UseCWMPVersion,omitempty"`
 }
 
 func (eh EnvelopeHeader) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
@@ -82,8 +167,39 @@ func (id IDStruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
 	return enc.EncodeElement(Alias(id), start)
 }
 
+type BoolHeaderStruct struct {
+	MustUnderstand string `xml:"soap-env:mustUnderstand,attr"`
+	Value          bool   `xml:",chardata"`
+}
+
+// custom marshaller to add prefix to name & encode value as 0/1
+func (bh BoolHeaderStruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name)
+	value := "0"
+	if bh.Value {
+		value = "1"
+	}
+	return enc.EncodeElement(struct {
+		MustUnderstand string `xml:"soap-env:mustUnderstand,attr"`
+		Value          string `xml:",chardata"`
+	}{MustUnderstand: bh.MustUnderstand, Value: value}, start)
+}
+
+type StringHeaderStruct struct {
+	MustUnderstand string `xml:"soap-env:mustUnderstand,attr"`
+	Value          string `xml:",chardata"`
+}
+
+func (sh StringHeaderStruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name)
+	type Alias StringHeaderStruct
+	return enc.EncodeElement(Alias(sh), start)
+}
+
 type EnvelopeBody struct {
 	Messages []Message
+	// version of the cwmp namespace of the messages
+	version CWMPVersion
 }
 
 func (eb EnvelopeBody) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
@@ -100,6 +216,9 @@ func (eb *EnvelopeBody) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) e
 		}
 		switch tok := token.(type) {
 		case xml.StartElement:
+			if v, ok := parseCWMPNamespace(tok.Name.Space); ok {
+				eb.version = v
+			}
 			var msg Message
 			switch tok.Name.Local {
 			case Inform{}.GetName():
@@ -180,6 +299,174 @@ func (eb *EnvelopeBody) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) e
 					return err
 				}
 				msg = m
+			case AddObject{}.GetName():
+				var m AddObject
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case AddObjectResponse{}.GetName():
+				var m AddObjectResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case DeleteObject{}.GetName():
+				var m DeleteObject
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case DeleteObjectResponse{}.GetName():
+				var m DeleteObjectResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case Download{}.GetName():
+				var m Download
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case DownloadResponse{}.GetName():
+				var m DownloadResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case TransferComplete{}.GetName():
+				var m TransferComplete
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case TransferCompleteResponse{}.GetName():
+				var m TransferCompleteResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case AutonomousTransferComplete{}.GetName():
+				var m AutonomousTransferComplete
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case AutonomousTransferCompleteResponse{}.GetName():
+				var m AutonomousTransferCompleteResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case AutonomousDUStateChangeComplete{}.GetName():
+				var m AutonomousDUStateChangeComplete
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case AutonomousDUStateChangeCompleteResponse{}.GetName():
+				var m AutonomousDUStateChangeCompleteResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case RequestDownload{}.GetName():
+				var m RequestDownload
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case RequestDownloadResponse{}.GetName():
+				var m RequestDownloadResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case GetRPCMethods{}.GetName():
+				var m GetRPCMethods
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case GetRPCMethodsResponse{}.GetName():
+				var m GetRPCMethodsResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case Upload{}.GetName():
+				var m Upload
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case UploadResponse{}.GetName():
+				var m UploadResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case ScheduleInform{}.GetName():
+				var m ScheduleInform
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case ScheduleInformResponse{}.GetName():
+				var m ScheduleInformResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case Reboot{}.GetName():
+				var m Reboot
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case RebootResponse{}.GetName():
+				var m RebootResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case FactoryReset{}.GetName():
+				var m FactoryReset
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case FactoryResetResponse{}.GetName():
+				var m FactoryResetResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case GetParameterAttributes{}.GetName():
+				var m GetParameterAttributes
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case GetParameterAttributesResponse{}.GetName():
+				var m GetParameterAttributesResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case SetParameterAttributes{}.GetName():
+				var m SetParameterAttributes
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
+			case SetParameterAttributesResponse{}.GetName():
+				var m SetParameterAttributesResponse
+				if err := dec.DecodeElement(&m, &tok); err != nil {
+					return err
+				}
+				msg = m
 			default:
 				return fmt.Errorf("unknown RPC '%s'", tok.Name.Local)
 			}
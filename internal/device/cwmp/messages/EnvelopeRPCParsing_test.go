@@ -113,7 +113,7 @@ const (
 	GetParameterValuesEnvelopeXML = `<soap-env:Envelope xmlns:soap-env="http://schemas.xmlsoap.org/soap/envelope/" xmlns:cwmp="urn:dslforum-org:cwmp-1-0" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns:soap-enc="http://schemas.xmlsoap.org/soap/encoding/">
   <soap-env:Body>
     <cwmp:GetParameterValues>
-      <ParameterNames soap-enc:array="xsd:string[1]">
+      <ParameterNames soap-enc:arrayType="xsd:string[1]">
         <string>Device.DeviceInfo.SoftwareVersion</string>
       </ParameterNames>
     </cwmp:GetParameterValues>
@@ -123,7 +123,7 @@ const (
 	GetParameterValuesResponseEnvelopeXML = `<soap-env:Envelope xmlns:soap-env="http://schemas.xmlsoap.org/soap/envelope/" xmlns:cwmp="urn:dslforum-org:cwmp-1-0" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:soap-enc="http://schemas.xmlsoap.org/soap/encoding/">
   <soap-env:Body>
     <cwmp:GetParameterValuesResponse>
-      <ParameterList soap-enc:array="cwmp:ParameterValueStruct[1]">
+      <ParameterList soap-enc:arrayType="cwmp:ParameterValueStruct[1]">
         <ParameterValueStruct>
           <Name>Device.DeviceInfo.SoftwareVersion</Name>
           <Value xsi:type="xsd:string">1.0.0</Value>
@@ -136,7 +136,7 @@ const (
 	SetParameterValuesEnvelopeXML = `<soap-env:Envelope xmlns:soap-env="http://schemas.xmlsoap.org/soap/envelope/" xmlns:cwmp="urn:dslforum-org:cwmp-1-0" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:soap-enc="http://schemas.xmlsoap.org/soap/encoding/">
   <soap-env:Body>
     <cwmp:SetParameterValues>
-      <ParameterList soap-enc:array="cwmp:ParameterValueStruct[1]">
+      <ParameterList soap-enc:arrayType="cwmp:ParameterValueStruct[1]">
         <ParameterValueStruct>
           <Name>Device.DeviceInfo.SoftwareVersion</Name>
           <Value xsi:type="xsd:string">2.0.0</Value>
@@ -59,7 +59,7 @@ const (
 
 	GetParameterValuesEnvelopeOutputXML = envelopeHeader + `
     <cwmp:GetParameterValues>
-      <ParameterNames soap-enc:array="xsd:string[2]">
+      <ParameterNames soap-enc:arrayType="xsd:string[2]">
         <string>Device.DeviceInfo.SoftwareVersion</string>
         <string>Device.DeviceInfo.HardwareVersion</string>
       </ParameterNames>
@@ -67,7 +67,7 @@ const (
 
 	SetParameterValuesEnvelopeOutputXML = envelopeHeader + `
     <cwmp:SetParameterValues>
-      <ParameterList soap-enc:array="cwmp:ParameterValueStruct[2]">
+      <ParameterList soap-enc:arrayType="cwmp:ParameterValueStruct[2]">
         <ParameterValueStruct>
           <Name>Device.DeviceInfo.SoftwareVersion</Name>
           <Value xsi:type="xsd:string">2.0.0</Value>
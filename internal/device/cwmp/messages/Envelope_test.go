@@ -93,6 +93,31 @@ const (
  </soap-env:Body>
 </soap-env:Envelope>`
 
+	HoldRequestsEnvelopeInputXML = `<soap-env:Envelope xmlns:soap-env="http://schemas.xmlsoap.org/soap/envelope/" xmlns:cwmp="urn:dslforum-org:cwmp-1-0">
+  <soap-env:Header>
+    <cwmp:ID soap-env:mustUnderstand="1">42</cwmp:ID>
+    <cwmp:HoldRequests soap-env:mustUnderstand="1">1</cwmp:HoldRequests>
+    <cwmp:NoMoreRequests>true</cwmp:NoMoreRequests>
+  </soap-env:Header>
+  <soap-env:Body>
+    <cwmp:InformResponse>
+      <MaxEnvelopes>1</MaxEnvelopes>
+    </cwmp:InformResponse>
+  </soap-env:Body>
+</soap-env:Envelope>`
+
+	HoldRequestsEnvelopeOutputXML = `<soap-env:Envelope xmlns:soap-env="http://schemas.xmlsoap.org/soap/envelope/" xmlns:soap-enc="http://schemas.xmlsoap.org/soap/encoding/" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:cwmp="urn:dslforum-org:cwmp-1-0">
+  <soap-env:Header>
+    <cwmp:ID soap-env:mustUnderstand="1">testEnvelope</cwmp:ID>
+    <cwmp:HoldRequests soap-env:mustUnderstand="1">1</cwmp:HoldRequests>
+  </soap-env:Header>
+  <soap-env:Body>
+    <cwmp:InformResponse>
+      <MaxEnvelopes>1</MaxEnvelopes>
+    </cwmp:InformResponse>
+  </soap-env:Body>
+</soap-env:Envelope>`
+
 	EnvelopeOutputXML = `<soap-env:Envelope xmlns:soap-env="http://schemas.xmlsoap.org/soap/envelope/" xmlns:soap-enc="http://schemas.xmlsoap.org/soap/encoding/" xmlns:xsd="http://www.w3.org/2001/XMLSchema" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xmlns:cwmp="urn:dslforum-org:cwmp-1-0">
   <soap-env:Header>
     <cwmp:ID soap-env:mustUnderstand="1">testEnvelope</cwmp:ID>
@@ -105,11 +130,11 @@ const (
         <ProductClass></ProductClass>
         <SerialNumber></SerialNumber>
       </DeviceId>
-      <Event soap-enc:array="cwmp:EventStruct[0]"></Event>
+      <Event soap-enc:arrayType="cwmp:EventStruct[0]"></Event>
       <MaxEnvelopes>0</MaxEnvelopes>
       <CurrentTime></CurrentTime>
       <RetryCount>0</RetryCount>
-      <ParameterList soap-enc:array="cwmp:ParameterValueStruct[0]"></ParameterList>
+      <ParameterList soap-enc:arrayType="cwmp:ParameterValueStruct[0]"></ParameterList>
     </cwmp:Inform>
   </soap-env:Body>
 </soap-env:Envelope>`
@@ -190,3 +215,41 @@ func TestBlankEnvelopeSerializeToXML(t *testing.T) {
 
 	assert.Equal(t, BlankEnvelopeOutputXML, buf.String())
 }
+
+func TestEnvelopeParseHoldRequestsHeaders(t *testing.T) {
+	buf := bytes.NewBufferString(HoldRequestsEnvelopeInputXML)
+	dec := xml.NewDecoder(buf)
+	env := messages.Envelope{}
+	if err := dec.Decode(&env); err != nil {
+		t.Logf("Failed parsing xml input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, "42", env.GetID())
+	assert.True(t, env.GetHoldRequests())
+	assert.True(t, env.GetNoMoreRequests())
+	require.Equal(t, 1, len(env.GetBody()))
+}
+
+func TestEnvelopeWithoutHoldRequestsHeaders(t *testing.T) {
+	env := messages.NewEnvelope("testEnvelope", messages.InformResponse{MaxEnvelopes: 1})
+	assert.False(t, env.GetHoldRequests())
+	assert.False(t, env.GetNoMoreRequests())
+	assert.False(t, messages.Envelope{}.GetHoldRequests())
+}
+
+func TestEnvelopeSerializeHoldRequestsToXML(t *testing.T) {
+	env := messages.NewEnvelope("testEnvelope", messages.InformResponse{MaxEnvelopes: 1})
+	env.SetHoldRequests(true)
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(env); err != nil {
+		t.Logf("Failed generating xml output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, HoldRequestsEnvelopeOutputXML, buf.String())
+
+	env.SetHoldRequests(false)
+	assert.False(t, env.GetHoldRequests())
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"encoding/xml"
+)
+
+type FactoryReset struct {
+	XMLName xml.Name `xml:"FactoryReset" yaml:"-"`
+}
+
+func (msg FactoryReset) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "FactoryReset")
+	type Alias FactoryReset
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg FactoryReset) GetName() string { return "FactoryReset" }
+func (msg FactoryReset) ValidateResponse(resp Message) error {
+	if _, ok := resp.(Inform); ok {
+		return nil
+	}
+	return ExpectMessage[FactoryResetResponse](resp)
+}
+
+// after a factory reset the CPE re-bootstraps; expect an Inform carrying "0 BOOTSTRAP"
+func (msg FactoryReset) Match(m Message) bool {
+	if inform, ok := m.(Inform); ok {
+		return inform.Event.Contains(EventBootStrap, "")
+	}
+	return false
+}
+
+type FactoryResetResponse struct {
+	XMLName xml.Name `xml:"FactoryResetResponse" yaml:"-"`
+}
+
+func (msg FactoryResetResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "FactoryResetResponse")
+	type Alias FactoryResetResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg FactoryResetResponse) GetName() string { return "FactoryResetResponse" }
@@ -0,0 +1,61 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	FactoryResetInputXML         = `<cwmp:FactoryReset></cwmp:FactoryReset>`
+	FactoryResetResponseInputXML = `<cwmp:FactoryResetResponse></cwmp:FactoryResetResponse>`
+)
+
+func TestFactoryResetSerializeToXML(t *testing.T) {
+	msg := messages.FactoryReset{}
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, FactoryResetInputXML, buf.String())
+}
+
+func TestFactoryResetResponseParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(FactoryResetResponseInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.FactoryResetResponse{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, "FactoryResetResponse", msg.GetName())
+}
+
+func TestFactoryResetMatchesBootstrapInform(t *testing.T) {
+	msg := messages.FactoryReset{}
+	assert.True(t, msg.Match(newBootInform(
+		messages.EventStruct{EventCode: messages.EventBootStrap},
+		messages.EventStruct{EventCode: messages.EventBoot},
+	)))
+	assert.False(t, msg.Match(newBootInform(
+		messages.EventStruct{EventCode: messages.EventBoot},
+	)))
+	assert.False(t, msg.Match(messages.FactoryResetResponse{}))
+}
+
+func TestFactoryResetValidateResponse(t *testing.T) {
+	msg := messages.FactoryReset{}
+	assert.NoError(t, msg.ValidateResponse(messages.FactoryResetResponse{}))
+	assert.NoError(t, msg.ValidateResponse(newBootInform()))
+	assert.Error(t, msg.ValidateResponse(messages.RebootResponse{}))
+}
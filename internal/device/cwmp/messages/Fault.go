@@ -6,8 +6,76 @@ package messages
 
 import (
 	"encoding/xml"
+	"fmt"
+	"strings"
 )
 
+// explanations of the CWMP fault codes (TR-069 tables 17 and 18)
+var faultDescriptions = map[uint]string{
+	8000: "Method not supported",
+	8001: "Request denied (no reason specified)",
+	8002: "Internal error",
+	8003: "Invalid arguments",
+	8004: "Resources exceeded",
+	8005: "Retry request",
+	9000: "Method not supported",
+	9001: "Request denied (no reason specified)",
+	9002: "Internal error",
+	9003: "Invalid arguments",
+	9004: "Resources exceeded",
+	9005: "Invalid parameter name",
+	9006: "Invalid parameter type",
+	9007: "Invalid parameter value",
+	9008: "Attempt to set a non-writable parameter",
+	9009: "Notification request rejected",
+	9010: "File transfer failure",
+	9011: "Upload failure",
+	9012: "File transfer server authentication failure",
+	9013: "Unsupported protocol for file transfer",
+	9014: "File transfer failure: unable to join multicast group",
+	9015: "File transfer failure: unable to contact file server",
+	9016: "File transfer failure: unable to access file",
+	9017: "File transfer failure: unable to complete download",
+	9018: "File transfer failure: file corrupted or otherwise unusable",
+	9019: "File transfer failure: file authentication failure",
+	9020: "File transfer failure: unable to complete download within specified time windows",
+	9021: "Cancelation of file transfer not permitted in current transfer state",
+	9022: "Invalid UUID format",
+	9023: "Unknown Execution Environment",
+	9024: "Disabled Execution Environment",
+	9025: "Deployment Unit to Execution Environment mismatch",
+	9026: "Duplicate Deployment Unit",
+	9027: "System resources exceeded",
+	9028: "Unknown Deployment Unit",
+	9029: "Invalid Deployment Unit state",
+	9030: "Invalid Deployment Unit Update: downgrade not permitted",
+	9031: "Invalid Deployment Unit Update: version not specified",
+	9032: "Invalid Deployment Unit Update: version already exists",
+}
+
+// explanation of a CWMP fault code; empty if unknown
+func FaultDescription(code uint) string {
+	if d, found := faultDescriptions[code]; found {
+		return d
+	}
+	if (code >= 8800 && code <= 8899) || (code >= 9800 && code <= 9899) {
+		return "Vendor defined fault"
+	}
+	return ""
+}
+
+// fault string followed by the fault code, along with its explanation unless the fault string already says so
+func formatFault(faultString string, code uint) string {
+	description := FaultDescription(code)
+	if len(faultString) == 0 {
+		faultString = description
+	}
+	if len(description) == 0 || strings.EqualFold(faultString, description) {
+		return fmt.Sprintf("%s (faultcode: %d)", faultString, code)
+	}
+	return fmt.Sprintf("%s (faultcode: %d, %s)", faultString, code, description)
+}
+
 func NewFault(code uint, msg string) Fault {
 	return Fault{
 		FaultCode:   "Server",
@@ -34,10 +102,28 @@ func (f Fault) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
 	return enc.EncodeElement(Alias(f), start)
 }
 
+// the fault reported by the CPE; the failure of each parameter of a SetParameterValues is reported separately
 type CwmpFaultStruct struct {
 	XMLName                 xml.Name `xml:"Fault" yaml:"-"`
 	FaultStruct             `yaml:",inline"`
-	SetParameterValuesFault *SetParameterValuesFaultStruct `xml:"SetParameterValuesFault,omitempty" yaml:"SetParameterValuesFault,omitempty"`
+	SetParameterValuesFault []SetParameterValuesFaultStruct `xml:"SetParameterValuesFault,omitempty" yaml:"SetParameterValuesFault,omitempty"`
+}
+
+func (cf CwmpFaultStruct) Error() string {
+	msg := cf.FaultStruct.Error()
+	for _, p := range cf.SetParameterValuesFault {
+		msg += "; " + p.Error()
+	}
+	return msg
+}
+
+// the faults of the individual parameters
+func (cf CwmpFaultStruct) Unwrap() []error {
+	errs := make([]error, len(cf.SetParameterValuesFault))
+	for i, p := range cf.SetParameterValuesFault {
+		errs[i] = p
+	}
+	return errs
 }
 
 func (cf CwmpFaultStruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
@@ -48,8 +134,13 @@ func (cf CwmpFaultStruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) e
 
 type SetParameterValuesFaultStruct struct {
 	ParameterName string `yaml:"ParameterName"`
-	FaultCode     string `yaml:"FaultCode"`
+	FaultCode     uint   `yaml:"FaultCode"`
 	FaultString   string `yaml:"FaultString"`
 }
 
+func (f SetParameterValuesFaultStruct) Error() string {
+	return fmt.Sprintf("%s: %s", f.ParameterName, formatFault(f.FaultString, f.FaultCode))
+}
+func (f SetParameterValuesFaultStruct) GetFaultCode() uint { return f.FaultCode }
+
 func (msg Fault) GetName() string { return "Fault" }
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
 	"encoding/xml"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -38,6 +39,11 @@ const (
         <FaultCode>9008</FaultCode>
         <FaultString>Attempt to set a non-writable parameter</FaultString>
       </SetParameterValuesFault>
+      <SetParameterValuesFault>
+        <ParameterName>Device.OtherParam</ParameterName>
+        <FaultCode>9007</FaultCode>
+        <FaultString>Invalid parameter value</FaultString>
+      </SetParameterValuesFault>
     </cwmp:Fault>
   </detail>
 </soap-env:Fault>`
@@ -48,9 +54,12 @@ Detail:
     FaultCode: 9003
     FaultString: Invalid arguments
     SetParameterValuesFault:
-        ParameterName: Device.SomeParam
-        FaultCode: "9008"
-        FaultString: Attempt to set a non-writable parameter
+        - ParameterName: Device.SomeParam
+          FaultCode: 9008
+          FaultString: Attempt to set a non-writable parameter
+        - ParameterName: Device.OtherParam
+          FaultCode: 9007
+          FaultString: Invalid parameter value
 `
 )
 
@@ -62,10 +71,17 @@ var FaultInputMsg = messages.Fault{
 			FaultCode:   9003,
 			FaultString: "Invalid arguments",
 		},
-		SetParameterValuesFault: &messages.SetParameterValuesFaultStruct{
-			ParameterName: "Device.SomeParam",
-			FaultCode:     "9008",
-			FaultString:   "Attempt to set a non-writable parameter",
+		SetParameterValuesFault: []messages.SetParameterValuesFaultStruct{
+			{
+				ParameterName: "Device.SomeParam",
+				FaultCode:     9008,
+				FaultString:   "Attempt to set a non-writable parameter",
+			},
+			{
+				ParameterName: "Device.OtherParam",
+				FaultCode:     9007,
+				FaultString:   "Invalid parameter value",
+			},
 		},
 	},
 }
@@ -101,10 +117,12 @@ func TestFaultParseFromXML(t *testing.T) {
 	assert.Equal(t, uint(9003), msg.Detail.FaultCode)
 	assert.Equal(t, "Invalid arguments", msg.Detail.FaultString)
 
-	assert.NotNil(t, msg.Detail.SetParameterValuesFault)
-	assert.Equal(t, "Device.SomeParam", msg.Detail.SetParameterValuesFault.ParameterName)
-	assert.Equal(t, "9008", msg.Detail.SetParameterValuesFault.FaultCode)
-	assert.Equal(t, "Attempt to set a non-writable parameter", msg.Detail.SetParameterValuesFault.FaultString)
+	assert.Len(t, msg.Detail.SetParameterValuesFault, 2)
+	assert.Equal(t, "Device.SomeParam", msg.Detail.SetParameterValuesFault[0].ParameterName)
+	assert.Equal(t, uint(9008), msg.Detail.SetParameterValuesFault[0].FaultCode)
+	assert.Equal(t, "Attempt to set a non-writable parameter", msg.Detail.SetParameterValuesFault[0].FaultString)
+	assert.Equal(t, "Device.OtherParam", msg.Detail.SetParameterValuesFault[1].ParameterName)
+	assert.Equal(t, uint(9007), msg.Detail.SetParameterValuesFault[1].FaultCode)
 }
 
 func TestFaultSerializeToXML(t *testing.T) {
@@ -134,10 +152,12 @@ func TestFaultParseFromYAML(t *testing.T) {
 	assert.Equal(t, uint(9003), msg.Detail.FaultCode)
 	assert.Equal(t, "Invalid arguments", msg.Detail.FaultString)
 
-	assert.NotNil(t, msg.Detail.SetParameterValuesFault)
-	assert.Equal(t, "Device.SomeParam", msg.Detail.SetParameterValuesFault.ParameterName)
-	assert.Equal(t, "9008", msg.Detail.SetParameterValuesFault.FaultCode)
-	assert.Equal(t, "Attempt to set a non-writable parameter", msg.Detail.SetParameterValuesFault.FaultString)
+	assert.Len(t, msg.Detail.SetParameterValuesFault, 2)
+	assert.Equal(t, "Device.SomeParam", msg.Detail.SetParameterValuesFault[0].ParameterName)
+	assert.Equal(t, uint(9008), msg.Detail.SetParameterValuesFault[0].FaultCode)
+	assert.Equal(t, "Attempt to set a non-writable parameter", msg.Detail.SetParameterValuesFault[0].FaultString)
+	assert.Equal(t, "Device.OtherParam", msg.Detail.SetParameterValuesFault[1].ParameterName)
+	assert.Equal(t, uint(9007), msg.Detail.SetParameterValuesFault[1].FaultCode)
 }
 
 func TestFaultSerializeToYAML(t *testing.T) {
@@ -151,3 +171,30 @@ func TestFaultSerializeToYAML(t *testing.T) {
 	}
 	assert.Equal(t, FaultInputYAML, outbuf.String())
 }
+
+func TestFaultError(t *testing.T) {
+	err := error(FaultInputMsg.Detail)
+	assert.Equal(t, "Invalid arguments (faultcode: 9003); "+
+		"Device.SomeParam: Attempt to set a non-writable parameter (faultcode: 9008); "+
+		"Device.OtherParam: Invalid parameter value (faultcode: 9007)", err.Error())
+
+	// the codes of the individual parameters are reachable through errors.As
+	var paramFault messages.SetParameterValuesFaultStruct
+	assert.True(t, errors.As(err, &paramFault))
+	assert.Equal(t, uint(9008), paramFault.GetFaultCode())
+	assert.Equal(t, uint(9003), FaultInputMsg.Detail.GetFaultCode())
+
+	// the explanation is added when the fault string does not provide it
+	assert.Equal(t, "Internal error (faultcode: 9002)", messages.FaultStruct{FaultCode: 9002}.Error())
+	assert.Equal(t, "no space left (faultcode: 9027, System resources exceeded)",
+		messages.FaultStruct{FaultCode: 9027, FaultString: "no space left"}.Error())
+	assert.Equal(t, "oops (faultcode: 9850, Vendor defined fault)", messages.FaultStruct{FaultCode: 9850, FaultString: "oops"}.Error())
+	assert.Equal(t, "oops (faultcode: 1234)", messages.FaultStruct{FaultCode: 1234, FaultString: "oops"}.Error())
+}
+
+func TestFaultDescription(t *testing.T) {
+	assert.Equal(t, "Invalid parameter name", messages.FaultDescription(9005))
+	assert.Equal(t, "Unknown Deployment Unit", messages.FaultDescription(9028))
+	assert.Equal(t, "Retry request", messages.FaultDescription(8005))
+	assert.Empty(t, messages.FaultDescription(9100))
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	NotificationOff     uint = 0
+	NotificationPassive uint = 1
+	NotificationActive  uint = 2
+)
+
+type GetParameterAttributes struct {
+	XMLName        xml.Name                `xml:"GetParameterAttributes" yaml:"-"`
+	ParameterNames ParameterNameListStruct `yaml:"ParameterNames"`
+}
+
+func (msg GetParameterAttributes) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "GetParameterAttributes")
+	type Alias GetParameterAttributes
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg GetParameterAttributes) GetName() string { return "GetParameterAttributes" }
+func (msg GetParameterAttributes) ValidateResponse(resp Message) error {
+	return ExpectMessage[GetParameterAttributesResponse](resp)
+}
+
+type GetParameterAttributesResponse struct {
+	XMLName       xml.Name                     `xml:"GetParameterAttributesResponse" yaml:"-"`
+	ParameterList ParameterAttributeListStruct `yaml:"ParameterList"`
+}
+
+func (msg GetParameterAttributesResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "GetParameterAttributesResponse")
+	type Alias GetParameterAttributesResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg GetParameterAttributesResponse) GetName() string { return "GetParameterAttributesResponse" }
+
+type ParameterAttributeListStruct struct {
+	Params []ParameterAttributeStruct `xml:"ParameterAttributeStruct"`
+}
+
+func (pl ParameterAttributeListStruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, XmlAttr(SoapArrayType, fmt.Sprintf("cwmp:ParameterAttributeStruct[%d]", len(pl.Params))))
+	type Alias ParameterAttributeListStruct
+	return enc.EncodeElement(Alias(pl), start)
+}
+
+func (pl ParameterAttributeListStruct) MarshalYAML() (any, error) {
+	return pl.Params, nil
+}
+
+func (pl *ParameterAttributeListStruct) UnmarshalYAML(value *yaml.Node) error {
+	return value.Decode(&pl.Params)
+}
+
+type ParameterAttributeStruct struct {
+	Name         string     `yaml:"Name"`
+	Notification uint       `yaml:"Notification"`
+	AccessList   AccessList `yaml:"AccessList"`
+}
@@ -0,0 +1,106 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	GetParameterAttributesInputXML = `<cwmp:GetParameterAttributes>
+  <ParameterNames soap-enc:arrayType="xsd:string[1]">
+    <string>Device.SoftwareModules.ExecutionUnit.1.Status</string>
+  </ParameterNames>
+</cwmp:GetParameterAttributes>`
+
+	GetParameterAttributesInputYAML = `ParameterNames:
+    - Device.SoftwareModules.ExecutionUnit.1.Status
+`
+
+	GetParameterAttributesResponseInputXML = `<cwmp:GetParameterAttributesResponse>
+  <ParameterList soap-enc:arrayType="cwmp:ParameterAttributeStruct[1]">
+    <ParameterAttributeStruct>
+      <Name>Device.SoftwareModules.ExecutionUnit.1.Status</Name>
+      <Notification>2</Notification>
+      <AccessList soap-enc:arrayType="xsd:string[1]">
+        <string>Subscriber</string>
+      </AccessList>
+    </ParameterAttributeStruct>
+  </ParameterList>
+</cwmp:GetParameterAttributesResponse>`
+)
+
+var GetParameterAttributesInputMsg = messages.GetParameterAttributes{
+	ParameterNames: messages.ParameterNameListStruct{
+		Params: []configuration.TemplateField{configuration.T("Device.SoftwareModules.ExecutionUnit.1.Status")},
+	},
+}
+
+var GetParameterAttributesResponseInputMsg = messages.GetParameterAttributesResponse{
+	ParameterList: messages.ParameterAttributeListStruct{
+		Params: []messages.ParameterAttributeStruct{
+			{
+				Name:         "Device.SoftwareModules.ExecutionUnit.1.Status",
+				Notification: messages.NotificationActive,
+				AccessList:   messages.AccessList{"Subscriber"},
+			},
+		},
+	},
+}
+
+func TestGetParameterAttributesSerializeToXML(t *testing.T) {
+	msg := GetParameterAttributesInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, GetParameterAttributesInputXML, buf.String())
+}
+
+func TestGetParameterAttributesParseFromYAML(t *testing.T) {
+	buf := bytes.NewBufferString(GetParameterAttributesInputYAML)
+	dec := yaml.NewDecoder(buf)
+	msg := messages.GetParameterAttributes{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing YAML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, GetParameterAttributesInputMsg.ParameterNames, msg.ParameterNames)
+}
+
+func TestGetParameterAttributesResponseParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(GetParameterAttributesResponseInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.GetParameterAttributesResponse{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, GetParameterAttributesResponseInputMsg.ParameterList, msg.ParameterList)
+}
+
+func TestGetParameterAttributesResponseSerializeToXML(t *testing.T) {
+	msg := GetParameterAttributesResponseInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, GetParameterAttributesResponseInputXML, buf.String())
+}
@@ -32,7 +32,7 @@ type GetParameterNamesResponse struct {
 }
 
 func (msg GetParameterNamesResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
-	PrefixCwmp(&start.Name)
+	PrefixCwmp(&start.Name, "GetParameterNamesResponse")
 	type Alias GetParameterNamesResponse
 	return enc.EncodeElement(Alias(msg), start)
 }
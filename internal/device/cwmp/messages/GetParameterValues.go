@@ -30,7 +30,7 @@ type GetParameterValuesResponse struct {
 }
 
 func (msg GetParameterValuesResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
-	PrefixCwmp(&start.Name)
+	PrefixCwmp(&start.Name, "GetParameterValuesResponse")
 	type Alias GetParameterValuesResponse
 	return enc.EncodeElement(Alias(msg), start)
 }
@@ -17,7 +17,7 @@ import (
 
 const (
 	GetParameterValuesInputXML = `<cwmp:GetParameterValues>
-  <ParameterNames soap-enc:array="xsd:string[2]">
+  <ParameterNames soap-enc:arrayType="xsd:string[2]">
     <string>Device.DeviceInfo.SoftwareVersion</string>
     <string>Device.DeviceInfo.HardwareVersion</string>
   </ParameterNames>
@@ -29,7 +29,7 @@ const (
 `
 
 	GetParameterValuesResponseInputXML = `<cwmp:GetParameterValuesResponse>
-  <ParameterList soap-enc:array="cwmp:ParameterValueStruct[2]">
+  <ParameterList soap-enc:arrayType="cwmp:ParameterValueStruct[2]">
     <ParameterValueStruct>
       <Name>Device.DeviceInfo.SoftwareVersion</Name>
       <Value xsi:type="xsd:string">1.0.0</Value>
@@ -31,7 +31,11 @@ func (msg GetRPCMethods) GenerateResponse() Message {
 			Methods: []string{
 				"Inform",
 				"GetRPCMethods",
+				"TransferComplete",
+				"AutonomousTransferComplete",
 				"DUStateChangeComplete",
+				"AutonomousDUStateChangeComplete",
+				"RequestDownload",
 			},
 		},
 	}
@@ -53,7 +57,7 @@ type MethodListStruct struct {
 }
 
 func (pl MethodListStruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
-	start.Attr = append(start.Attr, XmlAttr(SoapArray, fmt.Sprintf("xsd:string[%d]", len(pl.Methods))))
+	start.Attr = append(start.Attr, XmlAttr(SoapArrayType, fmt.Sprintf("xsd:string[%d]", len(pl.Methods))))
 	type Alias MethodListStruct
 	return enc.EncodeElement(Alias(pl), start)
 }
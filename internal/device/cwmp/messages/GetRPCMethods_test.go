@@ -18,17 +18,25 @@ const (
 	GetRPCMethodsInputXML = `<cwmp:GetRPCMethods></cwmp:GetRPCMethods>`
 
 	GetRPCMethodsResponseInputXML = `<cwmp:GetRPCMethodsResponse>
-  <MethodList soap-enc:array="xsd:string[3]">
+  <MethodList soap-enc:arrayType="xsd:string[7]">
     <string>Inform</string>
     <string>GetRPCMethods</string>
+    <string>TransferComplete</string>
+    <string>AutonomousTransferComplete</string>
     <string>DUStateChangeComplete</string>
+    <string>AutonomousDUStateChangeComplete</string>
+    <string>RequestDownload</string>
   </MethodList>
 </cwmp:GetRPCMethodsResponse>`
 
 	GetRPCMethodsResponseInputYAML = `MethodList:
     - Inform
     - GetRPCMethods
+    - TransferComplete
+    - AutonomousTransferComplete
     - DUStateChangeComplete
+    - AutonomousDUStateChangeComplete
+    - RequestDownload
 `
 )
 
@@ -67,10 +75,10 @@ func TestGetRPCMethodsResponseParseFromXML(t *testing.T) {
 		t.FailNow()
 	}
 
-	assert.Equal(t, 3, len(msg.MethodList.Methods))
+	assert.Equal(t, 7, len(msg.MethodList.Methods))
 	assert.Equal(t, "Inform", msg.MethodList.Methods[0])
 	assert.Equal(t, "GetRPCMethods", msg.MethodList.Methods[1])
-	assert.Equal(t, "DUStateChangeComplete", msg.MethodList.Methods[2])
+	assert.Equal(t, "DUStateChangeComplete", msg.MethodList.Methods[4])
 }
 
 func TestGetRPCMethodsResponseSerializeToXML(t *testing.T) {
@@ -94,10 +102,10 @@ func TestGetRPCMethodsResponseParseFromYAML(t *testing.T) {
 		t.FailNow()
 	}
 
-	assert.Equal(t, 3, len(msg.MethodList.Methods))
+	assert.Equal(t, 7, len(msg.MethodList.Methods))
 	assert.Equal(t, "Inform", msg.MethodList.Methods[0])
 	assert.Equal(t, "GetRPCMethods", msg.MethodList.Methods[1])
-	assert.Equal(t, "DUStateChangeComplete", msg.MethodList.Methods[2])
+	assert.Equal(t, "DUStateChangeComplete", msg.MethodList.Methods[4])
 }
 
 func TestGetRPCMethodsResponseSerializeToYAML(t *testing.T) {
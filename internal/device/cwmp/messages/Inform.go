@@ -7,6 +7,7 @@ package messages
 import (
 	"encoding/xml"
 	"fmt"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -35,6 +36,14 @@ type DeviceIDStruct struct {
 	SerialNumber string `yaml:"SerialNumber"`
 }
 
+// identity of the CPE in the form OUI-ProductClass-SerialNumber
+func (id DeviceIDStruct) String() string {
+	if len(id.ProductClass) > 0 {
+		return fmt.Sprintf("%s-%s-%s", id.OUI, id.ProductClass, id.SerialNumber)
+	}
+	return fmt.Sprintf("%s-%s", id.OUI, id.SerialNumber)
+}
+
 // EventStruct event
 type EventList struct {
 	Events []EventStruct `xml:"EventStruct"`
@@ -43,7 +52,7 @@ type EventList struct {
 // custom marshaller to add type attribute
 func (el EventList) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
 	start.Attr = append(start.Attr, xml.Attr{
-		Name:  xml.Name{Local: SoapArray},
+		Name:  xml.Name{Local: SoapArrayType},
 		Value: fmt.Sprintf("cwmp:EventStruct[%d]", len(el.Events)),
 	})
 	type Alias EventList
@@ -63,6 +72,44 @@ type EventStruct struct {
 	CommandKey string `yaml:"CommandKey"`
 }
 
+// check whether the list contains an event with the given code (and command key, if not empty)
+func (e EventList) Contains(eventCode, commandKey string) bool {
+	for _, ev := range e.Events {
+		if ev.EventCode == eventCode && (len(commandKey) == 0 || ev.CommandKey == commandKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// contents of the Inform as exposed to templates (`.device.inform`): the DeviceId fields, the events and the
+// parameters, nested by object (e.g. `.device.inform.Device.DeviceInfo.SoftwareVersion`)
+func (msg Inform) TemplateContext() map[string]any {
+	ctx := map[string]any{
+		"Manufacturer": msg.DeviceId.Manufacturer,
+		"OUI":          msg.DeviceId.OUI,
+		"ProductClass": msg.DeviceId.ProductClass,
+		"SerialNumber": msg.DeviceId.SerialNumber,
+		"Event":        msg.Event.Events,
+		"CurrentTime":  msg.CurrentTime,
+		"RetryCount":   msg.RetryCount,
+	}
+	for _, p := range msg.ParameterList.Params {
+		object := ctx
+		segments := strings.Split(p.Name.RawTemplate, ".")
+		for _, segment := range segments[:len(segments)-1] {
+			child, ok := object[segment].(map[string]any)
+			if !ok {
+				child = make(map[string]any)
+				object[segment] = child
+			}
+			object = child
+		}
+		object[segments[len(segments)-1]] = p.Content.Value.RawTemplate
+	}
+	return ctx
+}
+
 func (msg Inform) GetName() string           { return "Inform" }
 func (msg Inform) GenerateResponse() Message { return InformResponse{MaxEnvelopes: 1} }
 
@@ -73,7 +73,7 @@ const (
     <ProductClass>Beacon 9</ProductClass>
     <SerialNumber>SN1234567890</SerialNumber>
   </DeviceId>
-  <Event soap-enc:array="cwmp:EventStruct[2]">
+  <Event soap-enc:arrayType="cwmp:EventStruct[2]">
     <EventStruct>
       <EventCode>1 BOOT</EventCode>
       <CommandKey>12345</CommandKey>
@@ -86,7 +86,7 @@ const (
   <MaxEnvelopes>1</MaxEnvelopes>
   <CurrentTime>2026-03-29T23:45:00Z</CurrentTime>
   <RetryCount>2</RetryCount>
-  <ParameterList soap-enc:array="cwmp:ParameterValueStruct[1]">
+  <ParameterList soap-enc:arrayType="cwmp:ParameterValueStruct[1]">
     <ParameterValueStruct>
       <Name>Device.SoftwareModules.ExecutionUnit.1.Version</Name>
       <Value xsi:type="xsd:string">1.0.0</Value>
@@ -142,6 +142,27 @@ func TestInformParseFromXML(t *testing.T) {
 	assert.Equal(t, messages.XsdString, msg.ParameterList.Params[3].Content.Type)
 }
 
+func TestInformTemplateContext(t *testing.T) {
+	msg := messages.Inform{}
+	if err := xml.Unmarshal([]byte(InformInputXML), &msg); err != nil {
+		t.Logf("Failed parsing xml input: %s", err.Error())
+		t.FailNow()
+	}
+	ctx := msg.TemplateContext()
+	for path, expected := range map[string]any{
+		"SerialNumber":                      "1234567890",
+		"ProductClass":                      "RouterModelX",
+		"RetryCount":                        uint(5),
+		"Event.1.EventCode":                 messages.EventBoot,
+		"Device.DeviceInfo.SoftwareVersion": "1.0.0",
+		"Device.WANDevice.1.WANConnectionDevice.1.WANIPConnection.1.ExternalIPAddress": "203.0.113.45",
+	} {
+		value, err := configuration.ReadField(ctx, path)
+		assert.NoError(t, err, path)
+		assert.Equal(t, expected, value, path)
+	}
+}
+
 func TestInformSerializeToXML(t *testing.T) {
 	msg := messages.Inform{
 		DeviceId: messages.DeviceIDStruct{
@@ -14,24 +14,36 @@ import (
 )
 
 const (
-	XsdString      string = "xsd:string"
-	XsdUnsignedint string = "xsd:unsignedInt"
+	XsdString       string = "xsd:string"
+	XsdInt          string = "xsd:int"
+	XsdUnsignedint  string = "xsd:unsignedInt"
+	XsdLong         string = "xsd:long"
+	XsdUnsignedLong string = "xsd:unsignedLong"
+	XsdBoolean      string = "xsd:boolean"
+	XsdDateTime     string = "xsd:dateTime"
+	XsdBase64       string = "xsd:base64"
+	XsdHexBinary    string = "xsd:hexBinary"
 )
 
 const (
-	SoapArray string = "soap-enc:array"
-	XsiType   string = "xsi:type"
+	SoapArrayType string = "soap-enc:arrayType"
+	XsiType       string = "xsi:type"
 )
 
 const (
-	EventBootStrap         string = "0 BOOTSTRAP"
-	EventBoot              string = "1 BOOT"
-	EventPeriodic          string = "2 PERIODIC"
-	EventScheduled         string = "3 SCHEDULED"
-	EventValueChange       string = "4 VALUE CHANGE"
-	EventKicked            string = "5 KICKED"
-	EventConnectionRequest string = "6 CONNECTION REQUEST"
-	EventTransferComplete  string = "7 TRANSFER COMPLETE"
+	EventBootStrap             string = "0 BOOTSTRAP"
+	EventBoot                  string = "1 BOOT"
+	EventPeriodic              string = "2 PERIODIC"
+	EventScheduled             string = "3 SCHEDULED"
+	EventValueChange           string = "4 VALUE CHANGE"
+	EventKicked                string = "5 KICKED"
+	EventConnectionRequest     string = "6 CONNECTION REQUEST"
+	EventTransferComplete      string = "7 TRANSFER COMPLETE"
+	EventDUStateChangeComplete string = "11 DU STATE CHANGE COMPLETE"
+	EventMReboot               string = "M Reboot"
+	EventMDownload             string = "M Download"
+	EventMScheduleInform       string = "M ScheduleInform"
+	EventMChangeDUState        string = "M ChangeDUState"
 )
 
 // helpers
@@ -71,6 +83,20 @@ type AsyncRPC interface {
 	Match(m Message) bool
 }
 
+// AsyncRPC for which the completion notification is only expected when the
+// (synchronous) response reports the operation as still pending
+type ConditionalAsyncRPC interface {
+	AsyncRPC
+	AwaitNotification(resp Message) bool
+}
+
+func IsPending(rpc AsyncRPC, resp Message) bool {
+	if c, ok := rpc.(ConditionalAsyncRPC); ok {
+		return c.AwaitNotification(resp)
+	}
+	return true
+}
+
 type ACSMethod interface {
 	Message
 	GenerateResponse() Message
@@ -100,7 +126,7 @@ type ParameterNameListStruct struct {
 }
 
 func (pl ParameterNameListStruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
-	start.Attr = append(start.Attr, XmlAttr(SoapArray, fmt.Sprintf("xsd:string[%d]", len(pl.Params))))
+	start.Attr = append(start.Attr, XmlAttr(SoapArrayType, fmt.Sprintf("xsd:string[%d]", len(pl.Params))))
 	type Alias ParameterNameListStruct
 	return enc.EncodeElement(Alias(pl), start)
 }
@@ -118,7 +144,7 @@ type ParameterValueListStruct struct {
 }
 
 func (pl ParameterValueListStruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
-	start.Attr = append(start.Attr, XmlAttr(SoapArray, fmt.Sprintf("cwmp:ParameterValueStruct[%d]", len(pl.Params))))
+	start.Attr = append(start.Attr, XmlAttr(SoapArrayType, fmt.Sprintf("cwmp:ParameterValueStruct[%d]", len(pl.Params))))
 	type Alias ParameterValueListStruct
 	return enc.EncodeElement(Alias(pl), start)
 }
@@ -140,3 +166,6 @@ type FaultStruct struct {
 	FaultCode   uint   `yaml:"FaultCode"`
 	FaultString string `yaml:"FaultString"`
 }
+
+func (f FaultStruct) Error() string      { return formatFault(f.FaultString, f.FaultCode) }
+func (f FaultStruct) GetFaultCode() uint { return f.FaultCode }
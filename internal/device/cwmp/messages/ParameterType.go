@@ -0,0 +1,84 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TR-069 data types of parameter values (xsi:type)
+var parameterTypes = []string{
+	XsdString,
+	XsdInt,
+	XsdUnsignedint,
+	XsdLong,
+	XsdUnsignedLong,
+	XsdBoolean,
+	XsdDateTime,
+	XsdBase64,
+	XsdHexBinary,
+}
+
+// xsi:type of the given data type, given with or without the "xsd:" prefix; base64Binary is accepted for base64
+func ParseParameterType(t string) (string, error) {
+	name := strings.TrimPrefix(t, "xsd:")
+	if name == "base64Binary" {
+		name = "base64"
+	}
+	for _, pt := range parameterTypes {
+		if strings.EqualFold(pt, "xsd:"+name) {
+			return pt, nil
+		}
+	}
+	return "", fmt.Errorf("unknown parameter type '%s'; expected one of: %s", t, strings.Join(parameterTypes, ", "))
+}
+
+// xsi:type inferred from the tag of a YAML scalar: unquoted booleans; anything else, including numbers, is a string
+// (the CPE faults a string parameter given as xsd:unsignedInt)
+func InferParameterType(node *yaml.Node) string {
+	if node.ShortTag() == "!!bool" {
+		return XsdBoolean
+	}
+	return XsdString
+}
+
+// check that the value is valid for the given xsi:type; any value is a valid string
+func ValidateParameterValue(t, value string) error {
+	var err error
+	switch t {
+	case XsdInt:
+		_, err = strconv.ParseInt(value, 10, 32)
+	case XsdUnsignedint:
+		_, err = strconv.ParseUint(value, 10, 32)
+	case XsdLong:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case XsdUnsignedLong:
+		_, err = strconv.ParseUint(value, 10, 64)
+	case XsdBoolean:
+		if value != "true" && value != "false" && value != "1" && value != "0" {
+			err = fmt.Errorf("expected true, false, 1 or 0")
+		}
+	case XsdDateTime:
+		// the time zone is optional (TR-069 section A.2.1)
+		if _, err = time.Parse(time.RFC3339Nano, value); err != nil {
+			_, err = time.Parse("2006-01-02T15:04:05.999999999", value)
+		}
+	case XsdBase64:
+		_, err = base64.StdEncoding.DecodeString(value)
+	case XsdHexBinary:
+		_, err = hex.DecodeString(value)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s value '%s'", t, value)
+	}
+	return nil
+}
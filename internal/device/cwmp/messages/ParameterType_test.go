@@ -0,0 +1,166 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	TypedSetParameterValuesInputYAML = `ParameterList:
+    - Name: Device.ManagementServer.PeriodicInformEnable
+      Value: true
+    - Name: Device.ManagementServer.PeriodicInformInterval
+      Value: 300
+    - Name: Device.Time.LocalTimeZone
+      Value: "true"
+    - Name: Device.X_VENDOR.Offset
+      Type: int
+      Value: -5
+    - Name: Device.X_VENDOR.Counter
+      Type: long
+      Value: 4294967296
+    - Name: Device.X_VENDOR.Key
+      Type: xsd:hexBinary
+      Value: 0a1B
+ParameterKey: typed
+`
+
+	TypedSetParameterValuesOutputXML = `<cwmp:SetParameterValues>
+  <ParameterList soap-enc:arrayType="cwmp:ParameterValueStruct[6]">
+    <ParameterValueStruct>
+      <Name>Device.ManagementServer.PeriodicInformEnable</Name>
+      <Value xsi:type="xsd:boolean">true</Value>
+    </ParameterValueStruct>
+    <ParameterValueStruct>
+      <Name>Device.ManagementServer.PeriodicInformInterval</Name>
+      <Value xsi:type="xsd:string">300</Value>
+    </ParameterValueStruct>
+    <ParameterValueStruct>
+      <Name>Device.Time.LocalTimeZone</Name>
+      <Value xsi:type="xsd:string">true</Value>
+    </ParameterValueStruct>
+    <ParameterValueStruct>
+      <Name>Device.X_VENDOR.Offset</Name>
+      <Value xsi:type="xsd:int">-5</Value>
+    </ParameterValueStruct>
+    <ParameterValueStruct>
+      <Name>Device.X_VENDOR.Counter</Name>
+      <Value xsi:type="xsd:long">4294967296</Value>
+    </ParameterValueStruct>
+    <ParameterValueStruct>
+      <Name>Device.X_VENDOR.Key</Name>
+      <Value xsi:type="xsd:hexBinary">0a1B</Value>
+    </ParameterValueStruct>
+  </ParameterList>
+  <ParameterKey>typed</ParameterKey>
+</cwmp:SetParameterValues>`
+)
+
+func TestParseParameterType(t *testing.T) {
+	tests := map[string]string{
+		"boolean":           messages.XsdBoolean,
+		"xsd:unsignedInt":   messages.XsdUnsignedint,
+		"unsignedint":       messages.XsdUnsignedint,
+		"dateTime":          messages.XsdDateTime,
+		"xsd:base64Binary":  messages.XsdBase64,
+		"string":            messages.XsdString,
+		"xsd:unsignedLong":  messages.XsdUnsignedLong,
+	}
+	for input, expected := range tests {
+		pt, err := messages.ParseParameterType(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, pt, input)
+	}
+	_, err := messages.ParseParameterType("xsd:decimal")
+	assert.ErrorContains(t, err, "unknown parameter type 'xsd:decimal'")
+}
+
+func TestValidateParameterValue(t *testing.T) {
+	valid := [][2]string{
+		{messages.XsdString, "anything"},
+		{messages.XsdBoolean, "1"},
+		{messages.XsdBoolean, "false"},
+		{messages.XsdInt, "-2147483648"},
+		{messages.XsdUnsignedint, "4294967295"},
+		{messages.XsdLong, "-9223372036854775808"},
+		{messages.XsdUnsignedLong, "18446744073709551615"},
+		{messages.XsdDateTime, "2024-05-01T12:30:00Z"},
+		{messages.XsdDateTime, "2024-05-01T12:30:00.5+02:00"},
+		{messages.XsdDateTime, "0001-01-01T00:00:00"},
+		{messages.XsdBase64, "Y29ydGVjYQ=="},
+		{messages.XsdHexBinary, "00ff"},
+	}
+	for _, v := range valid {
+		assert.NoError(t, messages.ValidateParameterValue(v[0], v[1]), "%s %s", v[0], v[1])
+	}
+	invalid := [][2]string{
+		{messages.XsdBoolean, "yes"},
+		{messages.XsdInt, "2147483648"},
+		{messages.XsdUnsignedint, "-1"},
+		{messages.XsdUnsignedint, "0x10"},
+		{messages.XsdLong, "1.5"},
+		{messages.XsdDateTime, "2024-05-01"},
+		{messages.XsdBase64, "not base64!"},
+		{messages.XsdHexBinary, "0g"},
+	}
+	for _, v := range invalid {
+		assert.Error(t, messages.ValidateParameterValue(v[0], v[1]), "%s %s", v[0], v[1])
+	}
+}
+
+func TestSetParameterValuesTypesFromYAML(t *testing.T) {
+	msg := messages.SetParameterValues{}
+	require.NoError(t, yaml.Unmarshal([]byte(TypedSetParameterValuesInputYAML), &msg))
+
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	require.NoError(t, enc.Encode(msg))
+	assert.Equal(t, TypedSetParameterValuesOutputXML, buf.String())
+}
+
+func TestSetParameterValuesInvalidValueFromYAML(t *testing.T) {
+	input := `ParameterList:
+    - Name: Device.ManagementServer.PeriodicInformInterval
+      Type: unsignedInt
+      Value: "-300"
+`
+	msg := messages.SetParameterValues{}
+	err := yaml.Unmarshal([]byte(input), &msg)
+	assert.EqualError(t, err, "parameter 'Device.ManagementServer.PeriodicInformInterval': invalid xsd:unsignedInt value '-300'")
+
+	input = `ParameterList:
+    - Name: Device.ManagementServer.PeriodicInformInterval
+      Type: xsd:float
+      Value: 1.5
+`
+	err = yaml.Unmarshal([]byte(input), &msg)
+	assert.ErrorContains(t, err, "parameter 'Device.ManagementServer.PeriodicInformInterval': unknown parameter type 'xsd:float'")
+}
+
+func TestSetParameterValuesTemplateValidatedOnceExpanded(t *testing.T) {
+	input := `ParameterList:
+    - Name: Device.ManagementServer.PeriodicInformInterval
+      Type: unsignedInt
+      Value: "${ .app.version }"
+`
+	msg := messages.SetParameterValues{}
+	require.NoError(t, yaml.Unmarshal([]byte(input), &msg))
+
+	configuration.ResetContext()
+	configuration.GetCmdContext().App.Version = "300"
+	assert.NoError(t, msg.ValidateValues())
+	configuration.GetCmdContext().App.Version = "-300"
+	assert.EqualError(t, msg.ValidateValues(), "parameter 'Device.ManagementServer.PeriodicInformInterval': invalid xsd:unsignedInt value '-300'")
+}
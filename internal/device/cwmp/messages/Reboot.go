@@ -0,0 +1,49 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"encoding/xml"
+)
+
+type Reboot struct {
+	XMLName    xml.Name                    `xml:"Reboot" yaml:"-"`
+	CommandKey configuration.TemplateField `yaml:"CommandKey"`
+}
+
+func (msg Reboot) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "Reboot")
+	type Alias Reboot
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg Reboot) GetName() string { return "Reboot" }
+func (msg Reboot) ValidateResponse(resp Message) error {
+	if _, ok := resp.(Inform); ok {
+		return nil
+	}
+	return ExpectMessage[RebootResponse](resp)
+}
+
+// the CPE signals a completed reboot with an Inform carrying both "1 BOOT" and "M Reboot" (with the command key)
+func (msg Reboot) Match(m Message) bool {
+	if inform, ok := m.(Inform); ok {
+		return inform.Event.Contains(EventBoot, "") && inform.Event.Contains(EventMReboot, msg.CommandKey.String())
+	}
+	return false
+}
+
+type RebootResponse struct {
+	XMLName xml.Name `xml:"RebootResponse" yaml:"-"`
+}
+
+func (msg RebootResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "RebootResponse")
+	type Alias RebootResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg RebootResponse) GetName() string { return "RebootResponse" }
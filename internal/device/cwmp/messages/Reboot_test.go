@@ -0,0 +1,110 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	RebootInputXML = `<cwmp:Reboot>
+  <CommandKey>post-install</CommandKey>
+</cwmp:Reboot>`
+
+	RebootInputYAML = `CommandKey: post-install
+`
+
+	RebootResponseInputXML = `<cwmp:RebootResponse></cwmp:RebootResponse>`
+)
+
+var RebootInputMsg = messages.Reboot{
+	CommandKey: configuration.T("post-install"),
+}
+
+func newBootInform(events ...messages.EventStruct) messages.Inform {
+	return messages.Inform{Event: messages.EventList{Events: events}}
+}
+
+func TestRebootParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(RebootInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.Reboot{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, "post-install", msg.CommandKey.String())
+}
+
+func TestRebootSerializeToXML(t *testing.T) {
+	msg := RebootInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, RebootInputXML, buf.String())
+}
+
+func TestRebootParseFromYAML(t *testing.T) {
+	buf := bytes.NewBufferString(RebootInputYAML)
+	dec := yaml.NewDecoder(buf)
+	msg := messages.Reboot{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing YAML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, "post-install", msg.CommandKey.String())
+}
+
+func TestRebootResponseSerializeToXML(t *testing.T) {
+	msg := messages.RebootResponse{}
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, RebootResponseInputXML, buf.String())
+}
+
+func TestRebootMatchesBootInform(t *testing.T) {
+	msg := RebootInputMsg
+	assert.True(t, msg.Match(newBootInform(
+		messages.EventStruct{EventCode: messages.EventBoot},
+		messages.EventStruct{EventCode: messages.EventMReboot, CommandKey: "post-install"},
+	)))
+	// command key mismatch
+	assert.False(t, msg.Match(newBootInform(
+		messages.EventStruct{EventCode: messages.EventBoot},
+		messages.EventStruct{EventCode: messages.EventMReboot, CommandKey: "other"},
+	)))
+	// boot not caused by the Reboot RPC
+	assert.False(t, msg.Match(newBootInform(
+		messages.EventStruct{EventCode: messages.EventBoot},
+	)))
+	assert.False(t, msg.Match(newBootInform(
+		messages.EventStruct{EventCode: messages.EventPeriodic},
+	)))
+	assert.False(t, msg.Match(messages.RebootResponse{}))
+}
+
+func TestRebootValidateResponse(t *testing.T) {
+	msg := RebootInputMsg
+	assert.NoError(t, msg.ValidateResponse(messages.RebootResponse{}))
+	assert.NoError(t, msg.ValidateResponse(newBootInform()))
+	assert.Error(t, msg.ValidateResponse(messages.FactoryResetResponse{}))
+}
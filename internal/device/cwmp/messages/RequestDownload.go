@@ -0,0 +1,76 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// request of the CPE for the ACS to call Download with a file of the given type
+type RequestDownload struct {
+	XMLName     xml.Name          `xml:"RequestDownload" yaml:"-"`
+	FileType    string            `yaml:"FileType"`
+	FileTypeArg FileTypeArgStruct `yaml:"FileTypeArg"`
+}
+
+func (msg RequestDownload) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "RequestDownload")
+	type Alias RequestDownload
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+type ArgStruct struct {
+	Name  string `yaml:"Name"`
+	Value string `yaml:"Value"`
+}
+
+type FileTypeArgStruct struct {
+	Args []ArgStruct `xml:"ArgStruct"`
+}
+
+func (al FileTypeArgStruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, XmlAttr(SoapArrayType, fmt.Sprintf("cwmp:ArgStruct[%d]", len(al.Args))))
+	type Alias FileTypeArgStruct
+	return enc.EncodeElement(Alias(al), start)
+}
+
+func (al FileTypeArgStruct) MarshalYAML() (any, error) {
+	return al.Args, nil
+}
+
+func (al *FileTypeArgStruct) UnmarshalYAML(value *yaml.Node) error {
+	return value.Decode(&al.Args)
+}
+
+// contents of the request as exposed to templates (`.device.requestDownload`): the FileType and the FileTypeArg
+// values by name (e.g. `.device.requestDownload.FileTypeArg.Version`)
+func (m RequestDownload) TemplateContext() map[string]any {
+	args := make(map[string]any, len(m.FileTypeArg.Args))
+	for _, arg := range m.FileTypeArg.Args {
+		args[arg.Name] = arg.Value
+	}
+	return map[string]any{"FileType": m.FileType, "FileTypeArg": args}
+}
+
+func (m RequestDownload) GetName() string { return "RequestDownload" }
+func (m RequestDownload) ValidateResponse(msg Message) error {
+	return ExpectMessage[RequestDownloadResponse](msg)
+}
+func (m RequestDownload) GenerateResponse() Message { return RequestDownloadResponse{} }
+
+type RequestDownloadResponse struct {
+	XMLName xml.Name `xml:"RequestDownloadResponse" yaml:"-"`
+}
+
+func (msg RequestDownloadResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "RequestDownloadResponse")
+	type Alias RequestDownloadResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (m RequestDownloadResponse) GetName() string { return "RequestDownloadResponse" }
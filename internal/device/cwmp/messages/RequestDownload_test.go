@@ -0,0 +1,102 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	RequestDownloadInputXML = `<cwmp:RequestDownload>
+  <FileType>2 Web Content</FileType>
+  <FileTypeArg soap-enc:arrayType="cwmp:ArgStruct[1]">
+    <ArgStruct>
+      <Name>Version</Name>
+      <Value>1.2</Value>
+    </ArgStruct>
+  </FileTypeArg>
+</cwmp:RequestDownload>`
+
+	RequestDownloadInputYAML = `FileType: 2 Web Content
+FileTypeArg:
+    - Name: Version
+      Value: "1.2"
+`
+
+	RequestDownloadResponseInputXML = `<cwmp:RequestDownloadResponse></cwmp:RequestDownloadResponse>`
+)
+
+var RequestDownloadInputMsg = messages.RequestDownload{
+	FileType:    "2 Web Content",
+	FileTypeArg: messages.FileTypeArgStruct{Args: []messages.ArgStruct{{Name: "Version", Value: "1.2"}}},
+}
+
+func TestRequestDownloadParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(RequestDownloadInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.RequestDownload{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, RequestDownloadInputMsg.FileType, msg.FileType)
+	assert.Equal(t, RequestDownloadInputMsg.FileTypeArg, msg.FileTypeArg)
+}
+
+func TestRequestDownloadSerializeToXML(t *testing.T) {
+	msg := RequestDownloadInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, RequestDownloadInputXML, buf.String())
+}
+
+func TestRequestDownloadParseFromYAML(t *testing.T) {
+	buf := bytes.NewBufferString(RequestDownloadInputYAML)
+	dec := yaml.NewDecoder(buf)
+	msg := messages.RequestDownload{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing YAML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, RequestDownloadInputMsg, msg)
+}
+
+func TestRequestDownloadSerializeToYAML(t *testing.T) {
+	msg := RequestDownloadInputMsg
+	outbuf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := yaml.NewEncoder(outbuf)
+	enc.SetIndent(4)
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating YAML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, RequestDownloadInputYAML, outbuf.String())
+}
+
+func TestRequestDownloadGenerateResponse(t *testing.T) {
+	resp := RequestDownloadInputMsg.GenerateResponse()
+	assert.NoError(t, RequestDownloadInputMsg.ValidateResponse(resp))
+
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	if err := enc.Encode(resp); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, RequestDownloadResponseInputXML, buf.String())
+}
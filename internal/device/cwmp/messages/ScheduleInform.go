@@ -0,0 +1,39 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"encoding/xml"
+)
+
+type ScheduleInform struct {
+	XMLName      xml.Name                    `xml:"ScheduleInform" yaml:"-"`
+	DelaySeconds uint                        `yaml:"DelaySeconds"`
+	CommandKey   configuration.TemplateField `yaml:"CommandKey"`
+}
+
+func (msg ScheduleInform) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "ScheduleInform")
+	type Alias ScheduleInform
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg ScheduleInform) GetName() string { return "ScheduleInform" }
+func (msg ScheduleInform) ValidateResponse(resp Message) error {
+	return ExpectMessage[ScheduleInformResponse](resp)
+}
+
+type ScheduleInformResponse struct {
+	XMLName xml.Name `xml:"ScheduleInformResponse" yaml:"-"`
+}
+
+func (msg ScheduleInformResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "ScheduleInformResponse")
+	type Alias ScheduleInformResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg ScheduleInformResponse) GetName() string { return "ScheduleInformResponse" }
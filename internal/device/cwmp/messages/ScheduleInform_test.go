@@ -0,0 +1,76 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ScheduleInformInputXML = `<cwmp:ScheduleInform>
+  <DelaySeconds>60</DelaySeconds>
+  <CommandKey>check-in</CommandKey>
+</cwmp:ScheduleInform>`
+
+	ScheduleInformInputYAML = `DelaySeconds: 60
+CommandKey: check-in
+`
+)
+
+var ScheduleInformInputMsg = messages.ScheduleInform{
+	DelaySeconds: 60,
+	CommandKey:   configuration.T("check-in"),
+}
+
+func TestScheduleInformParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(ScheduleInformInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.ScheduleInform{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, uint(60), msg.DelaySeconds)
+	assert.Equal(t, "check-in", msg.CommandKey.String())
+}
+
+func TestScheduleInformSerializeToXML(t *testing.T) {
+	msg := ScheduleInformInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, ScheduleInformInputXML, buf.String())
+}
+
+func TestScheduleInformParseFromYAML(t *testing.T) {
+	buf := bytes.NewBufferString(ScheduleInformInputYAML)
+	dec := yaml.NewDecoder(buf)
+	msg := messages.ScheduleInform{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing YAML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, uint(60), msg.DelaySeconds)
+	assert.Equal(t, "check-in", msg.CommandKey.String())
+}
+
+func TestScheduleInformValidateResponse(t *testing.T) {
+	msg := ScheduleInformInputMsg
+	assert.NoError(t, msg.ValidateResponse(messages.ScheduleInformResponse{}))
+	assert.Error(t, msg.ValidateResponse(messages.RebootResponse{}))
+}
@@ -0,0 +1,148 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"encoding/xml"
+	"fmt"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+type SetParameterAttributes struct {
+	XMLName       xml.Name                         `xml:"SetParameterAttributes" yaml:"-"`
+	ParameterList SetParameterAttributesListStruct `yaml:"ParameterList"`
+	// when set, the step blocks until a "4 VALUE CHANGE" Inform reports a value satisfying any of the conditions
+	WaitFor []ValueCondition `xml:"-" yaml:"WaitFor,omitempty"`
+}
+
+func (msg SetParameterAttributes) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "SetParameterAttributes")
+	type Alias SetParameterAttributes
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg SetParameterAttributes) GetName() string { return "SetParameterAttributes" }
+func (msg SetParameterAttributes) ValidateResponse(resp Message) error {
+	if _, ok := resp.(Inform); ok {
+		return nil
+	}
+	return ExpectMessage[SetParameterAttributesResponse](resp)
+}
+func (msg SetParameterAttributes) Match(m Message) bool {
+	inform, ok := m.(Inform)
+	if !ok || !inform.Event.Contains(EventValueChange, "") {
+		return false
+	}
+	for _, param := range inform.ParameterList.Params {
+		for _, cond := range msg.WaitFor {
+			if cond.Match(param) {
+				return true
+			}
+		}
+	}
+	return false
+}
+func (msg SetParameterAttributes) AwaitNotification(resp Message) bool {
+	return len(msg.WaitFor) > 0
+}
+
+type SetParameterAttributesListStruct struct {
+	Params []SetParameterAttributesStruct `xml:"SetParameterAttributesStruct"`
+}
+
+func (pl SetParameterAttributesListStruct) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, XmlAttr(SoapArrayType, fmt.Sprintf("cwmp:SetParameterAttributesStruct[%d]", len(pl.Params))))
+	type Alias SetParameterAttributesListStruct
+	return enc.EncodeElement(Alias(pl), start)
+}
+
+func (pl SetParameterAttributesListStruct) MarshalYAML() (any, error) {
+	return pl.Params, nil
+}
+
+func (pl *SetParameterAttributesListStruct) UnmarshalYAML(value *yaml.Node) error {
+	return value.Decode(&pl.Params)
+}
+
+type SetParameterAttributesStruct struct {
+	Name               configuration.TemplateField `yaml:"Name"`
+	NotificationChange bool                        `yaml:"NotificationChange"`
+	Notification       uint                        `yaml:"Notification"`
+	AccessListChange   bool                        `yaml:"AccessListChange"`
+	AccessList         AccessList                  `yaml:"AccessList"`
+}
+
+// entities granted write access to a parameter; always sent, as an empty list is how write access is cleared
+type AccessList []string
+
+func (al AccessList) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, XmlAttr(SoapArrayType, fmt.Sprintf("xsd:string[%d]", len(al))))
+	return enc.EncodeElement(struct {
+		Entries []string `xml:"string"`
+	}{al}, start)
+}
+
+func (al *AccessList) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var entries struct {
+		Entries []string `xml:"string"`
+	}
+	if err := dec.DecodeElement(&entries, &start); err != nil {
+		return err
+	}
+	*al = entries.Entries
+	return nil
+}
+
+// condition on a parameter value reported by the CPE; either an exact Value or a Pattern (regular expression)
+type ValueCondition struct {
+	Name    configuration.TemplateField `yaml:"Name"`
+	Value   configuration.TemplateField `yaml:"Value,omitempty"`
+	Pattern string                      `yaml:"Pattern,omitempty"`
+	re      *regexp.Regexp
+}
+
+func (c *ValueCondition) UnmarshalYAML(value *yaml.Node) error {
+	type Alias ValueCondition
+	if err := value.Decode((*Alias)(c)); err != nil {
+		return err
+	}
+	if len(c.Pattern) > 0 {
+		re, err := regexp.Compile(c.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern for parameter '%s': %w", c.Name.String(), err)
+		}
+		c.re = re
+	} else if len(c.Value.RawTemplate) == 0 {
+		return fmt.Errorf("condition on parameter '%s' requires a Value or a Pattern", c.Name.String())
+	}
+	return nil
+}
+
+func (c ValueCondition) Match(param ParameterValueStruct) bool {
+	// values reported by the CPE are matched verbatim, never expanded as templates
+	if param.Name.RawTemplate != c.Name.String() {
+		return false
+	}
+	value := param.Content.Value.RawTemplate
+	if c.re != nil {
+		return c.re.MatchString(value)
+	}
+	return value == c.Value.String()
+}
+
+type SetParameterAttributesResponse struct {
+	XMLName xml.Name `xml:"SetParameterAttributesResponse" yaml:"-"`
+}
+
+func (msg SetParameterAttributesResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "SetParameterAttributesResponse")
+	type Alias SetParameterAttributesResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg SetParameterAttributesResponse) GetName() string { return "SetParameterAttributesResponse" }
@@ -0,0 +1,162 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	SetParameterAttributesInputXML = `<cwmp:SetParameterAttributes>
+  <ParameterList soap-enc:arrayType="cwmp:SetParameterAttributesStruct[1]">
+    <SetParameterAttributesStruct>
+      <Name>Device.SoftwareModules.ExecutionUnit.1.Status</Name>
+      <NotificationChange>true</NotificationChange>
+      <Notification>2</Notification>
+      <AccessListChange>false</AccessListChange>
+      <AccessList soap-enc:arrayType="xsd:string[0]"></AccessList>
+    </SetParameterAttributesStruct>
+  </ParameterList>
+</cwmp:SetParameterAttributes>`
+
+	AccessListXML = `<AccessList soap-enc:arrayType="xsd:string[1]">
+  <string>Subscriber</string>
+</AccessList>`
+
+	SetParameterAttributesInputYAML = `ParameterList:
+    - Name: Device.SoftwareModules.ExecutionUnit.1.Status
+      NotificationChange: true
+      Notification: 2
+WaitFor:
+    - Name: Device.SoftwareModules.ExecutionUnit.1.Status
+      Value: Active
+    - Name: Device.SoftwareModules.ExecutionUnit.1.Status
+      Pattern: ^(Idle|Error)$
+`
+)
+
+var SetParameterAttributesInputMsg = messages.SetParameterAttributes{
+	ParameterList: messages.SetParameterAttributesListStruct{
+		Params: []messages.SetParameterAttributesStruct{
+			{
+				Name:               configuration.T("Device.SoftwareModules.ExecutionUnit.1.Status"),
+				NotificationChange: true,
+				Notification:       messages.NotificationActive,
+			},
+		},
+	},
+}
+
+func newValueChangeInform(name, value string) messages.Inform {
+	return messages.Inform{
+		Event: messages.EventList{Events: []messages.EventStruct{{EventCode: messages.EventValueChange}}},
+		ParameterList: messages.ParameterValueListStruct{
+			Params: []messages.ParameterValueStruct{
+				{Name: configuration.T(name), Content: messages.NodeStruct{Type: messages.XsdString, Value: configuration.T(value)}},
+			},
+		},
+	}
+}
+
+func parseSetParameterAttributes(t *testing.T, input string) messages.SetParameterAttributes {
+	buf := bytes.NewBufferString(input)
+	dec := yaml.NewDecoder(buf)
+	msg := messages.SetParameterAttributes{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing YAML input: %s", err.Error())
+		t.FailNow()
+	}
+	return msg
+}
+
+func TestSetParameterAttributesSerializeToXML(t *testing.T) {
+	msg := SetParameterAttributesInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, SetParameterAttributesInputXML, buf.String())
+}
+
+func TestAccessListSerializeToXML(t *testing.T) {
+	buf := bytes.NewBuffer(make([]byte, 0, 256))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(messages.AccessList{"Subscriber"}); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, AccessListXML, buf.String())
+}
+
+func TestSetParameterAttributesParseFromYAML(t *testing.T) {
+	msg := parseSetParameterAttributes(t, SetParameterAttributesInputYAML)
+
+	assert.Equal(t, SetParameterAttributesInputMsg.ParameterList, msg.ParameterList)
+	assert.Len(t, msg.WaitFor, 2)
+	assert.Equal(t, "Active", msg.WaitFor[0].Value.String())
+	assert.Equal(t, "^(Idle|Error)$", msg.WaitFor[1].Pattern)
+}
+
+func TestSetParameterAttributesParseFromYAML_InvalidPattern(t *testing.T) {
+	msg := messages.SetParameterAttributes{}
+	err := yaml.Unmarshal([]byte("WaitFor:\n  - Name: Device.X\n    Pattern: \"(\"\n"), &msg)
+	assert.Error(t, err)
+}
+
+func TestSetParameterAttributesParseFromYAML_EmptyCondition(t *testing.T) {
+	msg := messages.SetParameterAttributes{}
+	err := yaml.Unmarshal([]byte("WaitFor:\n  - Name: Device.X\n"), &msg)
+	assert.Error(t, err)
+}
+
+func TestAccessListParseFromXML(t *testing.T) {
+	var al messages.AccessList
+	if err := xml.Unmarshal([]byte(AccessListXML), &al); err != nil {
+		t.Fatalf("Failed parsing XML input: %s", err.Error())
+	}
+	assert.Equal(t, messages.AccessList{"Subscriber"}, al)
+}
+
+func TestSetParameterAttributesMatch(t *testing.T) {
+	msg := parseSetParameterAttributes(t, SetParameterAttributesInputYAML)
+	const status = "Device.SoftwareModules.ExecutionUnit.1.Status"
+
+	assert.True(t, msg.Match(newValueChangeInform(status, "Active")))
+	assert.True(t, msg.Match(newValueChangeInform(status, "Error")))
+	assert.False(t, msg.Match(newValueChangeInform(status, "Starting")))
+	assert.False(t, msg.Match(newValueChangeInform("Device.DeviceInfo.UpTime", "Active")))
+
+	// values reported by the CPE are not expanded as templates
+	assert.False(t, msg.Match(newValueChangeInform(status, "${ \"Active\" }")))
+
+	// value reported without a VALUE CHANGE event
+	inform := newValueChangeInform(status, "Active")
+	inform.Event.Events[0].EventCode = messages.EventPeriodic
+	assert.False(t, msg.Match(inform))
+}
+
+func TestSetParameterAttributesIsPending(t *testing.T) {
+	resp := messages.SetParameterAttributesResponse{}
+	assert.False(t, messages.IsPending(SetParameterAttributesInputMsg, resp))
+	assert.True(t, messages.IsPending(parseSetParameterAttributes(t, SetParameterAttributesInputYAML), resp))
+}
+
+func TestSetParameterAttributesValidateResponse(t *testing.T) {
+	msg := SetParameterAttributesInputMsg
+	assert.NoError(t, msg.ValidateResponse(messages.SetParameterAttributesResponse{}))
+	assert.NoError(t, msg.ValidateResponse(newValueChangeInform("Device.X", "1")))
+	assert.Error(t, msg.ValidateResponse(messages.SetParameterValuesResponse{}))
+}
@@ -6,6 +6,9 @@ package messages
 
 import (
 	"encoding/xml"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
 )
 
 type SetParameterValues struct {
@@ -20,6 +23,50 @@ func (msg SetParameterValues) MarshalXML(enc *xml.Encoder, start xml.StartElemen
 	return enc.EncodeElement(Alias(msg), start)
 }
 
+// the type of each parameter is either given (with or without the "xsd:" prefix) or inferred from its value;
+// literal values are checked against their type here, templates once expanded (see ValidateValues)
+func (msg *SetParameterValues) UnmarshalYAML(value *yaml.Node) error {
+	type Alias SetParameterValues
+	if err := value.Decode((*Alias)(msg)); err != nil {
+		return err
+	}
+	var proxy struct {
+		ParameterList []struct {
+			Value yaml.Node `yaml:"Value"`
+		} `yaml:"ParameterList"`
+	}
+	if err := value.Decode(&proxy); err != nil {
+		return err
+	}
+	for i := range msg.ParameterList.Params {
+		param := &msg.ParameterList.Params[i]
+		if len(param.Content.Type) == 0 {
+			param.Content.Type = InferParameterType(&proxy.ParameterList[i].Value)
+		} else if t, err := ParseParameterType(param.Content.Type); err != nil {
+			return fmt.Errorf("parameter '%s': %w", param.Name.RawTemplate, err)
+		} else {
+			param.Content.Type = t
+		}
+		if param.Content.Value.IsTemplate() {
+			continue
+		}
+		if err := ValidateParameterValue(param.Content.Type, param.Content.Value.RawTemplate); err != nil {
+			return fmt.Errorf("parameter '%s': %w", param.Name.RawTemplate, err)
+		}
+	}
+	return nil
+}
+
+// check the expanded values against their type, so that they are not rejected by the CPE
+func (msg SetParameterValues) ValidateValues() error {
+	for _, param := range msg.ParameterList.Params {
+		if err := ValidateParameterValue(param.Content.Type, param.Content.Value.String()); err != nil {
+			return fmt.Errorf("parameter '%s': %w", param.Name.String(), err)
+		}
+	}
+	return nil
+}
+
 func (msg SetParameterValues) GetName() string { return "SetParameterValues" }
 func (msg SetParameterValues) ValidateResponse(resp Message) error {
 	return ExpectMessage[SetParameterValuesResponse](resp)
@@ -31,7 +78,7 @@ type SetParameterValuesResponse struct {
 }
 
 func (msg SetParameterValuesResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
-	PrefixCwmp(&start.Name)
+	PrefixCwmp(&start.Name, "SetParameterValuesResponse")
 	type Alias SetParameterValuesResponse
 	return enc.EncodeElement(Alias(msg), start)
 }
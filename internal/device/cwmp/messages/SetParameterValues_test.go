@@ -17,7 +17,7 @@ import (
 
 const (
 	SetParameterValuesInputXML = `<cwmp:SetParameterValues>
-  <ParameterList soap-enc:array="cwmp:ParameterValueStruct[2]">
+  <ParameterList soap-enc:arrayType="cwmp:ParameterValueStruct[2]">
     <ParameterValueStruct>
       <Name>Device.DeviceInfo.SoftwareVersion</Name>
       <Value xsi:type="xsd:string">2.0.0</Value>
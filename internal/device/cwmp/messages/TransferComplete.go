@@ -0,0 +1,41 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"encoding/xml"
+)
+
+type TransferComplete struct {
+	XMLName      xml.Name    `xml:"TransferComplete" yaml:"-"`
+	CommandKey   string      `yaml:"CommandKey"`
+	FaultStruct  FaultStruct `yaml:"FaultStruct"`
+	StartTime    string      `yaml:"StartTime"`
+	CompleteTime string      `yaml:"CompleteTime"`
+}
+
+func (msg TransferComplete) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "TransferComplete")
+	type Alias TransferComplete
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (m TransferComplete) GetName() string { return "TransferComplete" }
+func (m TransferComplete) ValidateResponse(msg Message) error {
+	return ExpectMessage[TransferCompleteResponse](msg)
+}
+func (m TransferComplete) GenerateResponse() Message { return TransferCompleteResponse{} }
+
+type TransferCompleteResponse struct {
+	XMLName xml.Name `xml:"TransferCompleteResponse" yaml:"-"`
+}
+
+func (msg TransferCompleteResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "TransferCompleteResponse")
+	type Alias TransferCompleteResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (m TransferCompleteResponse) GetName() string { return "TransferCompleteResponse" }
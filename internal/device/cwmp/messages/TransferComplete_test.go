@@ -0,0 +1,96 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	TransferCompleteInputXML = `<cwmp:TransferComplete>
+  <CommandKey>fw-upgrade</CommandKey>
+  <FaultStruct>
+    <FaultCode>9010</FaultCode>
+    <FaultString>Download failure</FaultString>
+  </FaultStruct>
+  <StartTime>2026-04-08T10:00:00Z</StartTime>
+  <CompleteTime>2026-04-08T10:01:30Z</CompleteTime>
+</cwmp:TransferComplete>`
+
+	TransferCompleteInputYAML = `CommandKey: fw-upgrade
+FaultStruct:
+    FaultCode: 9010
+    FaultString: Download failure
+StartTime: "2026-04-08T10:00:00Z"
+CompleteTime: "2026-04-08T10:01:30Z"
+`
+
+	TransferCompleteResponseInputXML = `<cwmp:TransferCompleteResponse></cwmp:TransferCompleteResponse>`
+)
+
+var TransferCompleteInputMsg = messages.TransferComplete{
+	CommandKey:   "fw-upgrade",
+	FaultStruct:  messages.FaultStruct{FaultCode: 9010, FaultString: "Download failure"},
+	StartTime:    "2026-04-08T10:00:00Z",
+	CompleteTime: "2026-04-08T10:01:30Z",
+}
+
+func TestTransferCompleteParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(TransferCompleteInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.TransferComplete{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, TransferCompleteInputMsg.CommandKey, msg.CommandKey)
+	assert.Equal(t, TransferCompleteInputMsg.FaultStruct, msg.FaultStruct)
+	assert.Equal(t, TransferCompleteInputMsg.StartTime, msg.StartTime)
+	assert.Equal(t, TransferCompleteInputMsg.CompleteTime, msg.CompleteTime)
+}
+
+func TestTransferCompleteSerializeToXML(t *testing.T) {
+	msg := TransferCompleteInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, TransferCompleteInputXML, buf.String())
+}
+
+func TestTransferCompleteSerializeToYAML(t *testing.T) {
+	msg := TransferCompleteInputMsg
+	outbuf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := yaml.NewEncoder(outbuf)
+	enc.SetIndent(4)
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating YAML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, TransferCompleteInputYAML, outbuf.String())
+}
+
+func TestTransferCompleteGenerateResponse(t *testing.T) {
+	resp := TransferCompleteInputMsg.GenerateResponse()
+	assert.NoError(t, TransferCompleteInputMsg.ValidateResponse(resp))
+
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	if err := enc.Encode(resp); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, TransferCompleteResponseInputXML, buf.String())
+}
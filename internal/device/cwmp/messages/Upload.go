@@ -0,0 +1,70 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"encoding/xml"
+	"fmt"
+)
+
+const (
+	UploadFileTypeVendorConfig string = "1 Vendor Configuration File"
+	UploadFileTypeVendorLog    string = "2 Vendor Log File"
+)
+
+type Upload struct {
+	XMLName      xml.Name                    `xml:"Upload" yaml:"-"`
+	CommandKey   configuration.TemplateField `yaml:"CommandKey"`
+	FileType     configuration.TemplateField `yaml:"FileType"`
+	URL          configuration.TemplateField `yaml:"URL,omitempty"`
+	Username     configuration.TemplateField `yaml:"Username,omitempty"`
+	Password     configuration.TemplateField `yaml:"Password,omitempty"`
+	DelaySeconds uint                        `yaml:"DelaySeconds,omitempty"`
+}
+
+func (msg Upload) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "Upload")
+	type Alias Upload
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg Upload) GetName() string { return "Upload" }
+func (msg Upload) ValidateResponse(resp Message) error {
+	if r, ok := resp.(TransferComplete); ok {
+		if r.FaultStruct.FaultCode != 0 {
+			return fmt.Errorf("upload failed: %w", r.FaultStruct)
+		}
+		return nil
+	}
+	return ExpectMessage[UploadResponse](resp)
+}
+func (msg Upload) Match(m Message) bool {
+	if r, ok := m.(TransferComplete); ok {
+		return r.CommandKey == msg.CommandKey.String()
+	}
+	return false
+}
+func (msg Upload) AwaitNotification(resp Message) bool {
+	if r, ok := resp.(UploadResponse); ok {
+		return r.Status == 1
+	}
+	return true
+}
+
+type UploadResponse struct {
+	XMLName      xml.Name `xml:"UploadResponse" yaml:"-"`
+	Status       uint     `yaml:"Status"`
+	StartTime    string   `yaml:"StartTime"`
+	CompleteTime string   `yaml:"CompleteTime"`
+}
+
+func (msg UploadResponse) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	PrefixCwmp(&start.Name, "UploadResponse")
+	type Alias UploadResponse
+	return enc.EncodeElement(Alias(msg), start)
+}
+
+func (msg UploadResponse) GetName() string { return "UploadResponse" }
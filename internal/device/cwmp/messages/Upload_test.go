@@ -0,0 +1,123 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	UploadInputXML = `<cwmp:Upload>
+  <CommandKey>get-log</CommandKey>
+  <FileType>2 Vendor Log File</FileType>
+  <URL>http://example.com/upload/syslog</URL>
+  <Username></Username>
+  <Password></Password>
+  <DelaySeconds>0</DelaySeconds>
+</cwmp:Upload>`
+
+	UploadInputYAML = `CommandKey: get-log
+FileType: 2 Vendor Log File
+URL: http://example.com/upload/syslog
+`
+
+	UploadResponseInputXML = `<cwmp:UploadResponse>
+  <Status>1</Status>
+  <StartTime>0001-01-01T00:00:00Z</StartTime>
+  <CompleteTime>0001-01-01T00:00:00Z</CompleteTime>
+</cwmp:UploadResponse>`
+)
+
+var UploadInputMsg = messages.Upload{
+	CommandKey: configuration.T("get-log"),
+	FileType:   configuration.T(messages.UploadFileTypeVendorLog),
+	URL:        configuration.T("http://example.com/upload/syslog"),
+}
+
+var UploadResponseInputMsg = messages.UploadResponse{
+	Status:       1,
+	StartTime:    "0001-01-01T00:00:00Z",
+	CompleteTime: "0001-01-01T00:00:00Z",
+}
+
+func TestUploadParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(UploadInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.Upload{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, "get-log", msg.CommandKey.String())
+	assert.Equal(t, messages.UploadFileTypeVendorLog, msg.FileType.String())
+	assert.Equal(t, "http://example.com/upload/syslog", msg.URL.String())
+}
+
+func TestUploadSerializeToXML(t *testing.T) {
+	msg := UploadInputMsg
+	buf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := xml.NewEncoder(buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating XML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, UploadInputXML, buf.String())
+}
+
+func TestUploadSerializeToYAML(t *testing.T) {
+	msg := UploadInputMsg
+	outbuf := bytes.NewBuffer(make([]byte, 0, 1024))
+	enc := yaml.NewEncoder(outbuf)
+	enc.SetIndent(4)
+	if err := enc.Encode(msg); err != nil {
+		t.Logf("Failed generating YAML output: %s", err.Error())
+		t.FailNow()
+	}
+	assert.Equal(t, UploadInputYAML, outbuf.String())
+}
+
+func TestUploadResponseParseFromXML(t *testing.T) {
+	buf := bytes.NewBufferString(UploadResponseInputXML)
+	dec := xml.NewDecoder(buf)
+	msg := messages.UploadResponse{}
+	if err := dec.Decode(&msg); err != nil {
+		t.Logf("Failed parsing XML input: %s", err.Error())
+		t.FailNow()
+	}
+
+	assert.Equal(t, uint(1), msg.Status)
+}
+
+func TestUploadMatchesTransferComplete(t *testing.T) {
+	msg := UploadInputMsg
+	assert.True(t, msg.Match(messages.TransferComplete{CommandKey: "get-log"}))
+	assert.False(t, msg.Match(messages.TransferComplete{CommandKey: "other"}))
+}
+
+func TestUploadIsPending(t *testing.T) {
+	msg := UploadInputMsg
+	assert.True(t, messages.IsPending(msg, messages.UploadResponse{Status: 1}))
+	assert.False(t, messages.IsPending(msg, messages.UploadResponse{Status: 0}))
+}
+
+func TestUploadValidateResponse(t *testing.T) {
+	msg := UploadInputMsg
+	assert.NoError(t, msg.ValidateResponse(UploadResponseInputMsg))
+	assert.NoError(t, msg.ValidateResponse(messages.TransferComplete{CommandKey: "get-log"}))
+	assert.Error(t, msg.ValidateResponse(messages.TransferComplete{
+		CommandKey:  "get-log",
+		FaultStruct: messages.FaultStruct{FaultCode: 9011, FaultString: "Upload failure"},
+	}))
+	assert.Error(t, msg.ValidateResponse(messages.DownloadResponse{}))
+}
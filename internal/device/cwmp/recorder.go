@@ -0,0 +1,105 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// envelope sent by the CPE to the ACS
+	RecordedIn = "in"
+	// envelope sent by the ACS to the CPE
+	RecordedOut = "out"
+)
+
+// a CWMP session, as recorded by a CWMP device; can be replayed by a `replay` device
+type Recording []RecordedEnvelope
+
+type RecordedEnvelope struct {
+	Time      time.Time `yaml:"time"`
+	Direction string    `yaml:"direction"`
+	// names of the envelope's messages, for readability
+	Messages []string `yaml:"messages,flow,omitempty"`
+	// raw envelope XML; empty for an empty HTTP post (or response)
+	Envelope string `yaml:"envelope,omitempty"`
+}
+
+func LoadRecording(filename string) (Recording, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read recording: %w", err)
+	}
+	var rec Recording
+	if err := yaml.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("malformed recording '%s': %w", filename, err)
+	}
+	return rec, nil
+}
+
+// appends every envelope exchanged with the CPE to a recording file
+type sessionRecorder struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func newSessionRecorder(filename string) (*sessionRecorder, error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		return nil, fmt.Errorf("cannot create recording: %w", err)
+	}
+	tui.LogNormal("Recording CWMP session into '%s'", filename)
+	return &sessionRecorder{file: file}, nil
+}
+
+// record an envelope along with its raw representation; a nil recorder records nothing
+func (r *sessionRecorder) record(direction string, env *messages.Envelope, raw []byte) {
+	if r == nil {
+		return
+	}
+	entry := RecordedEnvelope{
+		Time:      time.Now(),
+		Direction: direction,
+		Messages:  envelopeMessageNames(env),
+		Envelope:  string(raw),
+	}
+	// each entry is written as a single-item sequence, so that the file is a valid recording at all times
+	data, err := yaml.Marshal(Recording{entry})
+	if err != nil {
+		tui.LogError("Failed recording envelope: %s", err)
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, err := r.file.Write(data); err != nil {
+		tui.LogError("Failed recording envelope: %s", err)
+	}
+}
+
+func (r *sessionRecorder) close() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.file.Close()
+}
+
+func envelopeMessageNames(env *messages.Envelope) []string {
+	var names []string
+	if env != nil {
+		for _, msg := range env.Body.Messages {
+			names = append(names, msg.GetName())
+		}
+	}
+	return names
+}
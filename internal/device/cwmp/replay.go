@@ -0,0 +1,144 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"bytes"
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"time"
+)
+
+var ErrReplayEnded = errors.New("end of recorded CWMP session")
+
+func init() {
+	device.RegisterDeviceType("replay", NewReplayDevice)
+}
+
+type ReplayConfig struct {
+	configuration.Endpoint `yaml:",inline"`
+	UploadFolder           configuration.TemplateField `yaml:"uploadFolder,omitempty"`
+}
+
+// create a CWMP device that, instead of serving a real CPE, plays back the CPE side of a recorded session;
+// the recording file is given as the address, i.e. `replay:<path/to/recording.yaml>`
+func NewReplayDevice(c *configuration.DeviceConfig, log io.Writer) (device.Device, error) {
+	config := ReplayConfig{}
+	if err := c.Decode(&config); err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(config.Addr.String())
+	if err != nil {
+		return nil, err
+	}
+	filename := u.Opaque
+	if len(filename) == 0 {
+		filename = u.Host + u.Path
+	}
+	rec, err := LoadRecording(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &CWMPDevice{
+		log:            log,
+		in:             make(chan *cpePost),
+		passiveTimeout: DefaultPassiveTimeout,
+		uploadFolder:   config.UploadFolder.String(),
+		closed:         make(chan struct{}),
+		halted:         make(chan struct{}),
+	}
+	if len(d.uploadFolder) == 0 {
+		d.uploadFolder = DefaultUploadFolder
+	}
+	tui.DisplaySuccessMsg(fmt.Sprintf("Replaying CWMP session from '%s' (%d envelopes)...", filename, len(rec)))
+	go d.replay(rec)
+	return d, nil
+}
+
+// feed the recorded CPE envelopes to the device, verifying that its replies match the recorded ones
+func (d *CWMPDevice) replay(rec Recording) {
+	err := ErrReplayEnded
+	defer func() {
+		d.haltErr = err
+		close(d.halted)
+	}()
+
+	// cwmp:ID of the latest ACS request
+	requestID := ""
+	for i := 0; i < len(rec); i++ {
+		if rec[i].Direction != RecordedIn {
+			continue
+		}
+		env, perr := parseRecordedEnvelope(rec[i])
+		if perr != nil {
+			err = fmt.Errorf("recorded envelope #%d: %w", i+1, perr)
+			return
+		}
+		if env != nil {
+			if inform := findInform(env); inform != nil && d.cpe == nil {
+				d.cpe = &inform.DeviceId
+			}
+			// the recorded responses answer the requests of another run, with other IDs
+			if isResponseEnvelope(env) && env.Header != nil {
+				env.Header.ID.Value = requestID
+			}
+		}
+		d.log.Write(fmt.Appendf([]byte(""), "[%s] REPLAY IN (#%d):\n%s\n", time.Now().Format(time.DateTime), i+1, rec[i].Envelope))
+		reply, perr := d.processEnvelope(context.Background(), env)
+		if errors.Is(perr, errDeviceClosed) {
+			return
+		} else if perr != nil {
+			err = fmt.Errorf("recorded envelope #%d: %w", i+1, perr)
+			tui.LogError("%s", err)
+			return
+		}
+		if reply != nil {
+			requestID = reply.GetID()
+		}
+		d.log.Write(fmt.Appendf([]byte(""), "[%s] REPLAY OUT:\n", time.Now().Format(time.DateTime)))
+		if reply != nil {
+			if data, merr := xml.MarshalIndent(reply, "", "\t"); merr == nil {
+				d.log.Write(data)
+				d.log.Write([]byte("\n"))
+			}
+		}
+		// compare against the recorded reply, if any
+		if i+1 < len(rec) && rec[i+1].Direction == RecordedOut {
+			i++
+			if names := envelopeMessageNames(reply); !slices.Equal(names, rec[i].Messages) {
+				err = fmt.Errorf("replay diverged at envelope #%d: sent %v, recorded %v", i+1, names, rec[i].Messages)
+				tui.LogError("%s", err)
+				return
+			}
+		}
+	}
+}
+
+// whether the envelope carries responses only
+func isResponseEnvelope(env *messages.Envelope) bool {
+	for _, msg := range env.Body.Messages {
+		if _, ok := msg.(messages.ACSMethod); ok {
+			return false
+		}
+	}
+	return true
+}
+
+func parseRecordedEnvelope(entry RecordedEnvelope) (*messages.Envelope, error) {
+	if len(entry.Envelope) == 0 {
+		return nil, nil
+	}
+	return messages.ParseEnvelopeXML(bytes.NewBufferString(entry.Envelope))
+}
@@ -0,0 +1,147 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"bytes"
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"io"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const serialNumberParam = "Device.DeviceInfo.SerialNumber"
+
+func newTestStep(t *testing.T, step string) *configuration.SequenceCmd {
+	var cmd configuration.SequenceCmd
+	require.NoError(t, yaml.Unmarshal([]byte(step), &cmd))
+	return &cmd
+}
+
+// run a sequence of a single step against the device
+func runSingleStep(t *testing.T, d *CWMPDevice, step string) (any, error) {
+	if err := d.BeginSequence(); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := d.ExecuteCommand(ctx, newTestStep(t, step))
+	if err != nil {
+		return res, err
+	}
+	return res, d.EndSequence()
+}
+
+const getSerialNumberStep = `
+cmd: GetParameterValues
+ParameterNames:
+    - ` + serialNumberParam
+
+// record a session in which the CPE answers a GetParameterValues
+func recordTestSession(t *testing.T, filename string) any {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{})
+	recorder, err := newSessionRecorder(filename)
+	require.NoError(t, err)
+	dev.recorder = recorder
+	cpe := newTestCPE(t, "A")
+
+	type result struct {
+		res any
+		err error
+	}
+	done := make(chan result)
+	go func() {
+		res, err := runSingleStep(t, dev, getSerialNumberStep)
+		done <- result{res, err}
+	}()
+
+	url := dev.acs.url.String()
+	resp, _ := cpe.inform(t, url)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	_, body := cpe.post(t, url, nil)
+	assert.Contains(t, string(body), "GetParameterValues")
+	request, err := messages.ParseEnvelopeXML(bytes.NewReader(body))
+	require.NoError(t, err)
+	env := messages.NewEnvelope(request.GetID(), messages.GetParameterValuesResponse{
+		ParameterList: messages.ParameterValueListStruct{Params: []messages.ParameterValueStruct{{
+			Name:    configuration.T(serialNumberParam),
+			Content: messages.NodeStruct{Type: "xsd:string", Value: configuration.T("A")},
+		}}},
+	})
+	_, body = cpe.post(t, url, &env)
+	assert.Empty(t, body)
+
+	r := <-done
+	require.NoError(t, r.err)
+	dev.recorder.close()
+	return r.res
+}
+
+func newTestReplayDevice(t *testing.T, filename string) *CWMPDevice {
+	var config configuration.DeviceConfig
+	require.NoError(t, yaml.Unmarshal([]byte("addr: replay:"+filename), &config))
+	d, err := NewReplayDevice(&config, io.Discard)
+	require.NoError(t, err)
+	t.Cleanup(d.Close)
+	return d.(*CWMPDevice)
+}
+
+func TestRecordSession(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "session.yaml")
+	recordTestSession(t, filename)
+
+	rec, err := LoadRecording(filename)
+	require.NoError(t, err)
+	require.Len(t, rec, 6)
+	expected := []struct {
+		direction string
+		messages  []string
+	}{
+		{RecordedIn, []string{"Inform"}},
+		{RecordedOut, []string{"InformResponse"}},
+		{RecordedIn, nil},
+		{RecordedOut, []string{"GetParameterValues"}},
+		{RecordedIn, []string{"GetParameterValuesResponse"}},
+		{RecordedOut, nil},
+	}
+	for i, e := range expected {
+		assert.Equal(t, e.direction, rec[i].Direction, "entry #%d", i+1)
+		assert.Equal(t, e.messages, rec[i].Messages, "entry #%d", i+1)
+		assert.False(t, rec[i].Time.IsZero())
+	}
+	assert.Contains(t, rec[3].Envelope, serialNumberParam)
+}
+
+func TestReplaySession(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "session.yaml")
+	recorded := recordTestSession(t, filename)
+
+	dev := newTestReplayDevice(t, filename)
+	res, err := runSingleStep(t, dev, getSerialNumberStep)
+	require.NoError(t, err)
+	assert.Equal(t, recorded, res)
+	assert.Equal(t, "A", dev.cpe.SerialNumber)
+
+	// the recording is exhausted
+	assert.ErrorIs(t, dev.BeginSequence(), ErrReplayEnded)
+}
+
+func TestReplayDiverges(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "session.yaml")
+	recordTestSession(t, filename)
+
+	dev := newTestReplayDevice(t, filename)
+	_, err := runSingleStep(t, dev, "cmd: GetRPCMethods")
+	assert.ErrorContains(t, err, "replay diverged")
+}
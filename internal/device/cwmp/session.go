@@ -0,0 +1,209 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"context"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const DefaultRequestTimeout = 30 * time.Second
+
+var (
+	errDeviceClosed = errors.New("device closed")
+	errNoSession    = errors.New("no CWMP session")
+)
+
+// states of the CWMP session between the ACS and the CPE of a device
+type sessionState int
+
+const (
+	// no session is open; the CPE has to open one with an Inform
+	sessionClosed sessionState = iota
+	// the CPE sends its requests, starting with the Inform
+	sessionCPERequests
+	// the CPE handed control over (with an empty post or a response); the ACS sends its requests
+	sessionACSRequests
+	// an ACS request was sent; the CPE is expected to post its response
+	sessionAwaitingResponse
+)
+
+func (s sessionState) String() string {
+	switch s {
+	case sessionClosed:
+		return "closed"
+	case sessionCPERequests:
+		return "CPE requests"
+	case sessionACSRequests:
+		return "ACS requests"
+	case sessionAwaitingResponse:
+		return "awaiting response"
+	default:
+		return fmt.Sprintf("unknown (%d)", int(s))
+	}
+}
+
+// a message posted by the CPE (nil for an empty post), awaiting the reply of the sequence
+type cpePost struct {
+	msg messages.Message
+	// cwmp:ID of the envelope carrying the message
+	id string
+	// buffered, so that replying never blocks on an abandoned post
+	reply chan *messages.Envelope
+	// closed once the HTTP request is abandoned (CPE disconnected or request deadline exceeded)
+	abandoned <-chan struct{}
+}
+
+func (p *cpePost) isAbandoned() bool {
+	select {
+	case <-p.abandoned:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *CWMPDevice) sessionState() sessionState {
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	return d.state
+}
+
+// update the session upon a post of the CPE (env is nil for an empty post); posts outside of a session are refused
+func (d *CWMPDevice) enterPost(env *messages.Envelope) error {
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	if findInform(env) != nil {
+		if d.state != sessionClosed {
+			tui.LogWarning("CPE opened a new session; abandoning the current one (%s)", d.state)
+		}
+		d.state = sessionCPERequests
+		d.noMoreRequests = env.GetNoMoreRequests()
+		d.deferred = nil
+		return nil
+	}
+	if d.state == sessionClosed {
+		return errNoSession
+	}
+	if env == nil {
+		d.state = sessionACSRequests
+		return nil
+	}
+	if env.GetNoMoreRequests() && !d.noMoreRequests {
+		tui.LogNormal("CPE signalled NoMoreRequests")
+		d.noMoreRequests = true
+	}
+	for _, msg := range env.Body.Messages {
+		if _, ok := msg.(messages.ACSMethod); !ok {
+			// a response hands control back to the ACS (once the CPE requests it carries are answered)
+			d.state = sessionACSRequests
+		}
+	}
+	return nil
+}
+
+// end the session without the ACS having replied to the CPE
+func (d *CWMPDevice) terminateSession(format string, a ...any) {
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	if d.state != sessionClosed {
+		tui.LogWarning("Terminating CWMP session: %s", fmt.Sprintf(format, a...))
+	}
+	d.state = sessionClosed
+	d.deferred = nil
+}
+
+// whether the CPE signalled it sends no more requests within the session
+func (d *CWMPDevice) cpeHasNoMoreRequests() bool {
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	return d.noMoreRequests
+}
+
+// keep replies (e.g. an ACS request) that could not share the envelope of the post they were made upon
+func (d *CWMPDevice) deferReplies(envs []*messages.Envelope) {
+	if len(envs) == 0 {
+		return
+	}
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	d.deferred = append(d.deferred, envs...)
+}
+
+// take the next deferred reply, if any (outside of a session there is none)
+func (d *CWMPDevice) popDeferred() *messages.Envelope {
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	if d.state == sessionClosed || len(d.deferred) == 0 {
+		return nil
+	}
+	env := d.deferred[0]
+	d.deferred = d.deferred[1:]
+	return env
+}
+
+// reply to the pending post of the CPE (nil for an empty reply, closing the session)
+func (d *CWMPDevice) pushEnvelope(env *messages.Envelope) error {
+	return d.reply(env, false)
+}
+
+// send an ACS request as reply to the pending post of the CPE
+func (d *CWMPDevice) pushRequest(env *messages.Envelope) error {
+	return d.reply(env, true)
+}
+
+func (d *CWMPDevice) reply(env *messages.Envelope, request bool) error {
+	post := d.pending
+	d.pending = nil
+	if post == nil {
+		return fmt.Errorf("%w: the CPE awaits no reply", errNoSession)
+	}
+	d.idMutex.Lock()
+	defer d.idMutex.Unlock()
+	// checked under the lock, so that the session cannot be terminated in between
+	if post.isAbandoned() {
+		return fmt.Errorf("%w: the CPE abandoned its request", errNoSession)
+	}
+	if env == nil {
+		d.state = sessionClosed
+		d.deferred = nil
+	} else if request {
+		d.state = sessionAwaitingResponse
+	}
+	post.reply <- env
+	return nil
+}
+
+// make sure the CPE handed control over to the ACS, so that a request can be sent; once the session was
+// terminated, the CPE is asked to open a new one (unless passive)
+func (d *CWMPDevice) awaitControl(ctx context.Context) error {
+	if d.pending != nil && !d.pending.isAbandoned() {
+		return nil
+	}
+	d.pending = nil
+	if d.sessionState() == sessionClosed && d.endpoint != nil {
+		if err := d.sendConnectionRequest(d.endpoint); err != nil {
+			return err
+		}
+	}
+	tui.LogNormal("Waiting for (ready) message...")
+	msg, err := d.expectRPC(ctx, func(m messages.Message) bool {
+		_, isRequest := m.(messages.ACSMethod)
+		return !isRequest
+	})
+	if err != nil {
+		return err
+	}
+	if msg != nil {
+		tui.LogWarning("Ignoring late '%s' (cwmp:ID '%s')", msg.GetName(), d.pending.id)
+	}
+	// the CPE may have opened a new session meanwhile
+	d.publishInform()
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+const DefaultUploadFolder = "uploads"
+
+var unsafeFileNameChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// result of an Upload RPC received by corteca
+type UploadResult struct {
+	File   string           `yaml:"File"`
+	Result messages.Message `yaml:"Result"`
+}
+
+// a temporary HTTP listener on the ACS host, receiving a single file uploaded (PUT) by the CPE
+type uploadReceiver struct {
+	server *http.Server
+	url    *url.URL
+	file   string
+	log    io.Writer
+
+	mu       sync.Mutex
+	received bool
+}
+
+// start a receiver for the Upload RPC and point the RPC to it
+func (d *CWMPDevice) receiveUpload(m *messages.Upload) error {
+	if d.acs == nil {
+		return errors.New("cannot receive upload without an ACS listener")
+	}
+	if err := os.MkdirAll(d.uploadFolder, 0755); err != nil {
+		return fmt.Errorf("cannot create upload folder: %w", err)
+	}
+	cpe := "cpe"
+	// the CPE is bound to the device by the ACS listener
+	d.acs.mu.Lock()
+	if d.cpe != nil {
+		cpe = d.cpe.String()
+	}
+	d.acs.mu.Unlock()
+	fileType := strings.TrimLeft(m.FileType.String(), "0123456789 ")
+	// a random suffix, so that uploads of the same type within a second do not overwrite each other
+	name := fmt.Sprintf("%s_%s_%s_%s", cpe, fileType, time.Now().Format("20060102-150405"), randomHex(4))
+	name = strings.ToLower(strings.Trim(unsafeFileNameChars.ReplaceAllString(name, "-"), "-"))
+
+	// bind to the same interface as the ACS listener
+	host, _, err := net.SplitHostPort(d.acs.server.Addr)
+	if err != nil {
+		return err
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, "0"))
+	if err != nil {
+		return fmt.Errorf("cannot start upload receiver: %w", err)
+	}
+	u := connectableURL(&url.URL{Scheme: "http", Host: listener.Addr().String()})
+	// a random path, so that only the CPE being told can upload
+	u.Path = fmt.Sprintf("/%s/%s", randomHex(8), name)
+
+	r := &uploadReceiver{
+		url:  u,
+		file: filepath.Join(d.uploadFolder, name),
+		log:  d.log,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(u.Path, r.handleHTTPRequest)
+	r.server = &http.Server{Handler: mux}
+	go func() {
+		if err := r.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			tui.LogError("Upload receiver failed: %s", err)
+		}
+	}()
+
+	d.upload = r
+	m.URL = configuration.T(u.String())
+	tui.LogNormal("Receiving upload on %s", u.String())
+	return nil
+}
+
+func (r *uploadReceiver) handleHTTPRequest(w http.ResponseWriter, req *http.Request) {
+	r.log.Write(fmt.Appendf([]byte(""), "[%s] UPLOAD: %s %s %s\n",
+		time.Now().Format(time.DateTime),
+		req.Method,
+		req.RequestURI,
+		req.RemoteAddr))
+	if req.Method != http.MethodPut && req.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// write to a temporary file first, so that an interrupted upload leaves no partial file behind
+	tmp, err := os.CreateTemp(filepath.Dir(r.file), filepath.Base(r.file)+".*.part")
+	if err != nil {
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	defer os.Remove(tmp.Name())
+	size, err := io.Copy(tmp, req.Body)
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		tui.LogError("Upload failed: %s", err)
+		http.Error(w, "Bad request", http.StatusBadRequest)
+		return
+	}
+	if err := os.Rename(tmp.Name(), r.file); err != nil {
+		tui.LogError("Upload failed: %s", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	r.received = true
+	tui.LogNormal("Received upload of %d bytes into '%s'", size, r.file)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// the result of the Upload RPC, once the CPE reported the transfer complete
+func (r *uploadReceiver) result(res messages.Message) (*UploadResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.received {
+		return nil, errors.New("upload completed, but no file was received")
+	}
+	return &UploadResult{File: r.file, Result: res}, nil
+}
+
+func (r *uploadReceiver) close() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := r.server.Shutdown(ctx); err != nil {
+		tui.LogError("Upload receiver shutdown failed: %s", err)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package cwmp
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/cwmp/messages"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadReceiver(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{})
+	dev.uploadFolder = t.TempDir()
+
+	m := messages.Upload{CommandKey: configuration.T("get-log"), FileType: configuration.T(messages.UploadFileTypeVendorLog)}
+	require.NoError(t, dev.receiveUpload(&m))
+	defer dev.closeUpload()
+	assert.True(t, strings.HasPrefix(m.URL.String(), "http://127.0.0.1:"))
+
+	_, err := dev.upload.result(messages.TransferComplete{CommandKey: "get-log"})
+	assert.Error(t, err, "no file received yet")
+
+	resp, err := http.Get(m.URL.String())
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	req, err := http.NewRequest(http.MethodPut, m.URL.String(), bytes.NewBufferString("syslog contents"))
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	res, err := dev.upload.result(messages.TransferComplete{CommandKey: "get-log"})
+	require.NoError(t, err)
+	assert.Equal(t, dev.uploadFolder, filepath.Dir(res.File))
+	assert.Contains(t, filepath.Base(res.File), "vendor-log-file")
+	data, err := os.ReadFile(res.File)
+	require.NoError(t, err)
+	assert.Equal(t, "syslog contents", string(data))
+
+	// a different path is not accepted
+	req, err = http.NewRequest(http.MethodPut, strings.TrimSuffix(m.URL.String(), filepath.Base(res.File))+"other", nil)
+	require.NoError(t, err)
+	resp, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestUploadReceiverUniqueFiles(t *testing.T) {
+	config := newTestServerConfig(CWMPServerConfig{})
+	dev := newTestDevice(t, config, DeviceFilter{})
+	dev.uploadFolder = t.TempDir()
+
+	// uploads of the same type, started within the same second
+	first := messages.Upload{FileType: configuration.T(messages.UploadFileTypeVendorLog)}
+	require.NoError(t, dev.receiveUpload(&first))
+	file := dev.upload.file
+	dev.closeUpload()
+	second := messages.Upload{FileType: configuration.T(messages.UploadFileTypeVendorLog)}
+	require.NoError(t, dev.receiveUpload(&second))
+	defer dev.closeUpload()
+	assert.NotEqual(t, file, dev.upload.file)
+}
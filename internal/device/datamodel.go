@@ -0,0 +1,181 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package device
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const DefaultDataModelRoot = "Device."
+
+// Parameter of the data model of a device; objects have a name ending with '.' and no value
+type Parameter struct {
+	Name  string `json:"name" yaml:"name"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// DataModelReader is implemented by devices able to read their (TR-181) data model; it is used within a
+// sequence, i.e. between BeginSequence and EndSequence
+type DataModelReader interface {
+	// read the objects and parameters under path (or the single parameter path); depth limits the number of
+	// object levels descended below path (0 for no limit)
+	ReadDataModel(ctx context.Context, path string, depth int) ([]Parameter, error)
+}
+
+// ParameterValuesReader is implemented by devices able to read the values of all the parameters under a partial
+// path at once, cheaper than walking the data model
+type ParameterValuesReader interface {
+	ReadParameterValues(ctx context.Context, path string) ([]Parameter, error)
+}
+
+// read the values of all the parameters (but not the objects) under path, within a sequence
+func ReadParameterValues(ctx context.Context, dev Device, path string) ([]Parameter, error) {
+	if reader, ok := dev.(ParameterValuesReader); ok {
+		return reader.ReadParameterValues(ctx, path)
+	}
+	reader, ok := dev.(DataModelReader)
+	if !ok {
+		return nil, fmt.Errorf("reading the data model is not supported for %s devices", dev.GetProtocol())
+	}
+	params, err := reader.ReadDataModel(ctx, path, 0)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(params, func(p Parameter) bool { return strings.HasSuffix(p.Name, ".") }), nil
+}
+
+// node of the data model tree; objects have children, parameters a value
+type DataModelNode struct {
+	Name     string
+	Value    string
+	Children []*DataModelNode
+}
+
+func (n *DataModelNode) IsObject() bool {
+	return strings.HasSuffix(n.Name, ".")
+}
+
+// arrange the parameters by object; the returned root node is unnamed, holding the top-level objects
+func BuildDataModelTree(params []Parameter) *DataModelNode {
+	root := &DataModelNode{}
+	for _, p := range params {
+		node := root
+		segments := strings.Split(strings.TrimSuffix(p.Name, "."), ".")
+		for i, segment := range segments {
+			name := segment + "."
+			if i == len(segments)-1 && !strings.HasSuffix(p.Name, ".") {
+				name = segment
+			}
+			node = node.child(name)
+		}
+		node.Value = p.Value
+	}
+	root.sort()
+	return root
+}
+
+func (n *DataModelNode) child(name string) *DataModelNode {
+	for _, c := range n.Children {
+		if c.Name == name {
+			return c
+		}
+	}
+	c := &DataModelNode{Name: name}
+	n.Children = append(n.Children, c)
+	return c
+}
+
+// parameters first, then objects; instances in numerical order
+func (n *DataModelNode) sort() {
+	slices.SortFunc(n.Children, func(a, b *DataModelNode) int {
+		if a.IsObject() != b.IsObject() {
+			if a.IsObject() {
+				return 1
+			}
+			return -1
+		}
+		return compareSegments(strings.TrimSuffix(a.Name, "."), strings.TrimSuffix(b.Name, "."))
+	})
+	for _, c := range n.Children {
+		c.sort()
+	}
+}
+
+// compare names segment by segment, instance numbers in numerical order
+func compareNames(a, b string) int {
+	x, y := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(x) && i < len(y); i++ {
+		if c := compareSegments(x[i], y[i]); c != 0 {
+			return c
+		}
+	}
+	return len(x) - len(y)
+}
+
+func compareSegments(a, b string) int {
+	x, errX := strconv.Atoi(a)
+	y, errY := strconv.Atoi(b)
+	if errX == nil && errY == nil {
+		return x - y
+	}
+	return strings.Compare(a, b)
+}
+
+// write one line per node, indenting children below their object
+func (n *DataModelNode) WriteIndented(w io.Writer) error {
+	return n.writeIndented(w, "")
+}
+
+func (n *DataModelNode) writeIndented(w io.Writer, indent string) error {
+	for _, c := range n.Children {
+		var err error
+		if c.IsObject() {
+			_, err = fmt.Fprintf(w, "%s%s\n", indent, c.Name)
+		} else {
+			_, err = fmt.Fprintf(w, "%s%s: %s\n", indent, c.Name, c.Value)
+		}
+		if err != nil {
+			return err
+		}
+		if err := c.writeIndented(w, indent+"  "); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nested maps of objects (without the trailing '.') and parameter values, for YAML or JSON output
+func (n *DataModelNode) ToMap() map[string]any {
+	m := make(map[string]any, len(n.Children))
+	for _, c := range n.Children {
+		if c.IsObject() {
+			m[strings.TrimSuffix(c.Name, ".")] = c.ToMap()
+		} else {
+			m[c.Name] = c.Value
+		}
+	}
+	return m
+}
+
+// drop the objects and parameters more than depth object levels below path (0 for no limit); the objects at
+// the limit are kept, without their contents
+func LimitDataModelDepth(params []Parameter, path string, depth int) []Parameter {
+	if depth == 0 {
+		return params
+	}
+	limited := make([]Parameter, 0, len(params))
+	for _, p := range params {
+		levels := strings.Count(strings.TrimPrefix(p.Name, path), ".")
+		if levels < depth || (levels == depth && strings.HasSuffix(p.Name, ".")) {
+			limited = append(limited, p)
+		}
+	}
+	return limited
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package device_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/device"
+	"reflect"
+	"testing"
+)
+
+var testDataModel = []device.Parameter{
+	{Name: "Device.IP.Interface.10.Enable", Value: "false"},
+	{Name: "Device.IP.Interface.2.Enable", Value: "true"},
+	{Name: "Device.IP.Interface."},
+	{Name: "Device.DeviceInfo.SerialNumber", Value: "A"},
+	{Name: "Device.IP.IPv4Enable", Value: "true"},
+}
+
+func TestBuildDataModelTree_WriteIndented(t *testing.T) {
+	var out bytes.Buffer
+	if err := device.BuildDataModelTree(testDataModel).WriteIndented(&out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// parameters before objects, instances in numerical order
+	want := `Device.
+  DeviceInfo.
+    SerialNumber: A
+  IP.
+    IPv4Enable: true
+    Interface.
+      2.
+        Enable: true
+      10.
+        Enable: false
+`
+	if out.String() != want {
+		t.Errorf("expected:\n%s\ngot:\n%s", want, out.String())
+	}
+}
+
+func TestBuildDataModelTree_ToMap(t *testing.T) {
+	got := device.BuildDataModelTree(testDataModel).ToMap()
+	want := map[string]any{
+		"Device": map[string]any{
+			"DeviceInfo": map[string]any{"SerialNumber": "A"},
+			"IP": map[string]any{
+				"IPv4Enable": "true",
+				"Interface": map[string]any{
+					"2":  map[string]any{"Enable": "true"},
+					"10": map[string]any{"Enable": "false"},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestLimitDataModelDepth(t *testing.T) {
+	params := []device.Parameter{
+		{Name: "Device.IP.IPv4Enable", Value: "true"},
+		{Name: "Device.IP.Interface."},
+		{Name: "Device.IP.Interface.1."},
+		{Name: "Device.IP.Interface.1.Enable", Value: "true"},
+	}
+	tests := []struct {
+		depth int
+		want  []device.Parameter
+	}{
+		{depth: 0, want: params},
+		{depth: 1, want: params[:2]},
+		{depth: 2, want: params[:3]},
+		{depth: 3, want: params},
+	}
+	for _, tt := range tests {
+		if got := device.LimitDataModelDepth(params, "Device.IP.", tt.depth); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("depth %d: expected %v, got %v", tt.depth, tt.want, got)
+		}
+	}
+}
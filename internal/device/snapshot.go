@@ -0,0 +1,113 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package device
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Snapshot of the parameter values of a data model sub-tree
+type Snapshot struct {
+	Device     string            `yaml:"device"`
+	Path       string            `yaml:"path"`
+	Time       time.Time         `yaml:"time"`
+	Parameters map[string]string `yaml:"parameters"`
+}
+
+func NewSnapshot(deviceName, path string, params []Parameter) *Snapshot {
+	s := Snapshot{Device: deviceName, Path: path, Time: time.Now().UTC().Truncate(time.Second), Parameters: make(map[string]string, len(params))}
+	for _, p := range params {
+		s.Parameters[p.Name] = p.Value
+	}
+	return &s
+}
+
+func LoadSnapshot(filename string) (*Snapshot, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var s Snapshot
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("invalid snapshot '%s': %w", filename, err)
+	}
+	if s.Parameters == nil {
+		s.Parameters = make(map[string]string)
+	}
+	return &s, nil
+}
+
+func (s *Snapshot) Write(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(4)
+	if err := encoder.Encode(s); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+type ParameterChange struct {
+	Name     string `json:"name" yaml:"name"`
+	OldValue string `json:"old" yaml:"old"`
+	NewValue string `json:"new" yaml:"new"`
+}
+
+// differences between two snapshots, sorted by parameter name
+type SnapshotDiff struct {
+	Added   []Parameter       `json:"added,omitempty" yaml:"added,omitempty"`
+	Removed []Parameter       `json:"removed,omitempty" yaml:"removed,omitempty"`
+	Changed []ParameterChange `json:"changed,omitempty" yaml:"changed,omitempty"`
+}
+
+func DiffSnapshots(old, current *Snapshot) SnapshotDiff {
+	var diff SnapshotDiff
+	for name, value := range current.Parameters {
+		if oldValue, found := old.Parameters[name]; !found {
+			diff.Added = append(diff.Added, Parameter{Name: name, Value: value})
+		} else if oldValue != value {
+			diff.Changed = append(diff.Changed, ParameterChange{Name: name, OldValue: oldValue, NewValue: value})
+		}
+	}
+	for name, value := range old.Parameters {
+		if _, found := current.Parameters[name]; !found {
+			diff.Removed = append(diff.Removed, Parameter{Name: name, Value: value})
+		}
+	}
+	byName := func(a, b Parameter) int { return compareNames(a.Name, b.Name) }
+	slices.SortFunc(diff.Added, byName)
+	slices.SortFunc(diff.Removed, byName)
+	slices.SortFunc(diff.Changed, func(a, b ParameterChange) int { return compareNames(a.Name, b.Name) })
+	return diff
+}
+
+func (d SnapshotDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// write one line per difference, prefixed with '+' (added), '-' (removed) or '~' (changed)
+func (d SnapshotDiff) WriteText(w io.Writer) error {
+	for _, p := range d.Added {
+		if _, err := fmt.Fprintf(w, "+ %s: %s\n", p.Name, p.Value); err != nil {
+			return err
+		}
+	}
+	for _, p := range d.Removed {
+		if _, err := fmt.Fprintf(w, "- %s: %s\n", p.Name, p.Value); err != nil {
+			return err
+		}
+	}
+	for _, c := range d.Changed {
+		if _, err := fmt.Fprintf(w, "~ %s: %s -> %s\n", c.Name, c.OldValue, c.NewValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package device_test
+
+import (
+	"bytes"
+	"github.com/nokia/corteca-cli/internal/device"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestSnapshot_WriteAndLoad(t *testing.T) {
+	snapshot := device.NewSnapshot("cpe", "Device.", []device.Parameter{
+		{Name: "Device.DeviceInfo.SerialNumber", Value: "A"},
+		{Name: "Device.DeviceInfo.ProvisioningCode", Value: ""},
+	})
+	var buf bytes.Buffer
+	if err := snapshot.Write(&buf); err != nil {
+		t.Fatalf("unexpected error writing snapshot: %v", err)
+	}
+	filename := filepath.Join(t.TempDir(), "snapshot.yaml")
+	if err := os.WriteFile(filename, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := device.LoadSnapshot(filename)
+	if err != nil {
+		t.Fatalf("unexpected error loading snapshot: %v", err)
+	}
+	if !loaded.Time.Equal(snapshot.Time) {
+		t.Errorf("time: expected %v, got %v", snapshot.Time, loaded.Time)
+	}
+	loaded.Time = snapshot.Time
+	if !reflect.DeepEqual(loaded, snapshot) {
+		t.Errorf("expected %+v, got %+v", snapshot, loaded)
+	}
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	old := device.NewSnapshot("cpe", "Device.", []device.Parameter{
+		{Name: "Device.DeviceInfo.SoftwareVersion", Value: "1.0"},
+		{Name: "Device.SoftwareModules.DeploymentUnit.1.Name", Value: "app1"},
+		{Name: "Device.SoftwareModules.DeploymentUnitNumberOfEntries", Value: "1"},
+	})
+	new := device.NewSnapshot("cpe", "Device.", []device.Parameter{
+		{Name: "Device.DeviceInfo.SoftwareVersion", Value: "1.0"},
+		{Name: "Device.SoftwareModules.DeploymentUnit.10.Name", Value: "app3"},
+		{Name: "Device.SoftwareModules.DeploymentUnit.2.Name", Value: "app2"},
+		{Name: "Device.SoftwareModules.DeploymentUnitNumberOfEntries", Value: "2"},
+	})
+
+	diff := device.DiffSnapshots(old, new)
+	want := device.SnapshotDiff{
+		Added: []device.Parameter{
+			{Name: "Device.SoftwareModules.DeploymentUnit.2.Name", Value: "app2"},
+			{Name: "Device.SoftwareModules.DeploymentUnit.10.Name", Value: "app3"},
+		},
+		Removed: []device.Parameter{{Name: "Device.SoftwareModules.DeploymentUnit.1.Name", Value: "app1"}},
+		Changed: []device.ParameterChange{{Name: "Device.SoftwareModules.DeploymentUnitNumberOfEntries", OldValue: "1", NewValue: "2"}},
+	}
+	if !reflect.DeepEqual(diff, want) {
+		t.Errorf("expected %+v, got %+v", want, diff)
+	}
+
+	var out bytes.Buffer
+	if err := diff.WriteText(&out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wantText := `+ Device.SoftwareModules.DeploymentUnit.2.Name: app2
++ Device.SoftwareModules.DeploymentUnit.10.Name: app3
+- Device.SoftwareModules.DeploymentUnit.1.Name: app1
+~ Device.SoftwareModules.DeploymentUnitNumberOfEntries: 1 -> 2
+`
+	if out.String() != wantText {
+		t.Errorf("expected:\n%s\ngot:\n%s", wantText, out.String())
+	}
+
+	if !device.DiffSnapshots(old, old).IsEmpty() {
+		t.Error("expected no differences between identical snapshots")
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ssh
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	stdssh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// environment variable holding the socket of the SSH agent
+const envSSHAuthSock = "SSH_AUTH_SOCK"
+
+// the authentication methods offered to the server, in order: public keys (of the key file, then of the SSH agent),
+// password and keyboard-interactive; the user is only prompted once the server asks for a method, and only when
+// running in a terminal. The returned function releases the connection to the agent once authenticated.
+func authMethods(sshconfig *configuration.SSHClientEndpoint, user, host, password string) ([]stdssh.AuthMethod, func(), error) {
+	type keySource struct {
+		name    string
+		signers func() ([]stdssh.Signer, error)
+	}
+	var (
+		methods []stdssh.AuthMethod
+		sources []keySource
+	)
+	if keyPath := sshconfig.PrivateKeyFile.String(); len(keyPath) > 0 {
+		key, err := loadPrivateKey(keyPath, sshconfig.PrivateKeyPassphrase.String())
+		if err != nil {
+			return nil, nil, err
+		}
+		sources = append(sources, keySource{fmt.Sprintf("key file %s", keyPath), key})
+	}
+	agentConn := connectAgent()
+	if agentConn != nil {
+		sources = append(sources, keySource{"SSH agent", agent.NewClient(agentConn).Signers})
+	}
+	if len(sources) > 0 {
+		// a single method, as each one is only tried once per kind; a failing source (e.g. an unanswered
+		// passphrase prompt) is skipped, so that the keys of the others are still offered
+		methods = append(methods, stdssh.PublicKeysCallback(func() ([]stdssh.Signer, error) {
+			var all []stdssh.Signer
+			for _, source := range sources {
+				some, err := source.signers()
+				if err != nil {
+					tui.LogWarning("Skipping the keys of the %s: %s", source.name, err.Error())
+					continue
+				}
+				all = append(all, some...)
+			}
+			return all, nil
+		}))
+	}
+
+	interactive := tui.IsInteractive()
+	if len(password) > 0 {
+		methods = append(methods, stdssh.Password(password))
+	} else if interactive {
+		methods = append(methods, stdssh.RetryableAuthMethod(stdssh.PasswordCallback(func() (string, error) {
+			return tui.PromptForPassword(fmt.Sprintf("%s@%s's password", user, host))
+		}), maxNumRetries))
+	}
+	if len(password) > 0 || interactive {
+		methods = append(methods, stdssh.RetryableAuthMethod(
+			stdssh.KeyboardInteractive(keyboardInteractive(password, interactive)), maxNumRetries))
+	}
+	return methods, func() {
+		if agentConn != nil {
+			agentConn.Close()
+		}
+	}, nil
+}
+
+// connect to the SSH agent, if any is running; nil if not
+func connectAgent() net.Conn {
+	sock := os.Getenv(envSSHAuthSock)
+	if len(sock) == 0 {
+		return nil
+	}
+	conn, err := net.Dial("unix", sock)
+	if err != nil {
+		tui.LogWarning("Cannot connect to SSH agent (%s): %s", sock, err.Error())
+		return nil
+	}
+	return conn
+}
+
+// read a private key file; the passphrase of an encrypted key is prompted for (if not given) as soon as the server
+// offers public key authentication, before any key (including the ones of the SSH agent) is tried
+func loadPrivateKey(keyPath, passphrase string) (func() ([]stdssh.Signer, error), error) {
+	key, err := os.ReadFile(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read private key file %s: %w", keyPath, err)
+	}
+	signer, err := stdssh.ParsePrivateKey(key)
+	var missingErr *stdssh.PassphraseMissingError
+	switch {
+	case err == nil:
+		return func() ([]stdssh.Signer, error) { return []stdssh.Signer{signer}, nil }, nil
+	case !errors.As(err, &missingErr):
+		return nil, fmt.Errorf("cannot parse private key file %s: %w", keyPath, err)
+	case len(passphrase) > 0:
+		if signer, err = decryptPrivateKey(keyPath, key, passphrase); err != nil {
+			return nil, err
+		}
+		return func() ([]stdssh.Signer, error) { return []stdssh.Signer{signer}, nil }, nil
+	case !tui.IsInteractive():
+		return nil, fmt.Errorf("private key file %s is passphrase protected; set privateKeyPassphrase", keyPath)
+	}
+	return func() ([]stdssh.Signer, error) {
+		if signer == nil {
+			passphrase, err := tui.PromptForPassword(fmt.Sprintf("Enter passphrase for key '%s'", keyPath))
+			if err != nil {
+				return nil, err
+			}
+			if signer, err = decryptPrivateKey(keyPath, key, passphrase); err != nil {
+				return nil, err
+			}
+		}
+		return []stdssh.Signer{signer}, nil
+	}, nil
+}
+
+func decryptPrivateKey(keyPath string, key []byte, passphrase string) (stdssh.Signer, error) {
+	signer, err := stdssh.ParsePrivateKeyWithPassphrase(key, []byte(passphrase))
+	if errors.Is(err, x509.IncorrectPasswordError) {
+		return nil, fmt.Errorf("incorrect passphrase for private key file %s", keyPath)
+	} else if err != nil {
+		return nil, fmt.Errorf("cannot parse private key file %s: %w", keyPath, err)
+	}
+	return signer, nil
+}
+
+// answer the challenges of keyboard-interactive authentication: the password (if given) answers the first single
+// hidden question, as asked by servers authenticating passwords through PAM; the rest is prompted for
+func keyboardInteractive(password string, interactive bool) stdssh.KeyboardInteractiveChallenge {
+	passwordUsed := len(password) == 0
+	return func(name, instruction string, questions []string, echos []bool) ([]string, error) {
+		answers := make([]string, len(questions))
+		if len(questions) == 0 {
+			return answers, nil
+		}
+		if !passwordUsed && len(questions) == 1 && !echos[0] {
+			passwordUsed = true
+			answers[0] = password
+			return answers, nil
+		}
+		if !interactive {
+			return nil, errors.New("keyboard-interactive authentication cannot be answered without a terminal")
+		}
+		for _, text := range []string{name, instruction} {
+			if text = strings.TrimSpace(text); len(text) > 0 {
+				tui.LogNormal(text)
+			}
+		}
+		for i, question := range questions {
+			label := strings.TrimSuffix(strings.TrimSpace(question), ":")
+			var err error
+			if echos[i] {
+				answers[i], err = tui.PromptForValue(label, "")
+			} else {
+				answers[i], err = tui.PromptForPassword(label)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		return answers, nil
+	}
+}
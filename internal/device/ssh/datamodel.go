@@ -0,0 +1,65 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ssh
+
+import (
+	"bufio"
+	"context"
+	"github.com/nokia/corteca-cli/internal/device"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// command line client of the Ambiorix bus agnostic API (prplOS), querying the data model over ubus
+const baCliCmd = "ba-cli -l -a"
+
+// read the data model with ba-cli; it returns the whole sub-tree, limited to the requested depth afterwards
+func (d *SSHDevice) ReadDataModel(ctx context.Context, path string, depth int) ([]device.Parameter, error) {
+	if strings.ContainsAny(path, "'\\") {
+		return nil, fmt.Errorf("invalid data model path '%s'", path)
+	}
+	output, err := d.runCommand(ctx, fmt.Sprintf("%s '%s?'", baCliCmd, path))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query data model with ba-cli: %w", err)
+	}
+	params, err := parseBaCliOutput(output.String(), path)
+	if err != nil {
+		return nil, err
+	}
+	return device.LimitDataModelDepth(params, path, depth), nil
+}
+
+// parse the `<path>=<value>` lines (objects: `<path>.`) printed by ba-cli for a get request; values of strings
+// are quoted
+func parseBaCliOutput(output, path string) ([]device.Parameter, error) {
+	var params []device.Parameter
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, "ERROR") {
+			return nil, fmt.Errorf("ba-cli: %s", line)
+		}
+		// skip the echoed command and anything else outside the requested path
+		if !strings.HasPrefix(line, path) {
+			continue
+		}
+		name, value, found := strings.Cut(line, "=")
+		if !found {
+			if strings.HasSuffix(name, ".") {
+				params = append(params, device.Parameter{Name: name})
+			}
+			continue
+		}
+		if unquoted, err := strconv.Unquote(value); err == nil {
+			value = unquoted
+		}
+		params = append(params, device.Parameter{Name: name, Value: value})
+	}
+	if len(params) == 0 {
+		return nil, fmt.Errorf("no parameters found under '%s'", path)
+	}
+	return params, nil
+}
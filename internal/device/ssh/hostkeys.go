@@ -0,0 +1,224 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ssh
+
+import (
+	"github.com/nokia/corteca-cli/internal/tui"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	stdssh "golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	// prompt whether to trust an unknown host key (rejecting it when not running interactively)
+	HostKeyPolicyAsk = "ask"
+	// trust unknown host keys without prompting
+	HostKeyPolicyAcceptNew = "accept-new"
+	// reject unknown host keys
+	HostKeyPolicyStrict = "strict"
+)
+
+// known hosts files the host keys are verified against: the user's OpenSSH one, and the one corteca adds the
+// host keys trusted on first use to (set by the command line layer)
+var (
+	UserKnownHostsFile = defaultUserKnownHostsFile()
+	KnownHostsFile     string
+)
+
+// host keys trusted during this run, by host; also covers keys that could not be saved
+var (
+	trustedKeysMutex sync.Mutex
+	trustedKeys      = make(map[string][]stdssh.PublicKey)
+)
+
+func defaultUserKnownHostsFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".ssh", "known_hosts")
+}
+
+type hostKeyVerifier struct {
+	policy string
+	// verification against the known hosts files; nil if there are none
+	known stdssh.HostKeyCallback
+}
+
+func newHostKeyVerifier(policy string) (*hostKeyVerifier, error) {
+	v := hostKeyVerifier{policy: strings.ToLower(policy)}
+	switch v.policy {
+	case "":
+		v.policy = HostKeyPolicyAsk
+	case HostKeyPolicyAsk, HostKeyPolicyAcceptNew, HostKeyPolicyStrict:
+	default:
+		return nil, fmt.Errorf("unknown host key policy '%s'", policy)
+	}
+
+	var files []string
+	for _, file := range []string{UserKnownHostsFile, KnownHostsFile} {
+		if len(file) == 0 {
+			continue
+		}
+		if _, err := os.Stat(file); errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	if len(files) > 0 {
+		known, err := knownhosts.New(files...)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read known hosts: %w", err)
+		}
+		v.known = known
+	}
+	return &v, nil
+}
+
+// the host key algorithms to negotiate with the host, so that it presents a key of a known type (nil to negotiate
+// any, if the host is unknown)
+func (v *hostKeyVerifier) algorithms(hostport string) []string {
+	var keyErr *knownhosts.KeyError
+	if v.known == nil || !errors.As(v.known(hostport, &net.TCPAddr{}, probeKey{}), &keyErr) {
+		return nil
+	}
+	var algorithms []string
+	for _, known := range keyErr.Want {
+		if known.Key.Type() == stdssh.KeyAlgoRSA {
+			algorithms = append(algorithms, stdssh.KeyAlgoRSASHA512, stdssh.KeyAlgoRSASHA256)
+		}
+		algorithms = append(algorithms, known.Key.Type())
+	}
+	return algorithms
+}
+
+// the host key callback of the SSH client
+func (v *hostKeyVerifier) verify(hostname string, remote net.Addr, key stdssh.PublicKey) error {
+	host := knownhosts.Normalize(hostname)
+	if isTrusted(host, key) {
+		return nil
+	}
+	var err error = &knownhosts.KeyError{}
+	if v.known != nil {
+		err = v.known(hostname, remote, key)
+	}
+	var keyErr *knownhosts.KeyError
+	var revokedErr *knownhosts.RevokedError
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, &revokedErr):
+		return fmt.Errorf("host key of '%s' (%s) is revoked (%s:%d)", host, stdssh.FingerprintSHA256(key),
+			revokedErr.Revoked.Filename, revokedErr.Revoked.Line)
+	case !errors.As(err, &keyErr):
+		return err
+	case len(keyErr.Want) > 0:
+		return hostKeyChanged(host, key, keyErr.Want)
+	}
+	return v.trustNew(host, key)
+}
+
+func hostKeyChanged(host string, key stdssh.PublicKey, want []knownhosts.KnownKey) error {
+	entries := make([]string, 0, len(want))
+	tui.LogError("WARNING: THE HOST KEY OF '%s' HAS CHANGED!", host)
+	tui.LogError("Expected after reflashing the device; otherwise, someone may be intercepting the connection.")
+	for _, known := range want {
+		entry := fmt.Sprintf("%s:%d", known.Filename, known.Line)
+		tui.LogError("Known %s key %s (%s)", known.Key.Type(), stdssh.FingerprintSHA256(known.Key), entry)
+		entries = append(entries, entry)
+	}
+	return fmt.Errorf("host key of '%s' has changed: offered %s key %s; if expected, remove the outdated entries (%s)",
+		host, key.Type(), stdssh.FingerprintSHA256(key), strings.Join(entries, ", "))
+}
+
+// apply the policy to a host not found in the known hosts
+func (v *hostKeyVerifier) trustNew(host string, key stdssh.PublicKey) error {
+	fingerprint := stdssh.FingerprintSHA256(key)
+	switch v.policy {
+	case HostKeyPolicyStrict:
+		return fmt.Errorf("unknown host key of '%s' (%s key %s); add it to the known hosts or set hostKeyPolicy to '%s'",
+			host, key.Type(), fingerprint, HostKeyPolicyAcceptNew)
+	case HostKeyPolicyAsk:
+		if !tui.IsInteractive() {
+			return fmt.Errorf("unknown host key of '%s' (%s key %s) cannot be confirmed without a terminal; set hostKeyPolicy to '%s' to trust it",
+				host, key.Type(), fingerprint, HostKeyPolicyAcceptNew)
+		}
+		trust, err := tui.PromptForConfirm(fmt.Sprintf("The authenticity of host '%s' can't be established; its %s key fingerprint is %s. Trust it",
+			host, key.Type(), fingerprint), false)
+		if err != nil {
+			return err
+		}
+		if !trust {
+			return fmt.Errorf("host key of '%s' (%s) not trusted", host, fingerprint)
+		}
+	default:
+		tui.LogWarning("Trusting new %s host key of '%s': %s", key.Type(), host, fingerprint)
+	}
+	trustKey(host, key)
+	return nil
+}
+
+func isTrusted(host string, key stdssh.PublicKey) bool {
+	trustedKeysMutex.Lock()
+	defer trustedKeysMutex.Unlock()
+	for _, trusted := range trustedKeys[host] {
+		if string(trusted.Marshal()) == string(key.Marshal()) {
+			return true
+		}
+	}
+	return false
+}
+
+// trust the key for the rest of the run, and save it into corteca's known hosts file
+func trustKey(host string, key stdssh.PublicKey) {
+	trustedKeysMutex.Lock()
+	defer trustedKeysMutex.Unlock()
+	trustedKeys[host] = append(trustedKeys[host], key)
+	if len(KnownHostsFile) == 0 {
+		return
+	}
+	if err := appendKnownHost(KnownHostsFile, host, key); err != nil {
+		tui.LogWarning("Cannot save host key of '%s': %s", host, err.Error())
+		return
+	}
+	tui.LogNormal("Added host key of '%s' to '%s'", host, KnownHostsFile)
+}
+
+func appendKnownHost(filename, host string, key stdssh.PublicKey) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = fmt.Fprintln(f, knownhosts.Line([]string{host}, key))
+	return err
+}
+
+// a key matching none of the known ones, to look up the keys known for a host
+type probeKey struct{}
+
+func (probeKey) Type() string {
+	return "probe"
+}
+
+func (probeKey) Marshal() []byte {
+	return []byte("probe")
+}
+
+func (probeKey) Verify([]byte, *stdssh.Signature) error {
+	return errors.New("probe key")
+}
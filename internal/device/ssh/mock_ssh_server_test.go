@@ -10,11 +10,15 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
 	"net"
+	"os/exec"
 	"strings"
 	"testing"
 
+	"github.com/pkg/sftp"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -22,6 +26,9 @@ import (
 // It returns the stdout to send back and the exit code to report.
 type cmdHandlerFunc func(cmd string) (stdout string, exitCode uint32)
 
+// sessionHandlerFunc serves a session channel and its requests.
+type sessionHandlerFunc func(ch ssh.Channel, reqs <-chan *ssh.Request)
+
 // withQuaggaProbe wraps a cmdHandlerFunc so that the "ps | grep ash" probe fired
 // unconditionally by NewSSHDevice is handled transparently (returns "ash", exit 0,
 // which signals to the device that Quagga is not active and no further action is
@@ -46,17 +53,42 @@ func startTestServer(t *testing.T, expectedUsername, password string, authorized
 	t.Helper()
 
 	// Generate a fresh host key for every server instance.
+	return startTestServerWithHostKeys(t, []ssh.Signer{newTestHostKey(t)}, expectedUsername, password, authorizedKey, handler)
+}
+
+// newTestHostKey generates an ECDSA P-256 host key.
+func newTestHostKey(t *testing.T) ssh.Signer {
+	t.Helper()
 	hostKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
-		t.Fatalf("startTestServer: generate host key: %v", err)
+		t.Fatalf("newTestHostKey: generate host key: %v", err)
 	}
 	signer, err := ssh.NewSignerFromKey(hostKey)
 	if err != nil {
-		t.Fatalf("startTestServer: create signer: %v", err)
+		t.Fatalf("newTestHostKey: create signer: %v", err)
 	}
+	return signer
+}
+
+// startTestServerWithHostKeys is startTestServer presenting the given host keys.
+func startTestServerWithHostKeys(t *testing.T, hostKeys []ssh.Signer, expectedUsername, password string, authorizedKey ssh.PublicKey, handler cmdHandlerFunc) string {
+	t.Helper()
+
+	return startTestServerWithSessions(t, hostKeys, expectedUsername, password, authorizedKey,
+		func(ch ssh.Channel, reqs <-chan *ssh.Request) {
+			serveSession(ch, reqs, handler)
+		})
+}
+
+// startTestServerWithSessions is startTestServerWithHostKeys serving the sessions
+// with the given handler instead of serveSession.
+func startTestServerWithSessions(t *testing.T, hostKeys []ssh.Signer, expectedUsername, password string, authorizedKey ssh.PublicKey, handler sessionHandlerFunc) string {
+	t.Helper()
 
 	cfg := &ssh.ServerConfig{}
-	cfg.AddHostKey(signer)
+	for _, hostKey := range hostKeys {
+		cfg.AddHostKey(hostKey)
+	}
 
 	if password != "" {
 		cfg.PasswordCallback = func(meta ssh.ConnMetadata, pass []byte) (*ssh.Permissions, error) {
@@ -82,6 +114,14 @@ func startTestServer(t *testing.T, expectedUsername, password string, authorized
 		}
 	}
 
+	return startTestServerWithConfig(t, cfg, handler)
+}
+
+// startTestServerWithConfig starts a server authenticating clients as configured
+// by cfg, serving the sessions with handler.
+func startTestServerWithConfig(t *testing.T, cfg *ssh.ServerConfig, handler sessionHandlerFunc) string {
+	t.Helper()
+
 	ln, err := net.Listen("tcp", "127.0.0.1:0")
 	if err != nil {
 		t.Fatalf("startTestServer: listen: %v", err)
@@ -101,7 +141,7 @@ func startTestServer(t *testing.T, expectedUsername, password string, authorized
 	return ln.Addr().String()
 }
 
-func serveConn(conn net.Conn, cfg *ssh.ServerConfig, handler cmdHandlerFunc) {
+func serveConn(conn net.Conn, cfg *ssh.ServerConfig, handler sessionHandlerFunc) {
 	sshConn, chans, reqs, err := ssh.NewServerConn(conn, cfg)
 	if err != nil {
 		return // auth failure or protocol error — nothing to do
@@ -118,7 +158,7 @@ func serveConn(conn net.Conn, cfg *ssh.ServerConfig, handler cmdHandlerFunc) {
 		if err != nil {
 			return
 		}
-		go serveSession(ch, requests, handler)
+		go handler(ch, requests)
 	}
 }
 
@@ -171,3 +211,85 @@ func serveSession(ch ssh.Channel, reqs <-chan *ssh.Request, handler cmdHandlerFu
 		}
 	}
 }
+
+// serveShellSession serves a session like a device shell: exec requests run
+// through the local "sh" in dir, with the standard streams wired to the channel
+// and only the tools in path available; the sftp subsystem is served from dir
+// when withSFTP is set, and refused otherwise (as by a device without
+// sftp-server). The Quagga probe is answered as by withQuaggaProbe.
+func serveShellSession(dir, path string, withSFTP bool) sessionHandlerFunc {
+	return func(ch ssh.Channel, reqs <-chan *ssh.Request) {
+		defer ch.Close()
+
+		for req := range reqs {
+			switch req.Type {
+			case "subsystem":
+				if !withSFTP || len(req.Payload) < 4 || string(req.Payload[4:]) != "sftp" {
+					req.Reply(false, nil)
+					continue
+				}
+				req.Reply(true, nil)
+				go ssh.DiscardRequests(reqs)
+				server, err := sftp.NewServer(ch, sftp.WithServerWorkingDirectory(dir))
+				if err != nil {
+					return
+				}
+				server.Serve() //nolint:errcheck
+				return
+
+			case "exec":
+				if len(req.Payload) < 4 {
+					req.Reply(false, nil)
+					continue
+				}
+				n := binary.BigEndian.Uint32(req.Payload[:4])
+				if uint32(len(req.Payload)) < 4+n {
+					req.Reply(false, nil)
+					continue
+				}
+				cmdLine := string(req.Payload[4 : 4+n])
+				req.Reply(true, nil)
+				go ssh.DiscardRequests(reqs)
+
+				var exitCode uint32
+				if strings.TrimSpace(cmdLine) == "ps | grep ash" {
+					ch.Write([]byte("ash")) //nolint:errcheck
+				} else {
+					cmd := exec.Command("sh", "-c", cmdLine)
+					cmd.Dir = dir
+					cmd.Env = []string{"PATH=" + path}
+					cmd.Stdout = ch
+					cmd.Stderr = ch.Stderr()
+					// through a pipe, as Wait would block on a pending read of the channel
+					stdin, err := cmd.StdinPipe()
+					if err == nil {
+						err = cmd.Start()
+					}
+					if err == nil {
+						go func() {
+							io.Copy(stdin, ch) //nolint:errcheck
+							stdin.Close()
+						}()
+						err = cmd.Wait()
+					}
+					if err != nil {
+						exitCode = 255
+						var exitErr *exec.ExitError
+						if errors.As(err, &exitErr) {
+							exitCode = uint32(exitErr.ExitCode())
+						}
+					}
+				}
+				exitStatus := make([]byte, 4)
+				binary.BigEndian.PutUint32(exitStatus, exitCode)
+				ch.SendRequest("exit-status", false, exitStatus) //nolint:errcheck
+				return
+
+			default:
+				if req.WantReply {
+					req.Reply(false, nil)
+				}
+			}
+		}
+	}
+}
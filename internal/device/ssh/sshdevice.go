@@ -15,7 +15,6 @@ import (
 	"io"
 	"net"
 	"net/url"
-	"os"
 	"strings"
 	"sync"
 	"time"
@@ -90,6 +89,10 @@ func (d *SSHDevice) BeginSequence() error {
 }
 
 func (d *SSHDevice) ExecuteCommand(ctx context.Context, cmd *configuration.SequenceCmd) (any, error) {
+	if isTransferStep(cmd.Cmd.String()) {
+		return d.executeTransfer(ctx, cmd)
+	}
+
 	// interpret cmd.params as SSHParams (i.e. array of strings)
 	var params struct {
 		Params []configuration.TemplateField `yaml:"params"`
@@ -111,6 +114,16 @@ func (d *SSHDevice) ExecuteCommand(ctx context.Context, cmd *configuration.Seque
 }
 
 func (d *SSHDevice) executeCommandString(ctx context.Context, cmd string) (any, error) {
+	output, err := d.runCommand(ctx, cmd)
+	var exitError *stdssh.ExitError
+	if errors.As(err, &exitError) {
+		return output, fmt.Errorf("exit code (%d)", exitError.ExitStatus())
+	}
+	return nil, err
+}
+
+// run a command on the device, returning its standard output (also if the command fails)
+func (d *SSHDevice) runCommand(ctx context.Context, cmd string) (*bytes.Buffer, error) {
 	session, err := d.client.NewSession()
 	if err != nil {
 		return nil, fmt.Errorf("cannot start SSH command session: %w", err)
@@ -133,12 +146,7 @@ func (d *SSHDevice) executeCommandString(ctx context.Context, cmd string) (any,
 
 	select {
 	case err := <-done:
-		var exitError *stdssh.ExitError
-		if errors.As(err, &exitError) {
-			return output, fmt.Errorf("exit code (%d)", exitError.ExitStatus())
-		} else {
-			return nil, err
-		}
+		return output, err
 
 	case <-ctx.Done():
 		_ = session.Signal(ssh.SIGKILL)
@@ -169,45 +177,25 @@ func (d *SSHDevice) connectSSHClient(sshconfig *configuration.SSHClientEndpoint)
 		username = explicitUser
 	}
 
-	config := &stdssh.ClientConfig{
-		User:            username,
-		HostKeyCallback: stdssh.InsecureIgnoreHostKey(), // TODO: Replace with secure method
-		Auth:            make([]stdssh.AuthMethod, 0, 2),
-	}
-
-	// add keyfile, if present
-	keyPath := sshconfig.PrivateKeyFile.String()
-	if len(keyPath) > 0 {
-		key, err := os.ReadFile(keyPath)
-		if err != nil {
-			return fmt.Errorf("cannot read private key file %s: %w", keyPath, err)
-		}
-		signer, err := stdssh.ParsePrivateKey(key)
-		if err != nil {
-			return fmt.Errorf("cannot parse private key file %s: %w", keyPath, err)
-		}
-		config.Auth = append(config.Auth, stdssh.PublicKeys(signer))
+	hostKeys, err := newHostKeyVerifier(sshconfig.HostKeyPolicy)
+	if err != nil {
+		return err
 	}
-
-	// add password, if present
+	// explicit password field takes priority over the URL
 	passwd := sshconfig.Password.String()
-	passwdPresent := len(passwd) > 0
-	if passwdPresent {
-		config.Auth = append(config.Auth, stdssh.Password(passwd))
-	} else if passwd, passwdPresent = u.User.Password(); passwdPresent {
-		config.Auth = append(config.Auth, stdssh.Password(passwd))
+	if len(passwd) == 0 {
+		passwd, _ = u.User.Password()
 	}
-
-	// add prompt for password if no other methods exist
-	if len(config.Auth) == 0 {
-		// FIXME:
-		// the below results in always asking for a password even if the SSH server is not asking for one
-		// should use something like: config.Auth = append(config.Auth, stdssh.KeyboardInteractive(...))
-		passwd, err := tui.PromptForPassword(fmt.Sprintf("%s@%s's password", u.User.Username(), u.Host))
-		if err != nil {
-			return err
-		}
-		config.Auth = append(config.Auth, stdssh.Password(passwd))
+	auth, releaseAuth, err := authMethods(sshconfig, username, u.Host, passwd)
+	if err != nil {
+		return err
+	}
+	defer releaseAuth()
+	config := &stdssh.ClientConfig{
+		User:              username,
+		HostKeyCallback:   hostKeys.verify,
+		HostKeyAlgorithms: hostKeys.algorithms(u.Host),
+		Auth:              auth,
 	}
 
 	// connect to stdssh server
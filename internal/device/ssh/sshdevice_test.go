@@ -8,21 +8,28 @@ import (
 	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/x509"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"io"
+	"net"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device"
 	devssh "github.com/nokia/corteca-cli/internal/device/ssh"
 
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
 	"gopkg.in/yaml.v3"
 )
 
@@ -32,10 +39,32 @@ import (
 
 const testPassword = "s3cr3t-test-password"
 
+// TestMain points the known hosts files to a temporary folder, so that the
+// tests neither read nor extend the ones of the user running them, and keeps
+// the SSH agent of the user out of the tests.
+func TestMain(m *testing.M) {
+	os.Unsetenv("SSH_AUTH_SOCK")
+	dir, err := os.MkdirTemp("", "corteca-known-hosts-*")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	devssh.UserKnownHostsFile = filepath.Join(dir, "user_known_hosts")
+	devssh.KnownHostsFile = filepath.Join(dir, "known_hosts")
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
 // mustDeviceConfig unmarshals yamlStr into a *configuration.DeviceConfig,
 // ensuring the internal raw yaml.Node is populated (required by DeviceConfig.Decode).
+// Unless yamlStr sets a hostKeyPolicy, the (fresh) host keys of the test servers
+// are trusted without prompting.
 func mustDeviceConfig(t *testing.T, yamlStr string) *configuration.DeviceConfig {
 	t.Helper()
+	if !strings.Contains(yamlStr, "hostKeyPolicy:") {
+		yamlStr = strings.TrimSuffix(yamlStr, "\n") + "\nhostKeyPolicy: accept-new\n"
+	}
 	var cfg configuration.DeviceConfig
 	if err := yaml.Unmarshal([]byte(yamlStr), &cfg); err != nil {
 		t.Fatalf("mustDeviceConfig: %v", err)
@@ -197,6 +226,340 @@ func TestSSHDevice_PublicKeyAuth(t *testing.T) {
 	dev.Close()
 }
 
+// newTestClientKey generates an Ed25519 client key pair.
+func newTestClientKey(t *testing.T) (ed25519.PrivateKey, ssh.PublicKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate client key: %v", err)
+	}
+	sshPubKey, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatalf("create SSH public key: %v", err)
+	}
+	return priv, sshPubKey
+}
+
+// TestSSHDevice_EncryptedKeyAuth verifies that a passphrase protected key file
+// is decrypted with the configured passphrase, and that a wrong or missing
+// passphrase (which cannot be prompted for without a terminal) is reported.
+func TestSSHDevice_EncryptedKeyAuth(t *testing.T) {
+	clientKey, sshPubKey := newTestClientKey(t)
+	block, err := ssh.MarshalPrivateKeyWithPassphrase(clientKey, "", []byte("key-passphrase"))
+	if err != nil {
+		t.Fatalf("marshal encrypted key: %v", err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(block), 0600); err != nil {
+		t.Fatalf("write key file: %v", err)
+	}
+	addr := startTestServer(t, "testuser", "", sshPubKey, withQuaggaProbe(func(cmd string) (string, uint32) {
+		return "", 0
+	}))
+
+	tests := []struct {
+		name       string
+		passphrase string
+		wantErr    string
+	}{
+		{"correct_passphrase", "\nprivateKeyPassphrase: key-passphrase", ""},
+		{"wrong_passphrase", "\nprivateKeyPassphrase: wrong", "incorrect passphrase"},
+		{"missing_passphrase", "", "passphrase protected"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := mustDeviceConfig(t, fmt.Sprintf("addr: ssh://testuser@%s\nprivateKeyFile: %s%s\n",
+				addr, keyFile, tc.passphrase))
+			dev, err := devssh.NewSSHDevice(cfg, io.Discard)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("expected successful connection, got: %v", err)
+				}
+				dev.Close()
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("error = %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+// TestSSHDevice_AgentAuth verifies that the keys of the SSH agent listening on
+// SSH_AUTH_SOCK are offered to the server.
+func TestSSHDevice_AgentAuth(t *testing.T) {
+	clientKey, sshPubKey := newTestClientKey(t)
+	keyring := agent.NewKeyring()
+	if err := keyring.Add(agent.AddedKey{PrivateKey: clientKey}); err != nil {
+		t.Fatalf("add key to agent: %v", err)
+	}
+	sock := filepath.Join(t.TempDir(), "agent.sock")
+	ln, err := net.Listen("unix", sock)
+	if err != nil {
+		t.Fatalf("listen on agent socket: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				agent.ServeAgent(keyring, conn) //nolint:errcheck
+			}()
+		}
+	}()
+	t.Setenv("SSH_AUTH_SOCK", sock)
+
+	addr := startTestServer(t, "testuser", "", sshPubKey, withQuaggaProbe(func(cmd string) (string, uint32) {
+		return "", 0
+	}))
+	dev, err := devssh.NewSSHDevice(mustDeviceConfig(t, fmt.Sprintf("addr: ssh://testuser@%s\n", addr)), io.Discard)
+	if err != nil {
+		t.Fatalf("expected successful connection with the agent key, got: %v", err)
+	}
+	dev.Close()
+}
+
+// TestSSHDevice_BrokenAgentSkipped verifies that the key file is still offered
+// when the SSH agent fails to list its keys.
+func TestSSHDevice_BrokenAgentSkipped(t *testing.T) {
+	clientKey, sshPubKey := newTestClientKey(t)
+	block, err := ssh.MarshalPrivateKey(clientKey, "")
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "id_ed25519")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(block), 0600); err != nil {
+		t.Fatalf("write key file: %v", err)
+	}
+	// an agent closing every connection without answering
+	sock := filepath.Join(t.TempDir(), "agent.sock")
+	ln, err := net.Listen("unix", sock)
+	if err != nil {
+		t.Fatalf("listen on agent socket: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+	t.Setenv("SSH_AUTH_SOCK", sock)
+
+	addr := startTestServer(t, "testuser", "", sshPubKey, withQuaggaProbe(func(cmd string) (string, uint32) {
+		return "", 0
+	}))
+	cfg := mustDeviceConfig(t, fmt.Sprintf("addr: ssh://testuser@%s\nprivateKeyFile: %s\n", addr, keyFile))
+	dev, err := devssh.NewSSHDevice(cfg, io.Discard)
+	if err != nil {
+		t.Fatalf("expected successful connection with the key file, got: %v", err)
+	}
+	dev.Close()
+}
+
+// TestSSHDevice_KeyboardInteractiveAuth verifies that the configured password
+// answers the password question of a server only offering keyboard-interactive
+// authentication (as PAM based servers do).
+func TestSSHDevice_KeyboardInteractiveAuth(t *testing.T) {
+	cfg := &ssh.ServerConfig{
+		KeyboardInteractiveCallback: func(meta ssh.ConnMetadata, challenge ssh.KeyboardInteractiveChallenge) (*ssh.Permissions, error) {
+			answers, err := challenge("", "", []string{"Password: "}, []bool{false})
+			if err != nil {
+				return nil, err
+			}
+			if len(answers) != 1 || answers[0] != testPassword {
+				return nil, fmt.Errorf("wrong password")
+			}
+			return nil, nil
+		},
+	}
+	cfg.AddHostKey(newTestHostKey(t))
+	addr := startTestServerWithConfig(t, cfg, func(ch ssh.Channel, reqs <-chan *ssh.Request) {
+		serveSession(ch, reqs, withQuaggaProbe(func(cmd string) (string, uint32) {
+			return "", 0
+		}))
+	})
+
+	tests := []struct {
+		name     string
+		password string
+		wantErr  bool
+	}{
+		{"correct_password", testPassword, false},
+		{"wrong_password", "wrong-password", true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := mustDeviceConfig(t, fmt.Sprintf("addr: ssh://testuser@%s\npassword: %s\n", addr, tc.password))
+			dev, err := devssh.NewSSHDevice(cfg, io.Discard)
+			if tc.wantErr {
+				if err == nil {
+					dev.Close()
+					t.Fatal("expected error with wrong password, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected successful connection, got: %v", err)
+			}
+			dev.Close()
+		})
+	}
+}
+
+// TestSSHDevice_NoCredentials_NotPrompted verifies that without credentials,
+// and without a terminal to prompt on, the connection fails instead of waiting
+// for a password.
+func TestSSHDevice_NoCredentials_NotPrompted(t *testing.T) {
+	addr := startTestServer(t, "", testPassword, nil, withQuaggaProbe(func(cmd string) (string, uint32) {
+		return "", 0
+	}))
+
+	_, err := devssh.NewSSHDevice(mustDeviceConfig(t, fmt.Sprintf("addr: ssh://testuser@%s\n", addr)), io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "unable to authenticate") {
+		t.Fatalf("error = %v, want an authentication failure", err)
+	}
+}
+
+// =============================================================================
+// Host key verification tests
+// =============================================================================
+
+// useKnownHostsFiles points the known hosts files to empty ones for the duration
+// of the test, writing the given entries into the user's one.
+func useKnownHostsFiles(t *testing.T, userEntries ...string) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+	userFile, cortecaFile := devssh.UserKnownHostsFile, devssh.KnownHostsFile
+	t.Cleanup(func() { devssh.UserKnownHostsFile, devssh.KnownHostsFile = userFile, cortecaFile })
+	devssh.UserKnownHostsFile = filepath.Join(dir, "user_known_hosts")
+	devssh.KnownHostsFile = filepath.Join(dir, "corteca", "known_hosts")
+	if len(userEntries) > 0 {
+		data := strings.Join(userEntries, "\n") + "\n"
+		if err := os.WriteFile(devssh.UserKnownHostsFile, []byte(data), 0600); err != nil {
+			t.Fatalf("write known hosts: %v", err)
+		}
+	}
+	return devssh.UserKnownHostsFile, devssh.KnownHostsFile
+}
+
+func startHostKeyTestServer(t *testing.T, hostKeys ...ssh.Signer) string {
+	return startTestServerWithHostKeys(t, hostKeys, "testuser", testPassword, nil, withQuaggaProbe(func(cmd string) (string, uint32) {
+		return "", 0
+	}))
+}
+
+func connectWithHostKeyPolicy(t *testing.T, addr, policy string) (device.Device, error) {
+	cfg := mustDeviceConfig(t, fmt.Sprintf("addr: ssh://testuser:%s@%s\nhostKeyPolicy: %s\n", testPassword, addr, policy))
+	return devssh.NewSSHDevice(cfg, io.Discard)
+}
+
+// TestSSHDevice_HostKey_AcceptNewSavesKey verifies that an unknown host key is
+// trusted under the accept-new policy and saved into corteca's known hosts file.
+func TestSSHDevice_HostKey_AcceptNewSavesKey(t *testing.T) {
+	_, cortecaFile := useKnownHostsFiles(t)
+	hostKey := newTestHostKey(t)
+	addr := startHostKeyTestServer(t, hostKey)
+
+	dev, err := connectWithHostKeyPolicy(t, addr, devssh.HostKeyPolicyAcceptNew)
+	if err != nil {
+		t.Fatalf("expected successful connection, got: %v", err)
+	}
+	dev.Close()
+
+	verify, err := knownhosts.New(cortecaFile)
+	if err != nil {
+		t.Fatalf("read saved known hosts: %v", err)
+	}
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		t.Fatalf("resolve %s: %v", addr, err)
+	}
+	if err := verify(addr, tcpAddr, hostKey.PublicKey()); err != nil {
+		t.Errorf("host key not saved: %v", err)
+	}
+}
+
+// TestSSHDevice_HostKey_StrictRejectsUnknown verifies that the strict policy
+// rejects an unknown host key, reporting its fingerprint.
+func TestSSHDevice_HostKey_StrictRejectsUnknown(t *testing.T) {
+	useKnownHostsFiles(t)
+	hostKey := newTestHostKey(t)
+	addr := startHostKeyTestServer(t, hostKey)
+
+	_, err := connectWithHostKeyPolicy(t, addr, devssh.HostKeyPolicyStrict)
+	if err == nil {
+		t.Fatal("expected unknown host key to be rejected, got nil")
+	}
+	if fingerprint := ssh.FingerprintSHA256(hostKey.PublicKey()); !strings.Contains(err.Error(), fingerprint) {
+		t.Errorf("expected error to contain fingerprint %s, got: %v", fingerprint, err)
+	}
+}
+
+// TestSSHDevice_HostKey_KnownHost verifies that a host listed in the user's
+// known hosts file is accepted under the strict policy, also when its entry is
+// of another key type than the client would prefer.
+func TestSSHDevice_HostKey_KnownHost(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("generate ed25519 host key: %v", err)
+	}
+	edSigner, err := ssh.NewSignerFromKey(edKey)
+	if err != nil {
+		t.Fatalf("create signer: %v", err)
+	}
+	addr := startHostKeyTestServer(t, newTestHostKey(t), edSigner)
+	useKnownHostsFiles(t, knownhosts.Line([]string{knownhosts.Normalize(addr)}, edSigner.PublicKey()))
+
+	dev, err := connectWithHostKeyPolicy(t, addr, devssh.HostKeyPolicyStrict)
+	if err != nil {
+		t.Fatalf("expected successful connection, got: %v", err)
+	}
+	dev.Close()
+}
+
+// TestSSHDevice_HostKey_ChangedKeyFails verifies that a host presenting another
+// key than the known one (e.g. after a reflash) is rejected under any policy,
+// reporting the new fingerprint, and that the new key is not saved.
+func TestSSHDevice_HostKey_ChangedKeyFails(t *testing.T) {
+	hostKey := newTestHostKey(t)
+	addr := startHostKeyTestServer(t, hostKey)
+	oldKey := newTestHostKey(t)
+	_, cortecaFile := useKnownHostsFiles(t, knownhosts.Line([]string{knownhosts.Normalize(addr)}, oldKey.PublicKey()))
+
+	_, err := connectWithHostKeyPolicy(t, addr, devssh.HostKeyPolicyAcceptNew)
+	if err == nil {
+		t.Fatal("expected changed host key to be rejected, got nil")
+	}
+	if !strings.Contains(err.Error(), "has changed") {
+		t.Errorf("expected error to report the changed key, got: %v", err)
+	}
+	if fingerprint := ssh.FingerprintSHA256(hostKey.PublicKey()); !strings.Contains(err.Error(), fingerprint) {
+		t.Errorf("expected error to contain fingerprint %s, got: %v", fingerprint, err)
+	}
+	if _, err := os.Stat(cortecaFile); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected no host key to be saved, got: %v", err)
+	}
+}
+
+// TestSSHDevice_HostKey_UnknownPolicy verifies that an unknown policy is rejected.
+func TestSSHDevice_HostKey_UnknownPolicy(t *testing.T) {
+	useKnownHostsFiles(t)
+	addr := startHostKeyTestServer(t, newTestHostKey(t))
+
+	_, err := connectWithHostKeyPolicy(t, addr, "trust-all")
+	if err == nil || !strings.Contains(err.Error(), "unknown host key policy") {
+		t.Errorf("expected unknown host key policy error, got: %v", err)
+	}
+}
+
 // =============================================================================
 // Protocol / lifecycle tests
 // =============================================================================
@@ -384,3 +747,59 @@ func TestSSHDevice_ExecuteCommand_ContextCancellation(t *testing.T) {
 		t.Fatal("timed out waiting for ExecuteCommand to return after context cancellation")
 	}
 }
+
+// =============================================================================
+// Data model tests
+// =============================================================================
+
+// TestSSHDevice_ReadDataModel verifies that the data model is queried with
+// ba-cli and that its output is parsed into objects and (unquoted) parameter
+// values, limited to the requested depth.
+func TestSSHDevice_ReadDataModel(t *testing.T) {
+	received := make(chan string, 2)
+	addr := startTestServer(t, "testuser", testPassword, nil, withQuaggaProbe(func(cmd string) (string, uint32) {
+		received <- strings.TrimSpace(cmd)
+		return `> Device.IP.?
+Device.IP.
+Device.IP.IPv4Enable=1
+Device.IP.Interface.
+Device.IP.Interface.1.
+Device.IP.Interface.1.Alias="lan = bridge"
+`, 0
+	}))
+
+	cfg := mustDeviceConfig(t, fmt.Sprintf("addr: ssh://testuser:%s@%s", testPassword, addr))
+	dev, err := devssh.NewSSHDevice(cfg, io.Discard)
+	if err != nil {
+		t.Fatalf("unexpected error creating device: %v", err)
+	}
+	defer dev.Close()
+
+	params, err := dev.(device.DataModelReader).ReadDataModel(context.Background(), "Device.IP.", 1)
+	if err != nil {
+		t.Fatalf("unexpected error reading data model: %v", err)
+	}
+	if cmd := <-received; cmd != "ba-cli -l -a 'Device.IP.?'" {
+		t.Errorf("command: expected ba-cli query, got %q", cmd)
+	}
+	want := []device.Parameter{
+		{Name: "Device.IP."},
+		{Name: "Device.IP.IPv4Enable", Value: "1"},
+		{Name: "Device.IP.Interface."},
+	}
+	if fmt.Sprint(params) != fmt.Sprint(want) {
+		t.Errorf("parameters: expected %v, got %v", want, params)
+	}
+
+	params, err = dev.(device.DataModelReader).ReadDataModel(context.Background(), "Device.IP.", 0)
+	if err != nil {
+		t.Fatalf("unexpected error reading data model: %v", err)
+	}
+	if last := params[len(params)-1]; last != (device.Parameter{Name: "Device.IP.Interface.1.Alias", Value: "lan = bridge"}) {
+		t.Errorf("quoted value: expected it to be unquoted, got %v", last)
+	}
+
+	if _, err := dev.(device.DataModelReader).ReadDataModel(context.Background(), "Device.IP.'", 0); err == nil {
+		t.Error("expected an error for a path containing a quote")
+	}
+}
@@ -0,0 +1,578 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ssh
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/sftp"
+	stdssh "golang.org/x/crypto/ssh"
+)
+
+const (
+	// step kinds copying a local file to the device, and a file of the device to the host
+	StepUpload   = "upload"
+	StepDownload = "download"
+
+	TransferSFTP = "sftp"
+	TransferSCP  = "scp"
+	TransferCat  = "cat"
+
+	// exit code of a shell that cannot find the command
+	exitCommandNotFound = 127
+)
+
+// transfer methods, in the order they are tried when the step does not pick one
+var transferMethods = []string{TransferSFTP, TransferSCP, TransferCat}
+
+// the transfer method is not available on the device; the next one is tried
+var errTransferUnsupported = errors.New("not available on the device")
+
+// parameters of an upload or download step
+type transferStep struct {
+	Source      configuration.TemplateField `yaml:"source,omitempty"`
+	Destination configuration.TemplateField `yaml:"destination,omitempty"`
+	Method      string                      `yaml:"method,omitempty"`
+}
+
+// result of an upload or download step
+type TransferResult struct {
+	Source      string `yaml:"Source"`
+	Destination string `yaml:"Destination"`
+	Size        int64  `yaml:"Size"`
+	Method      string `yaml:"Method"`
+}
+
+func isTransferStep(cmd string) bool {
+	return cmd == StepUpload || cmd == StepDownload
+}
+
+func (d *SSHDevice) executeTransfer(ctx context.Context, cmd *configuration.SequenceCmd) (any, error) {
+	kind := cmd.Cmd.String()
+	var step transferStep
+	if err := cmd.Decode(&step); err != nil {
+		return nil, fmt.Errorf("incompatible %s parameters specified: %w", kind, err)
+	}
+	methods := transferMethods
+	if len(step.Method) > 0 {
+		method := strings.ToLower(step.Method)
+		if !slices.Contains(transferMethods, method) {
+			return nil, fmt.Errorf("unknown transfer method '%s' (expected one of: %s)", step.Method,
+				strings.Join(transferMethods, ", "))
+		}
+		methods = []string{method}
+	}
+	var (
+		result *TransferResult
+		err    error
+	)
+	if kind == StepUpload {
+		result, err = d.upload(ctx, step.Source.String(), step.Destination.String(), methods)
+	} else {
+		result, err = d.download(ctx, step.Source.String(), step.Destination.String(), methods)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// copy a local file (the build artifact, unless given) to the device
+func (d *SSHDevice) upload(ctx context.Context, source, destination string, methods []string) (*TransferResult, error) {
+	if len(source) == 0 {
+		source = configuration.GetCmdContext().Artifact
+	}
+	if len(source) == 0 {
+		return nil, errors.New("no source specified for upload, and no artifact was built")
+	}
+	if len(destination) == 0 {
+		return nil, errors.New("no destination specified for upload")
+	}
+	file, err := os.Open(source)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("cannot upload '%s': not a regular file", source)
+	}
+	if strings.HasSuffix(destination, "/") {
+		destination = path.Join(destination, filepath.Base(source))
+	}
+
+	result := TransferResult{Source: source, Destination: destination, Size: info.Size()}
+	var errs []error
+	for _, method := range methods {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		src := newProgressReader(file, info.Size(), fmt.Sprintf("Uploading %s", filepath.Base(source)))
+		switch method {
+		case TransferSFTP:
+			err = d.sftpUpload(ctx, src, info.Mode(), destination)
+		case TransferSCP:
+			err = d.scpUpload(ctx, src, info.Size(), info.Mode(), destination)
+		default:
+			err = d.catUpload(ctx, src, info.Mode(), destination)
+		}
+		src.Close()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if errors.Is(err, errTransferUnsupported) {
+			tui.LogNormal("Cannot transfer with %s: %s", method, err.Error())
+			errs = append(errs, fmt.Errorf("%s: %w", method, err))
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot upload '%s' to '%s' (%s): %w", source, destination, method, err)
+		}
+		result.Method = method
+		tui.LogNormal("Uploaded '%s' to '%s' (%d bytes, %s)", source, destination, result.Size, method)
+		return &result, nil
+	}
+	return nil, fmt.Errorf("cannot upload '%s': no transfer method available: %w", source, errors.Join(errs...))
+}
+
+// copy a file of the device to the host (into the current directory, unless given)
+func (d *SSHDevice) download(ctx context.Context, source, destination string, methods []string) (*TransferResult, error) {
+	if len(source) == 0 {
+		return nil, errors.New("no source specified for download")
+	}
+	if len(destination) == 0 {
+		destination = "."
+	}
+	if info, err := os.Stat(destination); strings.HasSuffix(destination, string(filepath.Separator)) ||
+		(err == nil && info.IsDir()) {
+		destination = filepath.Join(destination, path.Base(source))
+	}
+	if err := os.MkdirAll(filepath.Dir(destination), 0755); err != nil {
+		return nil, err
+	}
+
+	result := TransferResult{Source: source, Destination: destination}
+	var errs []error
+	for _, method := range methods {
+		// downloaded next to the destination, which is only replaced once complete
+		file, err := os.CreateTemp(filepath.Dir(destination), "."+filepath.Base(destination)+".*")
+		if err != nil {
+			return nil, err
+		}
+		label := fmt.Sprintf("Downloading %s", path.Base(source))
+		switch method {
+		case TransferSFTP:
+			result.Size, err = d.sftpDownload(ctx, source, file, label)
+		case TransferSCP:
+			result.Size, err = d.scpDownload(ctx, source, file, label)
+		default:
+			result.Size, err = d.catDownload(ctx, source, file, label)
+		}
+		if err == nil {
+			err = file.Chmod(0644)
+		}
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err == nil {
+			err = os.Rename(file.Name(), destination)
+		}
+		if err != nil {
+			os.Remove(file.Name())
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if errors.Is(err, errTransferUnsupported) {
+			tui.LogNormal("Cannot transfer with %s: %s", method, err.Error())
+			errs = append(errs, fmt.Errorf("%s: %w", method, err))
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot download '%s' to '%s' (%s): %w", source, destination, method, err)
+		}
+		result.Method = method
+		tui.LogNormal("Downloaded '%s' to '%s' (%d bytes, %s)", source, destination, result.Size, method)
+		return &result, nil
+	}
+	return nil, fmt.Errorf("cannot download '%s': no transfer method available: %w", source, errors.Join(errs...))
+}
+
+// ==== SFTP ====
+
+func (d *SSHDevice) newSFTPClient(ctx context.Context) (*sftp.Client, func(), error) {
+	session, err := d.client.NewSession()
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot start SSH session: %w", err)
+	}
+	// no sftp-server: the subsystem request is refused, or the session closes right away
+	client, err := func() (*sftp.Client, error) {
+		if err := session.RequestSubsystem("sftp"); err != nil {
+			return nil, err
+		}
+		stdin, err := session.StdinPipe()
+		if err != nil {
+			return nil, err
+		}
+		stdout, err := session.StdoutPipe()
+		if err != nil {
+			return nil, err
+		}
+		return sftp.NewClientPipe(stdout, stdin)
+	}()
+	if err != nil {
+		session.Close()
+		return nil, nil, fmt.Errorf("%w (%s)", errTransferUnsupported, err.Error())
+	}
+	stop := closeOnDone(ctx, client)
+	return client, func() {
+		stop()
+		client.Close()
+		session.Close()
+	}, nil
+}
+
+func (d *SSHDevice) sftpUpload(ctx context.Context, src io.Reader, mode fs.FileMode, destination string) error {
+	client, done, err := d.newSFTPClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+	file, err := client.Create(destination)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, src); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return client.Chmod(destination, mode.Perm())
+}
+
+func (d *SSHDevice) sftpDownload(ctx context.Context, source string, dst io.Writer, label string) (int64, error) {
+	client, done, err := d.newSFTPClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer done()
+	file, err := client.Open(source)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
+	}
+	src := newProgressReader(file, info.Size(), label)
+	defer src.Close()
+	return io.Copy(dst, src)
+}
+
+// ==== SCP (legacy protocol, for devices without sftp-server) ====
+
+type scpSession struct {
+	session *stdssh.Session
+	stdin   io.WriteCloser
+	stdout  *bufio.Reader
+	stderr  bytes.Buffer
+	waited  bool
+	waitErr error
+}
+
+func (d *SSHDevice) startSCP(ctx context.Context, cmd string) (*scpSession, func(), error) {
+	session, err := d.client.NewSession()
+	if err != nil {
+		return nil, nil, fmt.Errorf("cannot start SSH command session: %w", err)
+	}
+	s := scpSession{session: session}
+	session.Stderr = &s.stderr
+	if s.stdin, err = session.StdinPipe(); err == nil {
+		var stdout io.Reader
+		if stdout, err = session.StdoutPipe(); err == nil {
+			s.stdout = bufio.NewReader(stdout)
+			err = session.Start(cmd)
+		}
+	}
+	if err != nil {
+		session.Close()
+		return nil, nil, err
+	}
+	stop := closeOnDone(ctx, session)
+	return &s, func() {
+		stop()
+		session.Close()
+	}, nil
+}
+
+// read the acknowledgement of the remote scp; reports a missing scp as unsupported
+func (s *scpSession) ack() error {
+	status, err := s.stdout.ReadByte()
+	if err != nil {
+		return s.failed(err)
+	}
+	if status == 0 {
+		return nil
+	}
+	msg, _ := s.stdout.ReadString('\n')
+	return fmt.Errorf("scp: %s", strings.TrimSpace(msg))
+}
+
+// close the input of the remote scp and wait for it to exit
+func (s *scpSession) wait() error {
+	if !s.waited {
+		s.stdin.Close()
+		s.waitErr = s.session.Wait()
+		s.waited = true
+	}
+	return s.waitErr
+}
+
+// the error of an scp that stopped responding
+func (s *scpSession) failed(err error) error {
+	var exitErr *stdssh.ExitError
+	if waitErr := s.wait(); errors.As(waitErr, &exitErr) {
+		if exitErr.ExitStatus() == exitCommandNotFound {
+			return fmt.Errorf("%w (%s)", errTransferUnsupported, strings.TrimSpace(s.stderr.String()))
+		}
+		err = waitErr
+	}
+	if stderr := strings.TrimSpace(s.stderr.String()); len(stderr) > 0 {
+		return fmt.Errorf("%w: %s", err, stderr)
+	}
+	return err
+}
+
+func (s *scpSession) finish() error {
+	if err := s.wait(); err != nil {
+		return s.failed(err)
+	}
+	return nil
+}
+
+func (d *SSHDevice) scpUpload(ctx context.Context, src io.Reader, size int64, mode fs.FileMode, destination string) error {
+	s, done, err := d.startSCP(ctx, "scp -t "+shellQuote(destination))
+	if err != nil {
+		return err
+	}
+	defer done()
+	if err := s.ack(); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(s.stdin, "C%04o %d %s\n", mode.Perm(), size, path.Base(destination)); err != nil {
+		return s.failed(err)
+	}
+	if err := s.ack(); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(s.stdin, src, size); err != nil {
+		return s.failed(err)
+	}
+	if _, err := s.stdin.Write([]byte{0}); err != nil {
+		return s.failed(err)
+	}
+	if err := s.ack(); err != nil {
+		return err
+	}
+	return s.finish()
+}
+
+func (d *SSHDevice) scpDownload(ctx context.Context, source string, dst io.Writer, label string) (int64, error) {
+	s, done, err := d.startSCP(ctx, "scp -f "+shellQuote(source))
+	if err != nil {
+		return 0, err
+	}
+	defer done()
+	if _, err := s.stdin.Write([]byte{0}); err != nil {
+		return 0, s.failed(err)
+	}
+	header, err := s.stdout.ReadString('\n')
+	if err != nil {
+		return 0, s.failed(err)
+	}
+	if header[0] == 1 || header[0] == 2 {
+		return 0, fmt.Errorf("scp: %s", strings.TrimSpace(header[1:]))
+	}
+	// C<mode> <size> <name>
+	fields := strings.SplitN(strings.TrimSpace(header), " ", 3)
+	if len(fields) != 3 || !strings.HasPrefix(fields[0], "C") {
+		return 0, fmt.Errorf("scp: unexpected header '%s'", strings.TrimSpace(header))
+	}
+	size, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("scp: invalid file size in header '%s'", strings.TrimSpace(header))
+	}
+	if _, err := s.stdin.Write([]byte{0}); err != nil {
+		return 0, s.failed(err)
+	}
+	src := newProgressReader(s.stdout, size, label)
+	defer src.Close()
+	if _, err := io.CopyN(dst, src, size); errors.Is(err, io.EOF) {
+		return 0, s.failed(err)
+	} else if err != nil {
+		return 0, err
+	}
+	if err := s.ack(); err != nil {
+		return 0, err
+	}
+	if _, err := s.stdin.Write([]byte{0}); err != nil {
+		return 0, s.failed(err)
+	}
+	return size, s.finish()
+}
+
+// ==== cat (piping through the shell; always available) ====
+
+func (d *SSHDevice) catUpload(ctx context.Context, src io.Reader, mode fs.FileMode, destination string) error {
+	quoted := shellQuote(destination)
+	return d.runPiped(ctx, fmt.Sprintf("cat > %s && chmod %o %s", quoted, mode.Perm(), quoted), src, io.Discard)
+}
+
+func (d *SSHDevice) catDownload(ctx context.Context, source string, dst io.Writer, label string) (int64, error) {
+	quoted := shellQuote(source)
+	// the size is only needed for the progress
+	var size int64
+	var wc bytes.Buffer
+	if err := d.runPiped(ctx, "wc -c < "+quoted, nil, &wc); err == nil {
+		size, _ = strconv.ParseInt(strings.TrimSpace(wc.String()), 10, 64)
+	}
+	counter := &countingWriter{w: dst}
+	progress := newProgressWriter(counter, size, label)
+	defer progress.Close()
+	err := d.runPiped(ctx, "cat "+quoted, nil, progress)
+	return counter.n, err
+}
+
+// run a shell command on the device, feeding it stdin (if not nil); a failure reports its standard error
+func (d *SSHDevice) runPiped(ctx context.Context, cmd string, stdin io.Reader, stdout io.Writer) error {
+	session, err := d.client.NewSession()
+	if err != nil {
+		return fmt.Errorf("cannot start SSH command session: %w", err)
+	}
+	defer session.Close()
+	defer closeOnDone(ctx, session)()
+	var stderr bytes.Buffer
+	session.Stdin = stdin
+	session.Stdout = stdout
+	session.Stderr = &stderr
+	err = session.Run(cmd)
+	var exitErr *stdssh.ExitError
+	if errors.As(err, &exitErr) {
+		msg := strings.TrimSpace(stderr.String())
+		if exitErr.ExitStatus() == exitCommandNotFound {
+			return fmt.Errorf("%w (%s)", errTransferUnsupported, msg)
+		}
+		return fmt.Errorf("exit code (%d): %s", exitErr.ExitStatus(), msg)
+	}
+	return err
+}
+
+// ==== helpers ====
+
+// quote an argument for the shell of the device
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// close c once ctx is done, interrupting the transfer in progress; the returned function stops watching
+func closeOnDone(ctx context.Context, c io.Closer) func() {
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			c.Close()
+		case <-stop:
+		}
+	}()
+	return func() { close(stop) }
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// reports the progress of a transfer of known size; the progress bar is only shown once data flows
+type progress struct {
+	label   string
+	total   int64
+	current int64
+	ch      chan<- tui.ProgressUpdate
+}
+
+func (p *progress) add(n int) {
+	if n <= 0 || p.total <= 0 {
+		return
+	}
+	if p.ch == nil {
+		p.ch = tui.PromptForProgress(p.label)
+	}
+	p.current += int64(n)
+	p.ch <- tui.ProgressUpdate{Current: min(p.current, p.total), Total: p.total}
+}
+
+func (p *progress) Close() error {
+	if p.ch != nil {
+		close(p.ch)
+		p.ch = nil
+	}
+	return nil
+}
+
+type progressReader struct {
+	progress
+	r io.Reader
+}
+
+func newProgressReader(r io.Reader, total int64, label string) *progressReader {
+	return &progressReader{progress: progress{label: label, total: total}, r: r}
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.add(n)
+	return n, err
+}
+
+type progressWriter struct {
+	progress
+	w io.Writer
+}
+
+func newProgressWriter(w io.Writer, total int64, label string) *progressWriter {
+	return &progressWriter{progress: progress{label: label, total: total}, w: w}
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.add(n)
+	return n, err
+}
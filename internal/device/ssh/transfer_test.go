@@ -0,0 +1,258 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package ssh_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device"
+	devssh "github.com/nokia/corteca-cli/internal/device/ssh"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// =============================================================================
+// Test helpers
+// =============================================================================
+
+// startTransferTestServer starts a server serving the sessions from a fresh
+// "device" folder (see serveShellSession), offering only the given tools
+// besides the ones every device has (cat, chmod, wc). It returns the connected
+// device and the folder.
+func startTransferTestServer(t *testing.T, withSFTP bool, tools ...string) (device.Device, string) {
+	t.Helper()
+	root := t.TempDir()
+	bin := t.TempDir()
+	for _, tool := range append([]string{"cat", "chmod", "wc"}, tools...) {
+		target, err := exec.LookPath(tool)
+		if err != nil {
+			t.Skipf("%s not available: %v", tool, err)
+		}
+		if err := os.Symlink(target, filepath.Join(bin, tool)); err != nil {
+			t.Fatalf("link %s: %v", tool, err)
+		}
+	}
+
+	addr := startTestServerWithSessions(t, []ssh.Signer{newTestHostKey(t)}, "", testPassword, nil,
+		serveShellSession(root, bin, withSFTP))
+	d, err := devssh.NewSSHDevice(mustDeviceConfig(t, fmt.Sprintf(
+		"addr: ssh://root@%s\npassword: %s\n", addr, testPassword)), &strings.Builder{})
+	if err != nil {
+		t.Fatalf("NewSSHDevice: %v", err)
+	}
+	t.Cleanup(d.Close)
+	return d, root
+}
+
+// writeTestFile creates a file of the given mode holding content in a fresh folder.
+func writeTestFile(t *testing.T, name, content string, mode os.FileMode) string {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(file, []byte(content), mode); err != nil {
+		t.Fatalf("write %s: %v", file, err)
+	}
+	return file
+}
+
+func executeTransfer(t *testing.T, d device.Device, step string) (*devssh.TransferResult, error) {
+	t.Helper()
+	res, err := d.ExecuteCommand(context.Background(), mustSequenceCmd(t, step))
+	if err != nil {
+		return nil, err
+	}
+	result, ok := res.(*devssh.TransferResult)
+	if !ok {
+		t.Fatalf("result is %T, want *ssh.TransferResult", res)
+	}
+	return result, nil
+}
+
+func assertFileContent(t *testing.T, file, want string) {
+	t.Helper()
+	got, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("read %s: %v", file, err)
+	}
+	if string(got) != want {
+		t.Errorf("%s holds %q, want %q", file, got, want)
+	}
+}
+
+// =============================================================================
+// Upload and download
+// =============================================================================
+
+// TestSSHDevice_Transfer_Methods verifies that a file makes a round trip to the
+// device and back with each transfer method, keeping its mode on upload.
+func TestSSHDevice_Transfer_Methods(t *testing.T) {
+	const content = "#!/bin/sh\necho 'hello'\n"
+
+	for _, method := range []string{devssh.TransferSFTP, devssh.TransferSCP, devssh.TransferCat} {
+		t.Run(method, func(t *testing.T) {
+			d, root := startTransferTestServer(t, method == devssh.TransferSFTP, "scp")
+			source := writeTestFile(t, "start.sh", content, 0755)
+			if err := os.Mkdir(filepath.Join(root, "apps"), 0755); err != nil {
+				t.Fatalf("mkdir: %v", err)
+			}
+
+			result, err := executeTransfer(t, d, fmt.Sprintf(
+				"cmd: upload\nsource: %s\ndestination: apps/\nmethod: %s\n", source, method))
+			if err != nil {
+				t.Fatalf("upload: %v", err)
+			}
+			want := devssh.TransferResult{Source: source, Destination: "apps/start.sh", Size: int64(len(content)), Method: method}
+			if *result != want {
+				t.Errorf("upload result = %+v, want %+v", *result, want)
+			}
+			uploaded := filepath.Join(root, "apps", "start.sh")
+			assertFileContent(t, uploaded, content)
+			if info, err := os.Stat(uploaded); err != nil || info.Mode().Perm() != 0755 {
+				t.Errorf("uploaded file mode = %v (%v), want 0755", info.Mode().Perm(), err)
+			}
+
+			destination := filepath.Join(t.TempDir(), "scripts", "copy.sh")
+			result, err = executeTransfer(t, d, fmt.Sprintf(
+				"cmd: download\nsource: apps/start.sh\ndestination: %s\nmethod: %s\n", destination, method))
+			if err != nil {
+				t.Fatalf("download: %v", err)
+			}
+			want = devssh.TransferResult{Source: "apps/start.sh", Destination: destination, Size: int64(len(content)), Method: method}
+			if *result != want {
+				t.Errorf("download result = %+v, want %+v", *result, want)
+			}
+			assertFileContent(t, destination, content)
+		})
+	}
+}
+
+// TestSSHDevice_Upload_FallsBack verifies that without sftp-server the upload
+// falls back to scp, and without scp to piping through cat.
+func TestSSHDevice_Upload_FallsBack(t *testing.T) {
+	tests := []struct {
+		name   string
+		tools  []string
+		method string
+	}{
+		{"scp", []string{"scp"}, devssh.TransferSCP},
+		{"cat", nil, devssh.TransferCat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, root := startTransferTestServer(t, false, tt.tools...)
+			source := writeTestFile(t, "app.tar", "payload", 0644)
+
+			result, err := executeTransfer(t, d, fmt.Sprintf("cmd: upload\nsource: %s\ndestination: app.tar\n", source))
+			if err != nil {
+				t.Fatalf("upload: %v", err)
+			}
+			if result.Method != tt.method {
+				t.Errorf("method = %q, want %q", result.Method, tt.method)
+			}
+			assertFileContent(t, filepath.Join(root, "app.tar"), "payload")
+		})
+	}
+}
+
+// TestSSHDevice_Upload_ArtifactSource verifies that the build artifact is
+// uploaded when no source is given, and that the source renders templates.
+func TestSSHDevice_Upload_ArtifactSource(t *testing.T) {
+	d, root := startTransferTestServer(t, true)
+	artifact := writeTestFile(t, "app-1.0-aarch64-oci.tar", "oci image", 0644)
+	ctx := configuration.GetCmdContext()
+	saved := ctx.Artifact
+	ctx.Artifact = artifact
+	t.Cleanup(func() { ctx.Artifact = saved })
+
+	for _, step := range []string{
+		"cmd: upload\ndestination: ./\n",
+		"cmd: upload\nsource: ${.artifact}\ndestination: ./\n",
+	} {
+		os.Remove(filepath.Join(root, "app-1.0-aarch64-oci.tar"))
+		result, err := executeTransfer(t, d, step)
+		if err != nil {
+			t.Fatalf("%q: %v", step, err)
+		}
+		if result.Source != artifact || result.Destination != "app-1.0-aarch64-oci.tar" {
+			t.Errorf("%q: result = %+v", step, *result)
+		}
+	}
+}
+
+// TestSSHDevice_Download_MissingFile verifies that a failed download reports
+// the error of the device and leaves no partial file behind.
+func TestSSHDevice_Download_MissingFile(t *testing.T) {
+	for _, method := range []string{devssh.TransferSFTP, devssh.TransferSCP, devssh.TransferCat} {
+		t.Run(method, func(t *testing.T) {
+			d, _ := startTransferTestServer(t, true, "scp")
+			destination := filepath.Join(t.TempDir(), "missing.log")
+
+			_, err := executeTransfer(t, d, fmt.Sprintf(
+				"cmd: download\nsource: missing.log\ndestination: %s\nmethod: %s\n", destination, method))
+			if err == nil {
+				t.Fatal("expected an error downloading a missing file, got nil")
+			}
+			if _, statErr := os.Stat(destination); !os.IsNotExist(statErr) {
+				t.Errorf("partial file left behind: %v", statErr)
+			}
+		})
+	}
+}
+
+// TestSSHDevice_Download_KeepsExistingFile verifies that a failed download
+// leaves an existing destination file untouched.
+func TestSSHDevice_Download_KeepsExistingFile(t *testing.T) {
+	for _, method := range []string{devssh.TransferSFTP, devssh.TransferSCP, devssh.TransferCat} {
+		t.Run(method, func(t *testing.T) {
+			d, _ := startTransferTestServer(t, true, "scp")
+			destination := writeTestFile(t, "device.log", "previous", 0644)
+
+			_, err := executeTransfer(t, d, fmt.Sprintf(
+				"cmd: download\nsource: missing.log\ndestination: %s\nmethod: %s\n", destination, method))
+			if err == nil {
+				t.Fatal("expected an error downloading a missing file, got nil")
+			}
+			assertFileContent(t, destination, "previous")
+			if entries, _ := os.ReadDir(filepath.Dir(destination)); len(entries) != 1 {
+				t.Errorf("temporary file left behind: %v", entries)
+			}
+		})
+	}
+}
+
+// TestSSHDevice_Transfer_InvalidSteps verifies the errors of incomplete or
+// invalid transfer steps, and of a method the device lacks.
+func TestSSHDevice_Transfer_InvalidSteps(t *testing.T) {
+	d, _ := startTransferTestServer(t, false)
+	source := writeTestFile(t, "app.tar", "payload", 0644)
+	destination := t.TempDir()
+	configuration.GetCmdContext().Artifact = ""
+
+	tests := []struct {
+		name    string
+		step    string
+		wantErr string
+	}{
+		{"no source", "cmd: upload\ndestination: /tmp/\n", "no source"},
+		{"no destination", fmt.Sprintf("cmd: upload\nsource: %s\n", source), "no destination"},
+		{"unknown method", fmt.Sprintf("cmd: upload\nsource: %s\ndestination: x\nmethod: ftp\n", source), "unknown transfer method 'ftp'"},
+		{"no sftp-server", fmt.Sprintf("cmd: upload\nsource: %s\ndestination: x\nmethod: sftp\n", source), "no transfer method available"},
+		{"no scp", fmt.Sprintf("cmd: download\nsource: x\ndestination: %s\nmethod: scp\n", destination), "no transfer method available"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := executeTransfer(t, d, tt.step)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package usp
+
+import (
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device"
+	"github.com/nokia/corteca-cli/internal/device/usp/messages"
+	"slices"
+	"strings"
+)
+
+// read the data model with a single Get of the whole sub-tree, limited to the requested depth afterwards
+func (d *USPDevice) ReadDataModel(ctx context.Context, path string, depth int) ([]device.Parameter, error) {
+	resp, err := d.request(ctx, messages.Get{ParamPaths: []configuration.TemplateField{configuration.T(path)}})
+	if err != nil {
+		return nil, err
+	}
+	// resolved paths only cover the objects holding parameters; their ancestors below path are added as well
+	objects := make(map[string]bool)
+	var params []device.Parameter
+	for _, req := range resp.(messages.GetResp).ReqPathResults {
+		for _, res := range req.ResolvedPathResults {
+			for param, value := range res.ResultParams {
+				name := res.ResolvedPath + param
+				params = append(params, device.Parameter{Name: name, Value: value})
+				for i := len(path); i < len(name); i++ {
+					if name[i] == '.' {
+						objects[name[:i+1]] = true
+					}
+				}
+			}
+			if strings.HasSuffix(res.ResolvedPath, ".") {
+				objects[res.ResolvedPath] = true
+			}
+		}
+	}
+	for object := range objects {
+		params = append(params, device.Parameter{Name: object})
+	}
+	slices.SortFunc(params, func(a, b device.Parameter) int { return strings.Compare(a.Name, b.Name) })
+	return device.LimitDataModelDepth(params, path, depth), nil
+}
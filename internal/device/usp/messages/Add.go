@@ -0,0 +1,109 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/usp/messages/proto/uspmsg"
+	"fmt"
+)
+
+type Add struct {
+	AllowPartial bool             `yaml:"allow_partial,omitempty"`
+	CreateObjs   []ObjectSettings `yaml:"create_objs"`
+}
+
+func (m Add) GetName() string     { return "Add" }
+func (m Add) GetMsgType() MsgType { return MsgTypeAdd }
+func (m Add) ValidateResponse(resp Message) error {
+	if r, ok := resp.(AddResp); ok {
+		for _, res := range r.CreatedObjResults {
+			if f := res.OperFailure; f != nil {
+				return fmt.Errorf("failed to add '%s': %s (err_code: %d)", res.RequestedPath, f.ErrMsg, f.ErrCode)
+			}
+		}
+		return nil
+	}
+	return ExpectMessage[AddResp](resp)
+}
+
+func (m Add) toBody() *uspmsg.Body {
+	pb := &uspmsg.Add{AllowPartial: m.AllowPartial}
+	for _, obj := range m.CreateObjs {
+		o := &uspmsg.Add_CreateObject{ObjPath: obj.ObjPath.String()}
+		for _, p := range obj.ParamSettings {
+			o.ParamSettings = append(o.ParamSettings, &uspmsg.Add_CreateParamSetting{Param: p.Param.String(), Value: p.Value.String(), Required: p.Required})
+		}
+		pb.CreateObjs = append(pb.CreateObjs, o)
+	}
+	return requestBody(&uspmsg.Request{ReqType: &uspmsg.Request_Add{Add: pb}})
+}
+
+func addFromProto(pb *uspmsg.Add) Add {
+	m := Add{AllowPartial: pb.GetAllowPartial()}
+	for _, o := range pb.GetCreateObjs() {
+		obj := ObjectSettings{ObjPath: configuration.T(o.GetObjPath())}
+		for _, p := range o.GetParamSettings() {
+			obj.ParamSettings = append(obj.ParamSettings, ParamSetting{Param: configuration.T(p.GetParam()), Value: configuration.T(p.GetValue()), Required: p.GetRequired()})
+		}
+		m.CreateObjs = append(m.CreateObjs, obj)
+	}
+	return m
+}
+
+type AddResp struct {
+	CreatedObjResults []CreatedObjectResult `yaml:"created_obj_results"`
+}
+
+type CreatedObjectResult struct {
+	RequestedPath    string            `yaml:"requested_path"`
+	OperFailure      *OperationFailure `yaml:"oper_failure,omitempty"`
+	InstantiatedPath string            `yaml:"instantiated_path,omitempty"`
+	ParamErrs        []ParameterError  `yaml:"param_errs,omitempty"`
+	UniqueKeys       map[string]string `yaml:"unique_keys,omitempty"`
+}
+
+func (m AddResp) GetName() string     { return "AddResp" }
+func (m AddResp) GetMsgType() MsgType { return MsgTypeAddResp }
+
+func (m AddResp) toBody() *uspmsg.Body {
+	pb := &uspmsg.AddResp{}
+	for _, res := range m.CreatedObjResults {
+		status := &uspmsg.AddResp_CreatedObjectResult_OperationStatus{}
+		if f := res.OperFailure; f != nil {
+			status.OperStatus = &uspmsg.AddResp_CreatedObjectResult_OperationStatus_OperFailure{
+				OperFailure: &uspmsg.AddResp_CreatedObjectResult_OperationStatus_OperationFailure{ErrCode: f.ErrCode, ErrMsg: f.ErrMsg},
+			}
+		} else {
+			success := &uspmsg.AddResp_CreatedObjectResult_OperationStatus_OperationSuccess{InstantiatedPath: res.InstantiatedPath, UniqueKeys: res.UniqueKeys}
+			for _, p := range res.ParamErrs {
+				success.ParamErrs = append(success.ParamErrs, &uspmsg.AddResp_ParameterError{Param: p.Param, ErrCode: p.ErrCode, ErrMsg: p.ErrMsg})
+			}
+			status.OperStatus = &uspmsg.AddResp_CreatedObjectResult_OperationStatus_OperSuccess{OperSuccess: success}
+		}
+		pb.CreatedObjResults = append(pb.CreatedObjResults, &uspmsg.AddResp_CreatedObjectResult{RequestedPath: res.RequestedPath, OperStatus: status})
+	}
+	return responseBody(&uspmsg.Response{RespType: &uspmsg.Response_AddResp{AddResp: pb}})
+}
+
+func addRespFromProto(pb *uspmsg.AddResp) AddResp {
+	m := AddResp{}
+	for _, r := range pb.GetCreatedObjResults() {
+		res := CreatedObjectResult{RequestedPath: r.GetRequestedPath()}
+		status := r.GetOperStatus()
+		if f := status.GetOperFailure(); f != nil {
+			res.OperFailure = &OperationFailure{ErrCode: f.GetErrCode(), ErrMsg: f.GetErrMsg()}
+		}
+		if s := status.GetOperSuccess(); s != nil {
+			res.InstantiatedPath = s.GetInstantiatedPath()
+			res.UniqueKeys = stringMap(s.GetUniqueKeys())
+			for _, p := range s.GetParamErrs() {
+				res.ParamErrs = append(res.ParamErrs, ParameterError{Param: p.GetParam(), ErrCode: p.GetErrCode(), ErrMsg: p.GetErrMsg()})
+			}
+		}
+		m.CreatedObjResults = append(m.CreatedObjResults, res)
+	}
+	return m
+}
@@ -0,0 +1,115 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/usp/messages/proto/uspmsg"
+	"fmt"
+)
+
+type Delete struct {
+	AllowPartial bool                          `yaml:"allow_partial,omitempty"`
+	ObjPaths     []configuration.TemplateField `yaml:"obj_paths"`
+}
+
+func (m Delete) GetName() string     { return "Delete" }
+func (m Delete) GetMsgType() MsgType { return MsgTypeDelete }
+func (m Delete) ValidateResponse(resp Message) error {
+	if r, ok := resp.(DeleteResp); ok {
+		for _, res := range r.DeletedObjResults {
+			if f := res.OperFailure; f != nil {
+				return fmt.Errorf("failed to delete '%s': %s (err_code: %d)", res.RequestedPath, f.ErrMsg, f.ErrCode)
+			}
+			if len(res.UnaffectedPathErrs) > 0 {
+				u := res.UnaffectedPathErrs[0]
+				return fmt.Errorf("failed to delete '%s': %s (err_code: %d)", u.UnaffectedPath, u.ErrMsg, u.ErrCode)
+			}
+		}
+		return nil
+	}
+	return ExpectMessage[DeleteResp](resp)
+}
+
+func (m Delete) toBody() *uspmsg.Body {
+	pb := &uspmsg.Delete{AllowPartial: m.AllowPartial}
+	for _, path := range m.ObjPaths {
+		pb.ObjPaths = append(pb.ObjPaths, path.String())
+	}
+	return requestBody(&uspmsg.Request{ReqType: &uspmsg.Request_Delete{Delete: pb}})
+}
+
+func deleteFromProto(pb *uspmsg.Delete) Delete {
+	m := Delete{AllowPartial: pb.GetAllowPartial()}
+	for _, path := range pb.GetObjPaths() {
+		m.ObjPaths = append(m.ObjPaths, configuration.T(path))
+	}
+	return m
+}
+
+type DeleteResp struct {
+	DeletedObjResults []DeletedObjectResult `yaml:"deleted_obj_results"`
+}
+
+type DeletedObjectResult struct {
+	RequestedPath      string              `yaml:"requested_path"`
+	OperFailure        *OperationFailure   `yaml:"oper_failure,omitempty"`
+	AffectedPaths      []string            `yaml:"affected_paths,omitempty"`
+	UnaffectedPathErrs []UnaffectedPathErr `yaml:"unaffected_path_errs,omitempty"`
+}
+
+type UnaffectedPathErr struct {
+	UnaffectedPath string `yaml:"unaffected_path"`
+	ErrCode        uint32 `yaml:"err_code"`
+	ErrMsg         string `yaml:"err_msg"`
+}
+
+func (m DeleteResp) GetName() string     { return "DeleteResp" }
+func (m DeleteResp) GetMsgType() MsgType { return MsgTypeDeleteResp }
+
+func (m DeleteResp) toBody() *uspmsg.Body {
+	pb := &uspmsg.DeleteResp{}
+	for _, res := range m.DeletedObjResults {
+		status := &uspmsg.DeleteResp_DeletedObjectResult_OperationStatus{}
+		if f := res.OperFailure; f != nil {
+			status.OperStatus = &uspmsg.DeleteResp_DeletedObjectResult_OperationStatus_OperFailure{
+				OperFailure: &uspmsg.DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure{ErrCode: f.ErrCode, ErrMsg: f.ErrMsg},
+			}
+		} else {
+			success := &uspmsg.DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess{AffectedPaths: res.AffectedPaths}
+			for _, u := range res.UnaffectedPathErrs {
+				success.UnaffectedPathErrs = append(success.UnaffectedPathErrs, &uspmsg.DeleteResp_UnaffectedPathError{
+					UnaffectedPath: u.UnaffectedPath,
+					ErrCode:        u.ErrCode,
+					ErrMsg:         u.ErrMsg,
+				})
+			}
+			status.OperStatus = &uspmsg.DeleteResp_DeletedObjectResult_OperationStatus_OperSuccess{OperSuccess: success}
+		}
+		pb.DeletedObjResults = append(pb.DeletedObjResults, &uspmsg.DeleteResp_DeletedObjectResult{RequestedPath: res.RequestedPath, OperStatus: status})
+	}
+	return responseBody(&uspmsg.Response{RespType: &uspmsg.Response_DeleteResp{DeleteResp: pb}})
+}
+
+func deleteRespFromProto(pb *uspmsg.DeleteResp) DeleteResp {
+	m := DeleteResp{}
+	for _, r := range pb.GetDeletedObjResults() {
+		res := DeletedObjectResult{RequestedPath: r.GetRequestedPath()}
+		status := r.GetOperStatus()
+		if f := status.GetOperFailure(); f != nil {
+			res.OperFailure = &OperationFailure{ErrCode: f.GetErrCode(), ErrMsg: f.GetErrMsg()}
+		}
+		res.AffectedPaths = status.GetOperSuccess().GetAffectedPaths()
+		for _, u := range status.GetOperSuccess().GetUnaffectedPathErrs() {
+			res.UnaffectedPathErrs = append(res.UnaffectedPathErrs, UnaffectedPathErr{
+				UnaffectedPath: u.GetUnaffectedPath(),
+				ErrCode:        u.GetErrCode(),
+				ErrMsg:         u.GetErrMsg(),
+			})
+		}
+		m.DeletedObjResults = append(m.DeletedObjResults, res)
+	}
+	return m
+}
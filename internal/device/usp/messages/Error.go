@@ -0,0 +1,61 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/device/usp/messages/proto/uspmsg"
+	"fmt"
+)
+
+type Error struct {
+	ErrCode   uint32       `yaml:"err_code"`
+	ErrMsg    string       `yaml:"err_msg"`
+	ParamErrs []ParamError `yaml:"param_errs,omitempty"`
+}
+
+type ParamError struct {
+	ParamPath string `yaml:"param_path"`
+	ErrCode   uint32 `yaml:"err_code"`
+	ErrMsg    string `yaml:"err_msg"`
+}
+
+func (m Error) GetName() string     { return "Error" }
+func (m Error) GetMsgType() MsgType { return MsgTypeError }
+func (m Error) GetFaultCode() uint  { return uint(m.ErrCode) }
+func (m Error) Error() string {
+	msg := fmt.Sprintf("%s (err_code: %d)", m.ErrMsg, m.ErrCode)
+	for _, p := range m.ParamErrs {
+		msg += fmt.Sprintf("; %s: %s (err_code: %d)", p.ParamPath, p.ErrMsg, p.ErrCode)
+	}
+	return msg
+}
+
+func (m Error) toBody() *uspmsg.Body {
+	pb := &uspmsg.Error{ErrCode: m.ErrCode, ErrMsg: m.ErrMsg}
+	for _, p := range m.ParamErrs {
+		pb.ParamErrs = append(pb.ParamErrs, &uspmsg.Error_ParamError{ParamPath: p.ParamPath, ErrCode: p.ErrCode, ErrMsg: p.ErrMsg})
+	}
+	return &uspmsg.Body{MsgBody: &uspmsg.Body_Error{Error: pb}}
+}
+
+func errorFromProto(pb *uspmsg.Error) Error {
+	m := Error{ErrCode: pb.GetErrCode(), ErrMsg: pb.GetErrMsg()}
+	for _, p := range pb.GetParamErrs() {
+		m.ParamErrs = append(m.ParamErrs, ParamError{ParamPath: p.GetParamPath(), ErrCode: p.GetErrCode(), ErrMsg: p.GetErrMsg()})
+	}
+	return m
+}
+
+// failure of an operation on a single object
+type OperationFailure struct {
+	ErrCode uint32 `yaml:"err_code"`
+	ErrMsg  string `yaml:"err_msg"`
+}
+
+type ParameterError struct {
+	Param   string `yaml:"param"`
+	ErrCode uint32 `yaml:"err_code"`
+	ErrMsg  string `yaml:"err_msg"`
+}
@@ -0,0 +1,108 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/usp/messages/proto/uspmsg"
+	"fmt"
+)
+
+type Get struct {
+	ParamPaths []configuration.TemplateField `yaml:"param_paths"`
+	MaxDepth   uint32                        `yaml:"max_depth,omitempty"`
+}
+
+func (m Get) GetName() string     { return "Get" }
+func (m Get) GetMsgType() MsgType { return MsgTypeGet }
+func (m Get) ValidateResponse(resp Message) error {
+	if r, ok := resp.(GetResp); ok {
+		for _, res := range r.ReqPathResults {
+			if res.ErrCode != 0 {
+				return fmt.Errorf("failed to get '%s': %s (err_code: %d)", res.RequestedPath, res.ErrMsg, res.ErrCode)
+			}
+		}
+		return nil
+	}
+	return ExpectMessage[GetResp](resp)
+}
+
+func (m Get) toBody() *uspmsg.Body {
+	pb := &uspmsg.Get{MaxDepth: m.MaxDepth}
+	for _, path := range m.ParamPaths {
+		pb.ParamPaths = append(pb.ParamPaths, path.String())
+	}
+	return requestBody(&uspmsg.Request{ReqType: &uspmsg.Request_Get{Get: pb}})
+}
+
+func getFromProto(pb *uspmsg.Get) Get {
+	m := Get{MaxDepth: pb.GetMaxDepth()}
+	for _, path := range pb.GetParamPaths() {
+		m.ParamPaths = append(m.ParamPaths, configuration.T(path))
+	}
+	return m
+}
+
+type GetResp struct {
+	ReqPathResults []RequestedPathResult `yaml:"req_path_results"`
+}
+
+type RequestedPathResult struct {
+	RequestedPath       string               `yaml:"requested_path"`
+	ErrCode             uint32               `yaml:"err_code"`
+	ErrMsg              string               `yaml:"err_msg"`
+	ResolvedPathResults []ResolvedPathResult `yaml:"resolved_path_results"`
+}
+
+type ResolvedPathResult struct {
+	ResolvedPath string            `yaml:"resolved_path"`
+	ResultParams map[string]string `yaml:"result_params"`
+}
+
+func (m GetResp) GetName() string     { return "GetResp" }
+func (m GetResp) GetMsgType() MsgType { return MsgTypeGetResp }
+
+// all returned parameters, by full path
+func (m GetResp) Params() map[string]string {
+	params := make(map[string]string)
+	for _, req := range m.ReqPathResults {
+		for _, res := range req.ResolvedPathResults {
+			for name, value := range res.ResultParams {
+				params[res.ResolvedPath+name] = value
+			}
+		}
+	}
+	return params
+}
+
+func (m GetResp) toBody() *uspmsg.Body {
+	pb := &uspmsg.GetResp{}
+	for _, req := range m.ReqPathResults {
+		r := &uspmsg.GetResp_RequestedPathResult{RequestedPath: req.RequestedPath, ErrCode: req.ErrCode, ErrMsg: req.ErrMsg}
+		for _, res := range req.ResolvedPathResults {
+			r.ResolvedPathResults = append(r.ResolvedPathResults, &uspmsg.GetResp_ResolvedPathResult{
+				ResolvedPath: res.ResolvedPath,
+				ResultParams: res.ResultParams,
+			})
+		}
+		pb.ReqPathResults = append(pb.ReqPathResults, r)
+	}
+	return responseBody(&uspmsg.Response{RespType: &uspmsg.Response_GetResp{GetResp: pb}})
+}
+
+func getRespFromProto(pb *uspmsg.GetResp) GetResp {
+	m := GetResp{}
+	for _, r := range pb.GetReqPathResults() {
+		req := RequestedPathResult{RequestedPath: r.GetRequestedPath(), ErrCode: r.GetErrCode(), ErrMsg: r.GetErrMsg()}
+		for _, res := range r.GetResolvedPathResults() {
+			req.ResolvedPathResults = append(req.ResolvedPathResults, ResolvedPathResult{
+				ResolvedPath: res.GetResolvedPath(),
+				ResultParams: stringMap(res.GetResultParams()),
+			})
+		}
+		m.ReqPathResults = append(m.ReqPathResults, req)
+	}
+	return m
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+//go:generate protoc --proto_path=proto --go_out=proto --go_opt=module=github.com/nokia/corteca-cli/internal/device/usp/messages/proto --go_opt=Musp-msg-1-3.proto=github.com/nokia/corteca-cli/internal/device/usp/messages/proto/uspmsg --go_opt=Musp-record-1-3.proto=github.com/nokia/corteca-cli/internal/device/usp/messages/proto/usprecord usp-msg-1-3.proto usp-record-1-3.proto
+
+import (
+	"github.com/nokia/corteca-cli/internal/device/usp/messages/proto/uspmsg"
+	"fmt"
+	"maps"
+
+	"google.golang.org/protobuf/proto"
+)
+
+type MsgType uint64
+
+const (
+	MsgTypeError       MsgType = 0
+	MsgTypeGet         MsgType = 1
+	MsgTypeGetResp     MsgType = 2
+	MsgTypeNotify      MsgType = 3
+	MsgTypeSet         MsgType = 4
+	MsgTypeSetResp     MsgType = 5
+	MsgTypeOperate     MsgType = 6
+	MsgTypeOperateResp MsgType = 7
+	MsgTypeAdd         MsgType = 8
+	MsgTypeAddResp     MsgType = 9
+	MsgTypeDelete      MsgType = 10
+	MsgTypeDeleteResp  MsgType = 11
+	MsgTypeNotifyResp  MsgType = 16
+)
+
+type Message interface {
+	GetName() string
+	GetMsgType() MsgType
+	// the Body of a USP Msg carrying the message
+	toBody() *uspmsg.Body
+}
+
+// message sent by the controller, expecting a response from the agent
+type Request interface {
+	Message
+	ValidateResponse(Message) error
+}
+
+// request which may complete asynchronously, reporting its outcome with a notification
+type AsyncRequest interface {
+	Request
+	IsPending(resp Message) bool
+	Match(n Notify) bool
+}
+
+func ExpectMessage[T Message](m Message) error {
+	if _, ok := m.(T); !ok {
+		return fmt.Errorf("unexpected %s received", m.GetName())
+	}
+	return nil
+}
+
+// USP Msg: header plus body
+type Msg struct {
+	ID   string
+	Body Message
+}
+
+func (m Msg) Marshal() ([]byte, error) {
+	return proto.Marshal(&uspmsg.Msg{
+		Header: &uspmsg.Header{MsgId: m.ID, MsgType: uspmsg.Header_MsgType(m.Body.GetMsgType())},
+		Body:   m.Body.toBody(),
+	})
+}
+
+func ParseMsg(data []byte) (*Msg, error) {
+	var pb uspmsg.Msg
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, err
+	}
+	body, err := fromBody(pb.GetBody())
+	if err != nil {
+		return nil, err
+	}
+	if body.GetMsgType() != MsgType(pb.GetHeader().GetMsgType()) {
+		return nil, fmt.Errorf("USP message type %s does not match its %s body", pb.GetHeader().GetMsgType(), body.GetName())
+	}
+	return &Msg{ID: pb.GetHeader().GetMsgId(), Body: body}, nil
+}
+
+func requestBody(req *uspmsg.Request) *uspmsg.Body {
+	return &uspmsg.Body{MsgBody: &uspmsg.Body_Request{Request: req}}
+}
+
+func responseBody(resp *uspmsg.Response) *uspmsg.Body {
+	return &uspmsg.Body{MsgBody: &uspmsg.Body_Response{Response: resp}}
+}
+
+func fromBody(body *uspmsg.Body) (Message, error) {
+	switch b := body.GetMsgBody().(type) {
+	case *uspmsg.Body_Error:
+		return errorFromProto(b.Error), nil
+	case *uspmsg.Body_Request:
+		switch r := b.Request.GetReqType().(type) {
+		case *uspmsg.Request_Get:
+			return getFromProto(r.Get), nil
+		case *uspmsg.Request_Set:
+			return setFromProto(r.Set), nil
+		case *uspmsg.Request_Add:
+			return addFromProto(r.Add), nil
+		case *uspmsg.Request_Delete:
+			return deleteFromProto(r.Delete), nil
+		case *uspmsg.Request_Operate:
+			return operateFromProto(r.Operate), nil
+		case *uspmsg.Request_Notify:
+			return notifyFromProto(r.Notify), nil
+		}
+	case *uspmsg.Body_Response:
+		switch r := b.Response.GetRespType().(type) {
+		case *uspmsg.Response_GetResp:
+			return getRespFromProto(r.GetResp), nil
+		case *uspmsg.Response_SetResp:
+			return setRespFromProto(r.SetResp), nil
+		case *uspmsg.Response_AddResp:
+			return addRespFromProto(r.AddResp), nil
+		case *uspmsg.Response_DeleteResp:
+			return deleteRespFromProto(r.DeleteResp), nil
+		case *uspmsg.Response_OperateResp:
+			return operateRespFromProto(r.OperateResp), nil
+		case *uspmsg.Response_NotifyResp:
+			return notifyRespFromProto(r.NotifyResp), nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported USP message body")
+}
+
+// copy of a map<string, string> field; never nil
+func stringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return make(map[string]string)
+	}
+	return maps.Clone(m)
+}
@@ -0,0 +1,136 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/usp/messages"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func roundTrip(t *testing.T, body messages.Message) messages.Message {
+	data, err := messages.Msg{ID: "42", Body: body}.Marshal()
+	require.NoError(t, err)
+	msg, err := messages.ParseMsg(data)
+	require.NoError(t, err)
+	assert.Equal(t, "42", msg.ID)
+	return msg.Body
+}
+
+func TestRecordRoundTrip(t *testing.T) {
+	msg := messages.Msg{ID: "1", Body: messages.Get{ParamPaths: []configuration.TemplateField{configuration.T("Device.DeviceInfo.")}}}
+	sent, err := messages.NewRecord("os::012345-ABC", "self::corteca", msg)
+	require.NoError(t, err)
+	data, err := sent.Marshal()
+	require.NoError(t, err)
+	record, err := messages.ParseRecord(data)
+	require.NoError(t, err)
+	assert.Equal(t, messages.ProtocolVersion, record.Version)
+	assert.Equal(t, "os::012345-ABC", record.ToID)
+	assert.Equal(t, "self::corteca", record.FromID)
+	payload, err := messages.ParseMsg(record.Payload)
+	require.NoError(t, err)
+	assert.Equal(t, &msg, payload)
+
+	data, err = messages.Record{Version: "1.3", Disconnect: &messages.DisconnectRecord{Reason: "bye", ReasonCode: 7003}}.Marshal()
+	require.NoError(t, err)
+	record, err = messages.ParseRecord(data)
+	require.NoError(t, err)
+	assert.Equal(t, &messages.DisconnectRecord{Reason: "bye", ReasonCode: 7003}, record.Disconnect)
+	assert.Empty(t, record.Payload)
+}
+
+func TestParseMalformedMsg(t *testing.T) {
+	_, err := messages.ParseMsg([]byte{0x0A, 0x10, 0x01})
+	assert.Error(t, err)
+}
+
+func TestGetRoundTrip(t *testing.T) {
+	get := messages.Get{ParamPaths: []configuration.TemplateField{configuration.T("Device.DeviceInfo."), configuration.T("Device.Time.")}, MaxDepth: 2}
+	assert.Equal(t, get, roundTrip(t, get))
+
+	resp := messages.GetResp{ReqPathResults: []messages.RequestedPathResult{{
+		RequestedPath: "Device.DeviceInfo.",
+		ResolvedPathResults: []messages.ResolvedPathResult{{
+			ResolvedPath: "Device.DeviceInfo.",
+			ResultParams: map[string]string{"SerialNumber": "123", "SoftwareVersion": "1.0"},
+		}},
+	}}}
+	decoded := roundTrip(t, resp)
+	assert.Equal(t, resp, decoded)
+	assert.Equal(t, map[string]string{"Device.DeviceInfo.SerialNumber": "123", "Device.DeviceInfo.SoftwareVersion": "1.0"}, decoded.(messages.GetResp).Params())
+	assert.NoError(t, get.ValidateResponse(decoded))
+
+	failed := messages.GetResp{ReqPathResults: []messages.RequestedPathResult{{RequestedPath: "Device.Foo.", ErrCode: 7026, ErrMsg: "Invalid path"}}}
+	assert.Error(t, get.ValidateResponse(roundTrip(t, failed)))
+	assert.Error(t, get.ValidateResponse(messages.SetResp{}))
+}
+
+func TestSetRoundTrip(t *testing.T) {
+	set := messages.Set{UpdateObjs: []messages.ObjectSettings{{
+		ObjPath: configuration.T("Device.SoftwareModules.ExecutionUnit.1."),
+		ParamSettings: []messages.ParamSetting{
+			{Param: configuration.T("RequestedState"), Value: configuration.T("Active"), Required: true},
+		},
+	}}}
+	assert.Equal(t, set, roundTrip(t, set))
+
+	resp := messages.SetResp{UpdatedObjResults: []messages.UpdatedObjectResult{{
+		RequestedPath: "Device.SoftwareModules.ExecutionUnit.1.",
+		OperSuccess: []messages.UpdatedInstanceResult{{
+			AffectedPath:  "Device.SoftwareModules.ExecutionUnit.1.",
+			UpdatedParams: map[string]string{"RequestedState": "Active"},
+		}},
+	}}}
+	assert.Equal(t, resp, roundTrip(t, resp))
+	assert.NoError(t, set.ValidateResponse(resp))
+
+	failed := messages.SetResp{UpdatedObjResults: []messages.UpdatedObjectResult{{
+		RequestedPath: "Device.SoftwareModules.ExecutionUnit.1.",
+		OperFailure:   &messages.OperationFailure{ErrCode: 7012, ErrMsg: "Invalid value"},
+	}}}
+	decoded := roundTrip(t, failed)
+	assert.Equal(t, failed, decoded)
+	assert.Error(t, set.ValidateResponse(decoded))
+}
+
+func TestAddDeleteRoundTrip(t *testing.T) {
+	add := messages.Add{AllowPartial: true, CreateObjs: []messages.ObjectSettings{{
+		ObjPath:       configuration.T("Device.LocalAgent.Subscription."),
+		ParamSettings: []messages.ParamSetting{{Param: configuration.T("Enable"), Value: configuration.T("true")}},
+	}}}
+	assert.Equal(t, add, roundTrip(t, add))
+
+	addResp := messages.AddResp{CreatedObjResults: []messages.CreatedObjectResult{{
+		RequestedPath:    "Device.LocalAgent.Subscription.",
+		InstantiatedPath: "Device.LocalAgent.Subscription.3.",
+		UniqueKeys:       map[string]string{"ID": "sub"},
+	}}}
+	assert.Equal(t, addResp, roundTrip(t, addResp))
+	assert.NoError(t, add.ValidateResponse(addResp))
+
+	del := messages.Delete{ObjPaths: []configuration.TemplateField{configuration.T("Device.LocalAgent.Subscription.3.")}}
+	assert.Equal(t, del, roundTrip(t, del))
+
+	delResp := messages.DeleteResp{DeletedObjResults: []messages.DeletedObjectResult{{
+		RequestedPath: "Device.LocalAgent.Subscription.3.",
+		AffectedPaths: []string{"Device.LocalAgent.Subscription.3."},
+	}}}
+	assert.Equal(t, delResp, roundTrip(t, delResp))
+	assert.NoError(t, del.ValidateResponse(delResp))
+
+	delResp.DeletedObjResults[0].UnaffectedPathErrs = []messages.UnaffectedPathErr{{UnaffectedPath: "Device.LocalAgent.Subscription.3.", ErrCode: 7018, ErrMsg: "Object could not be deleted"}}
+	assert.Error(t, del.ValidateResponse(roundTrip(t, delResp)))
+}
+
+func TestErrorRoundTrip(t *testing.T) {
+	msg := messages.Error{ErrCode: 7004, ErrMsg: "Invalid arguments", ParamErrs: []messages.ParamError{{ParamPath: "Device.Foo", ErrCode: 7026, ErrMsg: "Invalid path"}}}
+	decoded := roundTrip(t, msg)
+	assert.Equal(t, msg, decoded)
+	assert.ErrorContains(t, decoded.(messages.Error), "Invalid arguments (err_code: 7004)")
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/device/usp/messages/proto/uspmsg"
+)
+
+// notification sent by the agent; exactly one of the notification pointers is set
+type Notify struct {
+	SubscriptionID string             `yaml:"subscription_id"`
+	SendResp       bool               `yaml:"send_resp"`
+	Event          *Event             `yaml:"event,omitempty"`
+	ValueChange    *ValueChange       `yaml:"value_change,omitempty"`
+	ObjCreation    *ObjectCreation    `yaml:"obj_creation,omitempty"`
+	ObjDeletion    *ObjectDeletion    `yaml:"obj_deletion,omitempty"`
+	OperComplete   *OperationComplete `yaml:"oper_complete,omitempty"`
+	OnBoardReq     *OnBoardRequest    `yaml:"on_board_req,omitempty"`
+}
+
+type Event struct {
+	ObjPath   string            `yaml:"obj_path"`
+	EventName string            `yaml:"event_name"`
+	Params    map[string]string `yaml:"params,omitempty"`
+}
+
+type ValueChange struct {
+	ParamPath  string `yaml:"param_path"`
+	ParamValue string `yaml:"param_value"`
+}
+
+type ObjectCreation struct {
+	ObjPath    string            `yaml:"obj_path"`
+	UniqueKeys map[string]string `yaml:"unique_keys,omitempty"`
+}
+
+type ObjectDeletion struct {
+	ObjPath string `yaml:"obj_path"`
+}
+
+type OperationComplete struct {
+	ObjPath       string            `yaml:"obj_path"`
+	CommandName   string            `yaml:"command_name"`
+	CommandKey    string            `yaml:"command_key"`
+	ReqOutputArgs map[string]string `yaml:"req_output_args,omitempty"`
+	CmdFailure    *CommandFailure   `yaml:"cmd_failure,omitempty"`
+}
+
+type OnBoardRequest struct {
+	OUI                            string `yaml:"oui"`
+	ProductClass                   string `yaml:"product_class"`
+	SerialNumber                   string `yaml:"serial_number"`
+	AgentSupportedProtocolVersions string `yaml:"agent_supported_protocol_versions"`
+}
+
+func (m Notify) GetName() string     { return "Notify" }
+func (m Notify) GetMsgType() MsgType { return MsgTypeNotify }
+
+func (m Notify) toBody() *uspmsg.Body {
+	pb := &uspmsg.Notify{SubscriptionId: m.SubscriptionID, SendResp: m.SendResp}
+	switch {
+	case m.Event != nil:
+		pb.Notification = &uspmsg.Notify_Event_{Event: &uspmsg.Notify_Event{
+			ObjPath:   m.Event.ObjPath,
+			EventName: m.Event.EventName,
+			Params:    m.Event.Params,
+		}}
+	case m.ValueChange != nil:
+		pb.Notification = &uspmsg.Notify_ValueChange_{ValueChange: &uspmsg.Notify_ValueChange{
+			ParamPath:  m.ValueChange.ParamPath,
+			ParamValue: m.ValueChange.ParamValue,
+		}}
+	case m.ObjCreation != nil:
+		pb.Notification = &uspmsg.Notify_ObjCreation{ObjCreation: &uspmsg.Notify_ObjectCreation{
+			ObjPath:    m.ObjCreation.ObjPath,
+			UniqueKeys: m.ObjCreation.UniqueKeys,
+		}}
+	case m.ObjDeletion != nil:
+		pb.Notification = &uspmsg.Notify_ObjDeletion{ObjDeletion: &uspmsg.Notify_ObjectDeletion{ObjPath: m.ObjDeletion.ObjPath}}
+	case m.OperComplete != nil:
+		c := m.OperComplete
+		oc := &uspmsg.Notify_OperationComplete{ObjPath: c.ObjPath, CommandName: c.CommandName, CommandKey: c.CommandKey}
+		if c.CmdFailure != nil {
+			oc.OperationResp = &uspmsg.Notify_OperationComplete_CmdFailure{
+				CmdFailure: &uspmsg.Notify_OperationComplete_CommandFailure{ErrCode: c.CmdFailure.ErrCode, ErrMsg: c.CmdFailure.ErrMsg},
+			}
+		} else {
+			oc.OperationResp = &uspmsg.Notify_OperationComplete_ReqOutputArgs{
+				ReqOutputArgs: &uspmsg.Notify_OperationComplete_OutputArgs{OutputArgs: c.ReqOutputArgs},
+			}
+		}
+		pb.Notification = &uspmsg.Notify_OperComplete{OperComplete: oc}
+	case m.OnBoardReq != nil:
+		pb.Notification = &uspmsg.Notify_OnBoardReq{OnBoardReq: &uspmsg.Notify_OnBoardRequest{
+			Oui:                            m.OnBoardReq.OUI,
+			ProductClass:                   m.OnBoardReq.ProductClass,
+			SerialNumber:                   m.OnBoardReq.SerialNumber,
+			AgentSupportedProtocolVersions: m.OnBoardReq.AgentSupportedProtocolVersions,
+		}}
+	}
+	return requestBody(&uspmsg.Request{ReqType: &uspmsg.Request_Notify{Notify: pb}})
+}
+
+func notifyFromProto(pb *uspmsg.Notify) Notify {
+	m := Notify{SubscriptionID: pb.GetSubscriptionId(), SendResp: pb.GetSendResp()}
+	if ev := pb.GetEvent(); ev != nil {
+		m.Event = &Event{ObjPath: ev.GetObjPath(), EventName: ev.GetEventName(), Params: stringMap(ev.GetParams())}
+	}
+	if vc := pb.GetValueChange(); vc != nil {
+		m.ValueChange = &ValueChange{ParamPath: vc.GetParamPath(), ParamValue: vc.GetParamValue()}
+	}
+	if oc := pb.GetObjCreation(); oc != nil {
+		m.ObjCreation = &ObjectCreation{ObjPath: oc.GetObjPath(), UniqueKeys: stringMap(oc.GetUniqueKeys())}
+	}
+	if od := pb.GetObjDeletion(); od != nil {
+		m.ObjDeletion = &ObjectDeletion{ObjPath: od.GetObjPath()}
+	}
+	if c := pb.GetOperComplete(); c != nil {
+		m.OperComplete = &OperationComplete{ObjPath: c.GetObjPath(), CommandName: c.GetCommandName(), CommandKey: c.GetCommandKey()}
+		if args := c.GetReqOutputArgs(); args != nil {
+			m.OperComplete.ReqOutputArgs = stringMap(args.GetOutputArgs())
+		}
+		if f := c.GetCmdFailure(); f != nil {
+			m.OperComplete.CmdFailure = &CommandFailure{ErrCode: f.GetErrCode(), ErrMsg: f.GetErrMsg()}
+		}
+	}
+	if ob := pb.GetOnBoardReq(); ob != nil {
+		m.OnBoardReq = &OnBoardRequest{
+			OUI:                            ob.GetOui(),
+			ProductClass:                   ob.GetProductClass(),
+			SerialNumber:                   ob.GetSerialNumber(),
+			AgentSupportedProtocolVersions: ob.GetAgentSupportedProtocolVersions(),
+		}
+	}
+	return m
+}
+
+type NotifyResp struct {
+	SubscriptionID string `yaml:"subscription_id"`
+}
+
+func (m NotifyResp) GetName() string     { return "NotifyResp" }
+func (m NotifyResp) GetMsgType() MsgType { return MsgTypeNotifyResp }
+
+func (m NotifyResp) toBody() *uspmsg.Body {
+	return responseBody(&uspmsg.Response{RespType: &uspmsg.Response_NotifyResp{
+		NotifyResp: &uspmsg.NotifyResp{SubscriptionId: m.SubscriptionID},
+	}})
+}
+
+func notifyRespFromProto(pb *uspmsg.NotifyResp) NotifyResp {
+	return NotifyResp{SubscriptionID: pb.GetSubscriptionId()}
+}
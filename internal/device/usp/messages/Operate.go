@@ -0,0 +1,141 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/usp/messages/proto/uspmsg"
+	"fmt"
+)
+
+type Operate struct {
+	Command    configuration.TemplateField            `yaml:"command"`
+	CommandKey configuration.TemplateField            `yaml:"command_key,omitempty"`
+	SendResp   bool                                   `yaml:"-"`
+	InputArgs  map[string]configuration.TemplateField `yaml:"input_args,omitempty"`
+}
+
+func (m Operate) GetName() string     { return "Operate" }
+func (m Operate) GetMsgType() MsgType { return MsgTypeOperate }
+
+// accepts either the OperateResp or the OperationComplete notification of an asynchronous command
+func (m Operate) ValidateResponse(resp Message) error {
+	switch r := resp.(type) {
+	case OperateResp:
+		for _, res := range r.OperationResults {
+			if f := res.CmdFailure; f != nil {
+				return fmt.Errorf("command '%s' failed: %s (err_code: %d)", res.ExecutedCommand, f.ErrMsg, f.ErrCode)
+			}
+		}
+		return nil
+	case Notify:
+		if r.OperComplete == nil {
+			return fmt.Errorf("unexpected notification received")
+		}
+		if f := r.OperComplete.CmdFailure; f != nil {
+			return fmt.Errorf("command '%s' failed: %s (err_code: %d)", r.OperComplete.ObjPath+r.OperComplete.CommandName, f.ErrMsg, f.ErrCode)
+		}
+		return nil
+	}
+	return ExpectMessage[OperateResp](resp)
+}
+
+// asynchronous commands (e.g. InstallDU()) respond with the path of the Request object tracking them
+func (m Operate) IsPending(resp Message) bool {
+	if r, ok := resp.(OperateResp); ok {
+		for _, res := range r.OperationResults {
+			if len(res.ReqObjPath) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (m Operate) Match(n Notify) bool {
+	c := n.OperComplete
+	return c != nil && c.CommandKey == m.CommandKey.String() && c.ObjPath+c.CommandName == m.Command.String()
+}
+
+func (m Operate) toBody() *uspmsg.Body {
+	pb := &uspmsg.Operate{Command: m.Command.String(), CommandKey: m.CommandKey.String(), SendResp: m.SendResp}
+	if len(m.InputArgs) > 0 {
+		pb.InputArgs = make(map[string]string, len(m.InputArgs))
+		for name, value := range m.InputArgs {
+			pb.InputArgs[name] = value.String()
+		}
+	}
+	return requestBody(&uspmsg.Request{ReqType: &uspmsg.Request_Operate{Operate: pb}})
+}
+
+func operateFromProto(pb *uspmsg.Operate) Operate {
+	m := Operate{
+		Command:    configuration.T(pb.GetCommand()),
+		CommandKey: configuration.T(pb.GetCommandKey()),
+		SendResp:   pb.GetSendResp(),
+	}
+	if args := pb.GetInputArgs(); len(args) > 0 {
+		m.InputArgs = make(map[string]configuration.TemplateField, len(args))
+		for name, value := range args {
+			m.InputArgs[name] = configuration.T(value)
+		}
+	}
+	return m
+}
+
+type OperateResp struct {
+	OperationResults []OperationResult `yaml:"operation_results"`
+}
+
+type OperationResult struct {
+	ExecutedCommand string            `yaml:"executed_command"`
+	ReqObjPath      string            `yaml:"req_obj_path,omitempty"`
+	ReqOutputArgs   map[string]string `yaml:"req_output_args,omitempty"`
+	CmdFailure      *CommandFailure   `yaml:"cmd_failure,omitempty"`
+}
+
+type CommandFailure struct {
+	ErrCode uint32 `yaml:"err_code"`
+	ErrMsg  string `yaml:"err_msg"`
+}
+
+func (m OperateResp) GetName() string     { return "OperateResp" }
+func (m OperateResp) GetMsgType() MsgType { return MsgTypeOperateResp }
+
+func (m OperateResp) toBody() *uspmsg.Body {
+	pb := &uspmsg.OperateResp{}
+	for _, res := range m.OperationResults {
+		r := &uspmsg.OperateResp_OperationResult{ExecutedCommand: res.ExecutedCommand}
+		switch {
+		case res.CmdFailure != nil:
+			r.OperationResp = &uspmsg.OperateResp_OperationResult_CmdFailure{
+				CmdFailure: &uspmsg.OperateResp_OperationResult_CommandFailure{ErrCode: res.CmdFailure.ErrCode, ErrMsg: res.CmdFailure.ErrMsg},
+			}
+		case len(res.ReqObjPath) > 0:
+			r.OperationResp = &uspmsg.OperateResp_OperationResult_ReqObjPath{ReqObjPath: res.ReqObjPath}
+		default:
+			r.OperationResp = &uspmsg.OperateResp_OperationResult_ReqOutputArgs{
+				ReqOutputArgs: &uspmsg.OperateResp_OperationResult_OutputArgs{OutputArgs: res.ReqOutputArgs},
+			}
+		}
+		pb.OperationResults = append(pb.OperationResults, r)
+	}
+	return responseBody(&uspmsg.Response{RespType: &uspmsg.Response_OperateResp{OperateResp: pb}})
+}
+
+func operateRespFromProto(pb *uspmsg.OperateResp) OperateResp {
+	m := OperateResp{}
+	for _, r := range pb.GetOperationResults() {
+		res := OperationResult{ExecutedCommand: r.GetExecutedCommand(), ReqObjPath: r.GetReqObjPath()}
+		if args := r.GetReqOutputArgs(); args != nil {
+			res.ReqOutputArgs = stringMap(args.GetOutputArgs())
+		}
+		if f := r.GetCmdFailure(); f != nil {
+			res.CmdFailure = &CommandFailure{ErrCode: f.GetErrCode(), ErrMsg: f.GetErrMsg()}
+		}
+		m.OperationResults = append(m.OperationResults, res)
+	}
+	return m
+}
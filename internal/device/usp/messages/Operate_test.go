@@ -0,0 +1,106 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages_test
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/usp/messages"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const OperateInputYAML = `command: Device.SoftwareModules.InstallDU()
+command_key: install-app
+input_args:
+    URL: http://example.com/app.tar.gz
+    ExecutionEnvRef: Device.SoftwareModules.ExecEnv.1.
+`
+
+var OperateInputMsg = messages.Operate{
+	Command:    configuration.T("Device.SoftwareModules.InstallDU()"),
+	CommandKey: configuration.T("install-app"),
+	InputArgs: map[string]configuration.TemplateField{
+		"URL":             configuration.T("http://example.com/app.tar.gz"),
+		"ExecutionEnvRef": configuration.T("Device.SoftwareModules.ExecEnv.1."),
+	},
+}
+
+func TestOperateParseFromYAML(t *testing.T) {
+	var msg messages.Operate
+	require.NoError(t, yaml.Unmarshal([]byte(OperateInputYAML), &msg))
+	assert.Equal(t, OperateInputMsg, msg)
+}
+
+func TestOperateRoundTrip(t *testing.T) {
+	msg := OperateInputMsg
+	msg.SendResp = true
+	assert.Equal(t, msg, roundTrip(t, msg))
+}
+
+func TestOperateAsyncCompletion(t *testing.T) {
+	msg := OperateInputMsg
+	pending := messages.OperateResp{OperationResults: []messages.OperationResult{{
+		ExecutedCommand: "Device.SoftwareModules.InstallDU()",
+		ReqObjPath:      "Device.LocalAgent.Request.1.",
+	}}}
+	decoded := roundTrip(t, pending)
+	assert.Equal(t, pending, decoded)
+	assert.NoError(t, msg.ValidateResponse(decoded))
+	assert.True(t, msg.IsPending(decoded))
+
+	notif := messages.Notify{SubscriptionID: "corteca-1", SendResp: true, OperComplete: &messages.OperationComplete{
+		ObjPath:       "Device.SoftwareModules.",
+		CommandName:   "InstallDU()",
+		CommandKey:    "install-app",
+		ReqOutputArgs: map[string]string{"DeploymentUnitRef": "Device.SoftwareModules.DeploymentUnit.1."},
+	}}
+	decodedNotif := roundTrip(t, notif).(messages.Notify)
+	assert.Equal(t, notif, decodedNotif)
+	assert.True(t, msg.Match(decodedNotif))
+	assert.NoError(t, msg.ValidateResponse(decodedNotif))
+
+	other := notif
+	other.OperComplete = &messages.OperationComplete{ObjPath: "Device.SoftwareModules.", CommandName: "InstallDU()", CommandKey: "other"}
+	assert.False(t, msg.Match(other))
+
+	failed := notif
+	failed.OperComplete = &messages.OperationComplete{
+		ObjPath:     "Device.SoftwareModules.",
+		CommandName: "InstallDU()",
+		CommandKey:  "install-app",
+		CmdFailure:  &messages.CommandFailure{ErrCode: 7228, ErrMsg: "Download failed"},
+	}
+	assert.Error(t, msg.ValidateResponse(roundTrip(t, failed)))
+}
+
+func TestOperateSyncCompletion(t *testing.T) {
+	msg := messages.Operate{Command: configuration.T("Device.Reboot()")}
+	resp := messages.OperateResp{OperationResults: []messages.OperationResult{{
+		ExecutedCommand: "Device.Reboot()",
+		ReqOutputArgs:   map[string]string{},
+	}}}
+	decoded := roundTrip(t, resp)
+	assert.Equal(t, resp, decoded)
+	assert.NoError(t, msg.ValidateResponse(decoded))
+	assert.False(t, msg.IsPending(decoded))
+}
+
+func TestNotifyRoundTrip(t *testing.T) {
+	notifs := []messages.Notify{
+		{SubscriptionID: "a", Event: &messages.Event{ObjPath: "Device.", EventName: "Boot!", Params: map[string]string{"Cause": "LocalReboot"}}},
+		{SubscriptionID: "b", ValueChange: &messages.ValueChange{ParamPath: "Device.WiFi.SSID.1.SSID", ParamValue: "home"}},
+		{SubscriptionID: "c", ObjCreation: &messages.ObjectCreation{ObjPath: "Device.Hosts.Host.3.", UniqueKeys: map[string]string{}}},
+		{SubscriptionID: "d", ObjDeletion: &messages.ObjectDeletion{ObjPath: "Device.Hosts.Host.3."}},
+		{SubscriptionID: "e", OnBoardReq: &messages.OnBoardRequest{OUI: "ABCDEF", ProductClass: "Router", SerialNumber: "123", AgentSupportedProtocolVersions: "1.3"}},
+	}
+	for _, notif := range notifs {
+		assert.Equal(t, notif, roundTrip(t, notif))
+	}
+	resp := messages.NotifyResp{SubscriptionID: "a"}
+	assert.Equal(t, resp, roundTrip(t, resp))
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/device/usp/messages/proto/usprecord"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+const ProtocolVersion = "1.3"
+
+// USP Record; only plaintext, non-session-context records are supported
+type Record struct {
+	Version          string
+	ToID             string
+	FromID           string
+	Payload          []byte
+	WebSocketConnect bool
+	Disconnect       *DisconnectRecord
+}
+
+type DisconnectRecord struct {
+	Reason     string
+	ReasonCode uint32
+}
+
+func NewRecord(toID, fromID string, msg Msg) (Record, error) {
+	payload, err := msg.Marshal()
+	return Record{Version: ProtocolVersion, ToID: toID, FromID: fromID, Payload: payload}, err
+}
+
+func (r Record) Marshal() ([]byte, error) {
+	pb := &usprecord.Record{Version: r.Version, ToId: r.ToID, FromId: r.FromID}
+	switch {
+	case r.Disconnect != nil:
+		pb.RecordType = &usprecord.Record_Disconnect{Disconnect: &usprecord.DisconnectRecord{
+			Reason:     r.Disconnect.Reason,
+			ReasonCode: r.Disconnect.ReasonCode,
+		}}
+	case r.WebSocketConnect:
+		pb.RecordType = &usprecord.Record_WebsocketConnect{WebsocketConnect: &usprecord.WebSocketConnectRecord{}}
+	default:
+		pb.RecordType = &usprecord.Record_NoSessionContext{NoSessionContext: &usprecord.NoSessionContextRecord{Payload: r.Payload}}
+	}
+	return proto.Marshal(pb)
+}
+
+func ParseRecord(data []byte) (*Record, error) {
+	var pb usprecord.Record
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, err
+	}
+	if pb.GetPayloadSecurity() != usprecord.Record_PLAINTEXT {
+		return nil, fmt.Errorf("unsupported payload security")
+	}
+	r := Record{Version: pb.GetVersion(), ToID: pb.GetToId(), FromID: pb.GetFromId()}
+	switch rt := pb.GetRecordType().(type) {
+	case *usprecord.Record_NoSessionContext:
+		r.Payload = rt.NoSessionContext.GetPayload()
+	case *usprecord.Record_SessionContext:
+		return nil, fmt.Errorf("session context records are not supported")
+	case *usprecord.Record_WebsocketConnect:
+		r.WebSocketConnect = true
+	case *usprecord.Record_Disconnect:
+		r.Disconnect = &DisconnectRecord{Reason: rt.Disconnect.GetReason(), ReasonCode: rt.Disconnect.GetReasonCode()}
+	}
+	return &r, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package messages
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device/usp/messages/proto/uspmsg"
+	"fmt"
+)
+
+type Set struct {
+	AllowPartial bool             `yaml:"allow_partial,omitempty"`
+	UpdateObjs   []ObjectSettings `yaml:"update_objs"`
+}
+
+// object path along with the parameters to set on it; used by Set and Add
+type ObjectSettings struct {
+	ObjPath       configuration.TemplateField `yaml:"obj_path"`
+	ParamSettings []ParamSetting              `yaml:"param_settings"`
+}
+
+type ParamSetting struct {
+	Param    configuration.TemplateField `yaml:"param"`
+	Value    configuration.TemplateField `yaml:"value"`
+	Required bool                        `yaml:"required,omitempty"`
+}
+
+func (m Set) GetName() string     { return "Set" }
+func (m Set) GetMsgType() MsgType { return MsgTypeSet }
+func (m Set) ValidateResponse(resp Message) error {
+	if r, ok := resp.(SetResp); ok {
+		for _, res := range r.UpdatedObjResults {
+			if f := res.OperFailure; f != nil {
+				return fmt.Errorf("failed to set '%s': %s (err_code: %d)", res.RequestedPath, f.ErrMsg, f.ErrCode)
+			}
+		}
+		return nil
+	}
+	return ExpectMessage[SetResp](resp)
+}
+
+func (m Set) toBody() *uspmsg.Body {
+	pb := &uspmsg.Set{AllowPartial: m.AllowPartial}
+	for _, obj := range m.UpdateObjs {
+		o := &uspmsg.Set_UpdateObject{ObjPath: obj.ObjPath.String()}
+		for _, p := range obj.ParamSettings {
+			o.ParamSettings = append(o.ParamSettings, &uspmsg.Set_UpdateParamSetting{Param: p.Param.String(), Value: p.Value.String(), Required: p.Required})
+		}
+		pb.UpdateObjs = append(pb.UpdateObjs, o)
+	}
+	return requestBody(&uspmsg.Request{ReqType: &uspmsg.Request_Set{Set: pb}})
+}
+
+func setFromProto(pb *uspmsg.Set) Set {
+	m := Set{AllowPartial: pb.GetAllowPartial()}
+	for _, o := range pb.GetUpdateObjs() {
+		obj := ObjectSettings{ObjPath: configuration.T(o.GetObjPath())}
+		for _, p := range o.GetParamSettings() {
+			obj.ParamSettings = append(obj.ParamSettings, ParamSetting{Param: configuration.T(p.GetParam()), Value: configuration.T(p.GetValue()), Required: p.GetRequired()})
+		}
+		m.UpdateObjs = append(m.UpdateObjs, obj)
+	}
+	return m
+}
+
+type SetResp struct {
+	UpdatedObjResults []UpdatedObjectResult `yaml:"updated_obj_results"`
+}
+
+type UpdatedObjectResult struct {
+	RequestedPath string                  `yaml:"requested_path"`
+	OperFailure   *OperationFailure       `yaml:"oper_failure,omitempty"`
+	OperSuccess   []UpdatedInstanceResult `yaml:"updated_inst_results,omitempty"`
+}
+
+type UpdatedInstanceResult struct {
+	AffectedPath  string            `yaml:"affected_path"`
+	ParamErrs     []ParameterError  `yaml:"param_errs,omitempty"`
+	UpdatedParams map[string]string `yaml:"updated_params"`
+}
+
+func (m SetResp) GetName() string     { return "SetResp" }
+func (m SetResp) GetMsgType() MsgType { return MsgTypeSetResp }
+
+func (m SetResp) toBody() *uspmsg.Body {
+	pb := &uspmsg.SetResp{}
+	for _, res := range m.UpdatedObjResults {
+		status := &uspmsg.SetResp_UpdatedObjectResult_OperationStatus{}
+		if f := res.OperFailure; f != nil {
+			status.OperStatus = &uspmsg.SetResp_UpdatedObjectResult_OperationStatus_OperFailure{
+				OperFailure: &uspmsg.SetResp_UpdatedObjectResult_OperationStatus_OperationFailure{ErrCode: f.ErrCode, ErrMsg: f.ErrMsg},
+			}
+		} else {
+			success := &uspmsg.SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess{}
+			for _, inst := range res.OperSuccess {
+				i := &uspmsg.SetResp_UpdatedInstanceResult{AffectedPath: inst.AffectedPath, UpdatedParams: inst.UpdatedParams}
+				for _, p := range inst.ParamErrs {
+					i.ParamErrs = append(i.ParamErrs, &uspmsg.SetResp_ParameterError{Param: p.Param, ErrCode: p.ErrCode, ErrMsg: p.ErrMsg})
+				}
+				success.UpdatedInstResults = append(success.UpdatedInstResults, i)
+			}
+			status.OperStatus = &uspmsg.SetResp_UpdatedObjectResult_OperationStatus_OperSuccess{OperSuccess: success}
+		}
+		pb.UpdatedObjResults = append(pb.UpdatedObjResults, &uspmsg.SetResp_UpdatedObjectResult{RequestedPath: res.RequestedPath, OperStatus: status})
+	}
+	return responseBody(&uspmsg.Response{RespType: &uspmsg.Response_SetResp{SetResp: pb}})
+}
+
+func setRespFromProto(pb *uspmsg.SetResp) SetResp {
+	m := SetResp{}
+	for _, r := range pb.GetUpdatedObjResults() {
+		res := UpdatedObjectResult{RequestedPath: r.GetRequestedPath()}
+		status := r.GetOperStatus()
+		if f := status.GetOperFailure(); f != nil {
+			res.OperFailure = &OperationFailure{ErrCode: f.GetErrCode(), ErrMsg: f.GetErrMsg()}
+		}
+		for _, i := range status.GetOperSuccess().GetUpdatedInstResults() {
+			inst := UpdatedInstanceResult{AffectedPath: i.GetAffectedPath(), UpdatedParams: stringMap(i.GetUpdatedParams())}
+			for _, p := range i.GetParamErrs() {
+				inst.ParamErrs = append(inst.ParamErrs, ParameterError{Param: p.GetParam(), ErrCode: p.GetErrCode(), ErrMsg: p.GetErrMsg()})
+			}
+			res.OperSuccess = append(res.OperSuccess, inst)
+		}
+		m.UpdatedObjResults = append(m.UpdatedObjResults, res)
+	}
+	return m
+}
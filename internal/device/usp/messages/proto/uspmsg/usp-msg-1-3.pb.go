@@ -0,0 +1,6674 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.0
+// 	protoc        (unknown)
+// source: usp-msg-1-3.proto
+
+//**************************************************************************
+// TR-369 USP Message Protocol Buffer Schema
+//
+//  Copyright (c) 2017-2023, Broadband Forum
+//
+//  Redistribution and use in source and binary forms, with or
+//  without modification, are permitted provided that the following
+//  conditions are met:
+//
+//  1. Redistributions of source code must retain the above copyright
+//     notice, this list of conditions and the following disclaimer.
+//
+//  2. Redistributions in binary form must reproduce the above
+//     copyright notice, this list of conditions and the following
+//     disclaimer in the documentation and/or other materials
+//     provided with the distribution.
+//
+//  3. Neither the name of the copyright holder nor the names of its
+//     contributors may be used to endorse or promote products
+//     derived from this software without specific prior written
+//     permission.
+//
+//  THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS AND
+//  CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+//  INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF
+//  MERCHANTABILITY AND FITNESS FOR A PARTICULAR PURPOSE ARE
+//  DISCLAIMED. IN NO EVENT SHALL THE COPYRIGHT HOLDER OR
+//  CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT, INCIDENTAL,
+//  SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING, BUT NOT
+//  LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS OF
+//  USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED
+//  AND ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT
+//  LIABILITY, OR TORT (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING
+//  IN ANY WAY OUT OF THE USE OF THIS SOFTWARE, EVEN IF ADVISED OF THE
+//  POSSIBILITY OF SUCH DAMAGE.
+//
+//  The above license is used as a license under copyright only.
+//  Please reference the Forum IPR Policy for patent licensing terms
+//  <https://www.broadband-forum.org/ipr-policy>.
+//
+//  Any moral rights which are necessary to exercise under the above
+//  license grant are also deemed granted under this license.
+//
+//  BBF software release registry:
+//  https://www.broadband-forum.org/software-releases
+//**************************************************************************
+
+package uspmsg
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Header_MsgType int32
+
+const (
+	Header_ERROR                    Header_MsgType = 0
+	Header_GET                      Header_MsgType = 1
+	Header_GET_RESP                 Header_MsgType = 2
+	Header_NOTIFY                   Header_MsgType = 3
+	Header_SET                      Header_MsgType = 4
+	Header_SET_RESP                 Header_MsgType = 5
+	Header_OPERATE                  Header_MsgType = 6
+	Header_OPERATE_RESP             Header_MsgType = 7
+	Header_ADD                      Header_MsgType = 8
+	Header_ADD_RESP                 Header_MsgType = 9
+	Header_DELETE                   Header_MsgType = 10
+	Header_DELETE_RESP              Header_MsgType = 11
+	Header_GET_SUPPORTED_DM         Header_MsgType = 12
+	Header_GET_SUPPORTED_DM_RESP    Header_MsgType = 13
+	Header_GET_INSTANCES            Header_MsgType = 14
+	Header_GET_INSTANCES_RESP       Header_MsgType = 15
+	Header_NOTIFY_RESP              Header_MsgType = 16
+	Header_GET_SUPPORTED_PROTO      Header_MsgType = 17
+	Header_GET_SUPPORTED_PROTO_RESP Header_MsgType = 18
+	Header_REGISTER                 Header_MsgType = 19
+	Header_REGISTER_RESP            Header_MsgType = 20
+	Header_DEREGISTER               Header_MsgType = 21
+	Header_DEREGISTER_RESP          Header_MsgType = 22
+)
+
+// Enum value maps for Header_MsgType.
+var (
+	Header_MsgType_name = map[int32]string{
+		0:  "ERROR",
+		1:  "GET",
+		2:  "GET_RESP",
+		3:  "NOTIFY",
+		4:  "SET",
+		5:  "SET_RESP",
+		6:  "OPERATE",
+		7:  "OPERATE_RESP",
+		8:  "ADD",
+		9:  "ADD_RESP",
+		10: "DELETE",
+		11: "DELETE_RESP",
+		12: "GET_SUPPORTED_DM",
+		13: "GET_SUPPORTED_DM_RESP",
+		14: "GET_INSTANCES",
+		15: "GET_INSTANCES_RESP",
+		16: "NOTIFY_RESP",
+		17: "GET_SUPPORTED_PROTO",
+		18: "GET_SUPPORTED_PROTO_RESP",
+		19: "REGISTER",
+		20: "REGISTER_RESP",
+		21: "DEREGISTER",
+		22: "DEREGISTER_RESP",
+	}
+	Header_MsgType_value = map[string]int32{
+		"ERROR":                    0,
+		"GET":                      1,
+		"GET_RESP":                 2,
+		"NOTIFY":                   3,
+		"SET":                      4,
+		"SET_RESP":                 5,
+		"OPERATE":                  6,
+		"OPERATE_RESP":             7,
+		"ADD":                      8,
+		"ADD_RESP":                 9,
+		"DELETE":                   10,
+		"DELETE_RESP":              11,
+		"GET_SUPPORTED_DM":         12,
+		"GET_SUPPORTED_DM_RESP":    13,
+		"GET_INSTANCES":            14,
+		"GET_INSTANCES_RESP":       15,
+		"NOTIFY_RESP":              16,
+		"GET_SUPPORTED_PROTO":      17,
+		"GET_SUPPORTED_PROTO_RESP": 18,
+		"REGISTER":                 19,
+		"REGISTER_RESP":            20,
+		"DEREGISTER":               21,
+		"DEREGISTER_RESP":          22,
+	}
+)
+
+func (x Header_MsgType) Enum() *Header_MsgType {
+	p := new(Header_MsgType)
+	*p = x
+	return p
+}
+
+func (x Header_MsgType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Header_MsgType) Descriptor() protoreflect.EnumDescriptor {
+	return file_usp_msg_1_3_proto_enumTypes[0].Descriptor()
+}
+
+func (Header_MsgType) Type() protoreflect.EnumType {
+	return &file_usp_msg_1_3_proto_enumTypes[0]
+}
+
+func (x Header_MsgType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Header_MsgType.Descriptor instead.
+func (Header_MsgType) EnumDescriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type GetSupportedDMResp_ParamAccessType int32
+
+const (
+	GetSupportedDMResp_PARAM_READ_ONLY  GetSupportedDMResp_ParamAccessType = 0
+	GetSupportedDMResp_PARAM_READ_WRITE GetSupportedDMResp_ParamAccessType = 1
+	GetSupportedDMResp_PARAM_WRITE_ONLY GetSupportedDMResp_ParamAccessType = 2
+)
+
+// Enum value maps for GetSupportedDMResp_ParamAccessType.
+var (
+	GetSupportedDMResp_ParamAccessType_name = map[int32]string{
+		0: "PARAM_READ_ONLY",
+		1: "PARAM_READ_WRITE",
+		2: "PARAM_WRITE_ONLY",
+	}
+	GetSupportedDMResp_ParamAccessType_value = map[string]int32{
+		"PARAM_READ_ONLY":  0,
+		"PARAM_READ_WRITE": 1,
+		"PARAM_WRITE_ONLY": 2,
+	}
+)
+
+func (x GetSupportedDMResp_ParamAccessType) Enum() *GetSupportedDMResp_ParamAccessType {
+	p := new(GetSupportedDMResp_ParamAccessType)
+	*p = x
+	return p
+}
+
+func (x GetSupportedDMResp_ParamAccessType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetSupportedDMResp_ParamAccessType) Descriptor() protoreflect.EnumDescriptor {
+	return file_usp_msg_1_3_proto_enumTypes[1].Descriptor()
+}
+
+func (GetSupportedDMResp_ParamAccessType) Type() protoreflect.EnumType {
+	return &file_usp_msg_1_3_proto_enumTypes[1]
+}
+
+func (x GetSupportedDMResp_ParamAccessType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetSupportedDMResp_ParamAccessType.Descriptor instead.
+func (GetSupportedDMResp_ParamAccessType) EnumDescriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type GetSupportedDMResp_ObjAccessType int32
+
+const (
+	GetSupportedDMResp_OBJ_READ_ONLY   GetSupportedDMResp_ObjAccessType = 0
+	GetSupportedDMResp_OBJ_ADD_DELETE  GetSupportedDMResp_ObjAccessType = 1
+	GetSupportedDMResp_OBJ_ADD_ONLY    GetSupportedDMResp_ObjAccessType = 2
+	GetSupportedDMResp_OBJ_DELETE_ONLY GetSupportedDMResp_ObjAccessType = 3
+)
+
+// Enum value maps for GetSupportedDMResp_ObjAccessType.
+var (
+	GetSupportedDMResp_ObjAccessType_name = map[int32]string{
+		0: "OBJ_READ_ONLY",
+		1: "OBJ_ADD_DELETE",
+		2: "OBJ_ADD_ONLY",
+		3: "OBJ_DELETE_ONLY",
+	}
+	GetSupportedDMResp_ObjAccessType_value = map[string]int32{
+		"OBJ_READ_ONLY":   0,
+		"OBJ_ADD_DELETE":  1,
+		"OBJ_ADD_ONLY":    2,
+		"OBJ_DELETE_ONLY": 3,
+	}
+)
+
+func (x GetSupportedDMResp_ObjAccessType) Enum() *GetSupportedDMResp_ObjAccessType {
+	p := new(GetSupportedDMResp_ObjAccessType)
+	*p = x
+	return p
+}
+
+func (x GetSupportedDMResp_ObjAccessType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetSupportedDMResp_ObjAccessType) Descriptor() protoreflect.EnumDescriptor {
+	return file_usp_msg_1_3_proto_enumTypes[2].Descriptor()
+}
+
+func (GetSupportedDMResp_ObjAccessType) Type() protoreflect.EnumType {
+	return &file_usp_msg_1_3_proto_enumTypes[2]
+}
+
+func (x GetSupportedDMResp_ObjAccessType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetSupportedDMResp_ObjAccessType.Descriptor instead.
+func (GetSupportedDMResp_ObjAccessType) EnumDescriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9, 1}
+}
+
+type GetSupportedDMResp_ParamValueType int32
+
+const (
+	GetSupportedDMResp_PARAM_UNKNOWN       GetSupportedDMResp_ParamValueType = 0
+	GetSupportedDMResp_PARAM_BASE_64       GetSupportedDMResp_ParamValueType = 1
+	GetSupportedDMResp_PARAM_BOOLEAN       GetSupportedDMResp_ParamValueType = 2
+	GetSupportedDMResp_PARAM_DATE_TIME     GetSupportedDMResp_ParamValueType = 3
+	GetSupportedDMResp_PARAM_DECIMAL       GetSupportedDMResp_ParamValueType = 4
+	GetSupportedDMResp_PARAM_HEX_BINARY    GetSupportedDMResp_ParamValueType = 5
+	GetSupportedDMResp_PARAM_INT           GetSupportedDMResp_ParamValueType = 6
+	GetSupportedDMResp_PARAM_LONG          GetSupportedDMResp_ParamValueType = 7
+	GetSupportedDMResp_PARAM_STRING        GetSupportedDMResp_ParamValueType = 8
+	GetSupportedDMResp_PARAM_UNSIGNED_INT  GetSupportedDMResp_ParamValueType = 9
+	GetSupportedDMResp_PARAM_UNSIGNED_LONG GetSupportedDMResp_ParamValueType = 10
+)
+
+// Enum value maps for GetSupportedDMResp_ParamValueType.
+var (
+	GetSupportedDMResp_ParamValueType_name = map[int32]string{
+		0:  "PARAM_UNKNOWN",
+		1:  "PARAM_BASE_64",
+		2:  "PARAM_BOOLEAN",
+		3:  "PARAM_DATE_TIME",
+		4:  "PARAM_DECIMAL",
+		5:  "PARAM_HEX_BINARY",
+		6:  "PARAM_INT",
+		7:  "PARAM_LONG",
+		8:  "PARAM_STRING",
+		9:  "PARAM_UNSIGNED_INT",
+		10: "PARAM_UNSIGNED_LONG",
+	}
+	GetSupportedDMResp_ParamValueType_value = map[string]int32{
+		"PARAM_UNKNOWN":       0,
+		"PARAM_BASE_64":       1,
+		"PARAM_BOOLEAN":       2,
+		"PARAM_DATE_TIME":     3,
+		"PARAM_DECIMAL":       4,
+		"PARAM_HEX_BINARY":    5,
+		"PARAM_INT":           6,
+		"PARAM_LONG":          7,
+		"PARAM_STRING":        8,
+		"PARAM_UNSIGNED_INT":  9,
+		"PARAM_UNSIGNED_LONG": 10,
+	}
+)
+
+func (x GetSupportedDMResp_ParamValueType) Enum() *GetSupportedDMResp_ParamValueType {
+	p := new(GetSupportedDMResp_ParamValueType)
+	*p = x
+	return p
+}
+
+func (x GetSupportedDMResp_ParamValueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetSupportedDMResp_ParamValueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_usp_msg_1_3_proto_enumTypes[3].Descriptor()
+}
+
+func (GetSupportedDMResp_ParamValueType) Type() protoreflect.EnumType {
+	return &file_usp_msg_1_3_proto_enumTypes[3]
+}
+
+func (x GetSupportedDMResp_ParamValueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetSupportedDMResp_ParamValueType.Descriptor instead.
+func (GetSupportedDMResp_ParamValueType) EnumDescriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9, 2}
+}
+
+type GetSupportedDMResp_ValueChangeType int32
+
+const (
+	GetSupportedDMResp_VALUE_CHANGE_UNKNOWN     GetSupportedDMResp_ValueChangeType = 0
+	GetSupportedDMResp_VALUE_CHANGE_ALLOWED     GetSupportedDMResp_ValueChangeType = 1
+	GetSupportedDMResp_VALUE_CHANGE_WILL_IGNORE GetSupportedDMResp_ValueChangeType = 2
+)
+
+// Enum value maps for GetSupportedDMResp_ValueChangeType.
+var (
+	GetSupportedDMResp_ValueChangeType_name = map[int32]string{
+		0: "VALUE_CHANGE_UNKNOWN",
+		1: "VALUE_CHANGE_ALLOWED",
+		2: "VALUE_CHANGE_WILL_IGNORE",
+	}
+	GetSupportedDMResp_ValueChangeType_value = map[string]int32{
+		"VALUE_CHANGE_UNKNOWN":     0,
+		"VALUE_CHANGE_ALLOWED":     1,
+		"VALUE_CHANGE_WILL_IGNORE": 2,
+	}
+)
+
+func (x GetSupportedDMResp_ValueChangeType) Enum() *GetSupportedDMResp_ValueChangeType {
+	p := new(GetSupportedDMResp_ValueChangeType)
+	*p = x
+	return p
+}
+
+func (x GetSupportedDMResp_ValueChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetSupportedDMResp_ValueChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_usp_msg_1_3_proto_enumTypes[4].Descriptor()
+}
+
+func (GetSupportedDMResp_ValueChangeType) Type() protoreflect.EnumType {
+	return &file_usp_msg_1_3_proto_enumTypes[4]
+}
+
+func (x GetSupportedDMResp_ValueChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetSupportedDMResp_ValueChangeType.Descriptor instead.
+func (GetSupportedDMResp_ValueChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9, 3}
+}
+
+type GetSupportedDMResp_CmdType int32
+
+const (
+	GetSupportedDMResp_CMD_UNKNOWN GetSupportedDMResp_CmdType = 0
+	GetSupportedDMResp_CMD_SYNC    GetSupportedDMResp_CmdType = 1
+	GetSupportedDMResp_CMD_ASYNC   GetSupportedDMResp_CmdType = 2
+)
+
+// Enum value maps for GetSupportedDMResp_CmdType.
+var (
+	GetSupportedDMResp_CmdType_name = map[int32]string{
+		0: "CMD_UNKNOWN",
+		1: "CMD_SYNC",
+		2: "CMD_ASYNC",
+	}
+	GetSupportedDMResp_CmdType_value = map[string]int32{
+		"CMD_UNKNOWN": 0,
+		"CMD_SYNC":    1,
+		"CMD_ASYNC":   2,
+	}
+)
+
+func (x GetSupportedDMResp_CmdType) Enum() *GetSupportedDMResp_CmdType {
+	p := new(GetSupportedDMResp_CmdType)
+	*p = x
+	return p
+}
+
+func (x GetSupportedDMResp_CmdType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetSupportedDMResp_CmdType) Descriptor() protoreflect.EnumDescriptor {
+	return file_usp_msg_1_3_proto_enumTypes[5].Descriptor()
+}
+
+func (GetSupportedDMResp_CmdType) Type() protoreflect.EnumType {
+	return &file_usp_msg_1_3_proto_enumTypes[5]
+}
+
+func (x GetSupportedDMResp_CmdType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetSupportedDMResp_CmdType.Descriptor instead.
+func (GetSupportedDMResp_CmdType) EnumDescriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9, 4}
+}
+
+type Msg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Header        *Header                `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"` // Make required in the protocol
+	Body          *Body                  `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`     // Make required in the protocol
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Msg) Reset() {
+	*x = Msg{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Msg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Msg) ProtoMessage() {}
+
+func (x *Msg) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Msg.ProtoReflect.Descriptor instead.
+func (*Msg) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Msg) GetHeader() *Header {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *Msg) GetBody() *Body {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+type Header struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MsgId         string                 `protobuf:"bytes,1,opt,name=msg_id,json=msgId,proto3" json:"msg_id,omitempty"`                                // Make required in the protocol
+	MsgType       Header_MsgType         `protobuf:"varint,2,opt,name=msg_type,json=msgType,proto3,enum=usp.Header_MsgType" json:"msg_type,omitempty"` // Make required in the protocol
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Header) Reset() {
+	*x = Header{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Header) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Header) ProtoMessage() {}
+
+func (x *Header) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Header.ProtoReflect.Descriptor instead.
+func (*Header) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Header) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *Header) GetMsgType() Header_MsgType {
+	if x != nil {
+		return x.MsgType
+	}
+	return Header_ERROR
+}
+
+type Body struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to MsgBody:
+	//
+	//	*Body_Request
+	//	*Body_Response
+	//	*Body_Error
+	MsgBody       isBody_MsgBody `protobuf_oneof:"msg_body"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Body) Reset() {
+	*x = Body{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Body) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Body) ProtoMessage() {}
+
+func (x *Body) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Body.ProtoReflect.Descriptor instead.
+func (*Body) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Body) GetMsgBody() isBody_MsgBody {
+	if x != nil {
+		return x.MsgBody
+	}
+	return nil
+}
+
+func (x *Body) GetRequest() *Request {
+	if x != nil {
+		if x, ok := x.MsgBody.(*Body_Request); ok {
+			return x.Request
+		}
+	}
+	return nil
+}
+
+func (x *Body) GetResponse() *Response {
+	if x != nil {
+		if x, ok := x.MsgBody.(*Body_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *Body) GetError() *Error {
+	if x != nil {
+		if x, ok := x.MsgBody.(*Body_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isBody_MsgBody interface {
+	isBody_MsgBody()
+}
+
+type Body_Request struct {
+	Request *Request `protobuf:"bytes,1,opt,name=request,proto3,oneof"`
+}
+
+type Body_Response struct {
+	Response *Response `protobuf:"bytes,2,opt,name=response,proto3,oneof"`
+}
+
+type Body_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*Body_Request) isBody_MsgBody() {}
+
+func (*Body_Response) isBody_MsgBody() {}
+
+func (*Body_Error) isBody_MsgBody() {}
+
+type Request struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to ReqType:
+	//
+	//	*Request_Get
+	//	*Request_GetSupportedDm
+	//	*Request_GetInstances
+	//	*Request_Set
+	//	*Request_Add
+	//	*Request_Delete
+	//	*Request_Operate
+	//	*Request_Notify
+	//	*Request_GetSupportedProtocol
+	//	*Request_Register
+	//	*Request_Deregister
+	ReqType       isRequest_ReqType `protobuf_oneof:"req_type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Request) GetReqType() isRequest_ReqType {
+	if x != nil {
+		return x.ReqType
+	}
+	return nil
+}
+
+func (x *Request) GetGet() *Get {
+	if x != nil {
+		if x, ok := x.ReqType.(*Request_Get); ok {
+			return x.Get
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetGetSupportedDm() *GetSupportedDM {
+	if x != nil {
+		if x, ok := x.ReqType.(*Request_GetSupportedDm); ok {
+			return x.GetSupportedDm
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetGetInstances() *GetInstances {
+	if x != nil {
+		if x, ok := x.ReqType.(*Request_GetInstances); ok {
+			return x.GetInstances
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetSet() *Set {
+	if x != nil {
+		if x, ok := x.ReqType.(*Request_Set); ok {
+			return x.Set
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetAdd() *Add {
+	if x != nil {
+		if x, ok := x.ReqType.(*Request_Add); ok {
+			return x.Add
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetDelete() *Delete {
+	if x != nil {
+		if x, ok := x.ReqType.(*Request_Delete); ok {
+			return x.Delete
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetOperate() *Operate {
+	if x != nil {
+		if x, ok := x.ReqType.(*Request_Operate); ok {
+			return x.Operate
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetNotify() *Notify {
+	if x != nil {
+		if x, ok := x.ReqType.(*Request_Notify); ok {
+			return x.Notify
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetGetSupportedProtocol() *GetSupportedProtocol {
+	if x != nil {
+		if x, ok := x.ReqType.(*Request_GetSupportedProtocol); ok {
+			return x.GetSupportedProtocol
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetRegister() *Register {
+	if x != nil {
+		if x, ok := x.ReqType.(*Request_Register); ok {
+			return x.Register
+		}
+	}
+	return nil
+}
+
+func (x *Request) GetDeregister() *Deregister {
+	if x != nil {
+		if x, ok := x.ReqType.(*Request_Deregister); ok {
+			return x.Deregister
+		}
+	}
+	return nil
+}
+
+type isRequest_ReqType interface {
+	isRequest_ReqType()
+}
+
+type Request_Get struct {
+	Get *Get `protobuf:"bytes,1,opt,name=get,proto3,oneof"`
+}
+
+type Request_GetSupportedDm struct {
+	GetSupportedDm *GetSupportedDM `protobuf:"bytes,2,opt,name=get_supported_dm,json=getSupportedDm,proto3,oneof"`
+}
+
+type Request_GetInstances struct {
+	GetInstances *GetInstances `protobuf:"bytes,3,opt,name=get_instances,json=getInstances,proto3,oneof"`
+}
+
+type Request_Set struct {
+	Set *Set `protobuf:"bytes,4,opt,name=set,proto3,oneof"`
+}
+
+type Request_Add struct {
+	Add *Add `protobuf:"bytes,5,opt,name=add,proto3,oneof"`
+}
+
+type Request_Delete struct {
+	Delete *Delete `protobuf:"bytes,6,opt,name=delete,proto3,oneof"`
+}
+
+type Request_Operate struct {
+	Operate *Operate `protobuf:"bytes,7,opt,name=operate,proto3,oneof"`
+}
+
+type Request_Notify struct {
+	Notify *Notify `protobuf:"bytes,8,opt,name=notify,proto3,oneof"`
+}
+
+type Request_GetSupportedProtocol struct {
+	GetSupportedProtocol *GetSupportedProtocol `protobuf:"bytes,9,opt,name=get_supported_protocol,json=getSupportedProtocol,proto3,oneof"`
+}
+
+type Request_Register struct {
+	Register *Register `protobuf:"bytes,10,opt,name=register,proto3,oneof"`
+}
+
+type Request_Deregister struct {
+	Deregister *Deregister `protobuf:"bytes,11,opt,name=deregister,proto3,oneof"`
+}
+
+func (*Request_Get) isRequest_ReqType() {}
+
+func (*Request_GetSupportedDm) isRequest_ReqType() {}
+
+func (*Request_GetInstances) isRequest_ReqType() {}
+
+func (*Request_Set) isRequest_ReqType() {}
+
+func (*Request_Add) isRequest_ReqType() {}
+
+func (*Request_Delete) isRequest_ReqType() {}
+
+func (*Request_Operate) isRequest_ReqType() {}
+
+func (*Request_Notify) isRequest_ReqType() {}
+
+func (*Request_GetSupportedProtocol) isRequest_ReqType() {}
+
+func (*Request_Register) isRequest_ReqType() {}
+
+func (*Request_Deregister) isRequest_ReqType() {}
+
+type Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to RespType:
+	//
+	//	*Response_GetResp
+	//	*Response_GetSupportedDmResp
+	//	*Response_GetInstancesResp
+	//	*Response_SetResp
+	//	*Response_AddResp
+	//	*Response_DeleteResp
+	//	*Response_OperateResp
+	//	*Response_NotifyResp
+	//	*Response_GetSupportedProtocolResp
+	//	*Response_RegisterResp
+	//	*Response_DeregisterResp
+	RespType      isResponse_RespType `protobuf_oneof:"resp_type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Response) GetRespType() isResponse_RespType {
+	if x != nil {
+		return x.RespType
+	}
+	return nil
+}
+
+func (x *Response) GetGetResp() *GetResp {
+	if x != nil {
+		if x, ok := x.RespType.(*Response_GetResp); ok {
+			return x.GetResp
+		}
+	}
+	return nil
+}
+
+func (x *Response) GetGetSupportedDmResp() *GetSupportedDMResp {
+	if x != nil {
+		if x, ok := x.RespType.(*Response_GetSupportedDmResp); ok {
+			return x.GetSupportedDmResp
+		}
+	}
+	return nil
+}
+
+func (x *Response) GetGetInstancesResp() *GetInstancesResp {
+	if x != nil {
+		if x, ok := x.RespType.(*Response_GetInstancesResp); ok {
+			return x.GetInstancesResp
+		}
+	}
+	return nil
+}
+
+func (x *Response) GetSetResp() *SetResp {
+	if x != nil {
+		if x, ok := x.RespType.(*Response_SetResp); ok {
+			return x.SetResp
+		}
+	}
+	return nil
+}
+
+func (x *Response) GetAddResp() *AddResp {
+	if x != nil {
+		if x, ok := x.RespType.(*Response_AddResp); ok {
+			return x.AddResp
+		}
+	}
+	return nil
+}
+
+func (x *Response) GetDeleteResp() *DeleteResp {
+	if x != nil {
+		if x, ok := x.RespType.(*Response_DeleteResp); ok {
+			return x.DeleteResp
+		}
+	}
+	return nil
+}
+
+func (x *Response) GetOperateResp() *OperateResp {
+	if x != nil {
+		if x, ok := x.RespType.(*Response_OperateResp); ok {
+			return x.OperateResp
+		}
+	}
+	return nil
+}
+
+func (x *Response) GetNotifyResp() *NotifyResp {
+	if x != nil {
+		if x, ok := x.RespType.(*Response_NotifyResp); ok {
+			return x.NotifyResp
+		}
+	}
+	return nil
+}
+
+func (x *Response) GetGetSupportedProtocolResp() *GetSupportedProtocolResp {
+	if x != nil {
+		if x, ok := x.RespType.(*Response_GetSupportedProtocolResp); ok {
+			return x.GetSupportedProtocolResp
+		}
+	}
+	return nil
+}
+
+func (x *Response) GetRegisterResp() *RegisterResp {
+	if x != nil {
+		if x, ok := x.RespType.(*Response_RegisterResp); ok {
+			return x.RegisterResp
+		}
+	}
+	return nil
+}
+
+func (x *Response) GetDeregisterResp() *DeregisterResp {
+	if x != nil {
+		if x, ok := x.RespType.(*Response_DeregisterResp); ok {
+			return x.DeregisterResp
+		}
+	}
+	return nil
+}
+
+type isResponse_RespType interface {
+	isResponse_RespType()
+}
+
+type Response_GetResp struct {
+	GetResp *GetResp `protobuf:"bytes,1,opt,name=get_resp,json=getResp,proto3,oneof"`
+}
+
+type Response_GetSupportedDmResp struct {
+	GetSupportedDmResp *GetSupportedDMResp `protobuf:"bytes,2,opt,name=get_supported_dm_resp,json=getSupportedDmResp,proto3,oneof"`
+}
+
+type Response_GetInstancesResp struct {
+	GetInstancesResp *GetInstancesResp `protobuf:"bytes,3,opt,name=get_instances_resp,json=getInstancesResp,proto3,oneof"`
+}
+
+type Response_SetResp struct {
+	SetResp *SetResp `protobuf:"bytes,4,opt,name=set_resp,json=setResp,proto3,oneof"`
+}
+
+type Response_AddResp struct {
+	AddResp *AddResp `protobuf:"bytes,5,opt,name=add_resp,json=addResp,proto3,oneof"`
+}
+
+type Response_DeleteResp struct {
+	DeleteResp *DeleteResp `protobuf:"bytes,6,opt,name=delete_resp,json=deleteResp,proto3,oneof"`
+}
+
+type Response_OperateResp struct {
+	OperateResp *OperateResp `protobuf:"bytes,7,opt,name=operate_resp,json=operateResp,proto3,oneof"`
+}
+
+type Response_NotifyResp struct {
+	NotifyResp *NotifyResp `protobuf:"bytes,8,opt,name=notify_resp,json=notifyResp,proto3,oneof"`
+}
+
+type Response_GetSupportedProtocolResp struct {
+	GetSupportedProtocolResp *GetSupportedProtocolResp `protobuf:"bytes,9,opt,name=get_supported_protocol_resp,json=getSupportedProtocolResp,proto3,oneof"`
+}
+
+type Response_RegisterResp struct {
+	RegisterResp *RegisterResp `protobuf:"bytes,10,opt,name=register_resp,json=registerResp,proto3,oneof"`
+}
+
+type Response_DeregisterResp struct {
+	DeregisterResp *DeregisterResp `protobuf:"bytes,11,opt,name=deregister_resp,json=deregisterResp,proto3,oneof"`
+}
+
+func (*Response_GetResp) isResponse_RespType() {}
+
+func (*Response_GetSupportedDmResp) isResponse_RespType() {}
+
+func (*Response_GetInstancesResp) isResponse_RespType() {}
+
+func (*Response_SetResp) isResponse_RespType() {}
+
+func (*Response_AddResp) isResponse_RespType() {}
+
+func (*Response_DeleteResp) isResponse_RespType() {}
+
+func (*Response_OperateResp) isResponse_RespType() {}
+
+func (*Response_NotifyResp) isResponse_RespType() {}
+
+func (*Response_GetSupportedProtocolResp) isResponse_RespType() {}
+
+func (*Response_RegisterResp) isResponse_RespType() {}
+
+func (*Response_DeregisterResp) isResponse_RespType() {}
+
+type Error struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrCode       uint32                 `protobuf:"fixed32,1,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg        string                 `protobuf:"bytes,2,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	ParamErrs     []*Error_ParamError    `protobuf:"bytes,3,rep,name=param_errs,json=paramErrs,proto3" json:"param_errs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Error) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *Error) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *Error) GetParamErrs() []*Error_ParamError {
+	if x != nil {
+		return x.ParamErrs
+	}
+	return nil
+}
+
+type Get struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParamPaths    []string               `protobuf:"bytes,1,rep,name=param_paths,json=paramPaths,proto3" json:"param_paths,omitempty"`
+	MaxDepth      uint32                 `protobuf:"fixed32,2,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Get) Reset() {
+	*x = Get{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Get) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Get) ProtoMessage() {}
+
+func (x *Get) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Get.ProtoReflect.Descriptor instead.
+func (*Get) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Get) GetParamPaths() []string {
+	if x != nil {
+		return x.ParamPaths
+	}
+	return nil
+}
+
+func (x *Get) GetMaxDepth() uint32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+type GetResp struct {
+	state          protoimpl.MessageState         `protogen:"open.v1"`
+	ReqPathResults []*GetResp_RequestedPathResult `protobuf:"bytes,1,rep,name=req_path_results,json=reqPathResults,proto3" json:"req_path_results,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetResp) Reset() {
+	*x = GetResp{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResp) ProtoMessage() {}
+
+func (x *GetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResp.ProtoReflect.Descriptor instead.
+func (*GetResp) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetResp) GetReqPathResults() []*GetResp_RequestedPathResult {
+	if x != nil {
+		return x.ReqPathResults
+	}
+	return nil
+}
+
+type GetSupportedDM struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	ObjPaths            []string               `protobuf:"bytes,1,rep,name=obj_paths,json=objPaths,proto3" json:"obj_paths,omitempty"`
+	FirstLevelOnly      bool                   `protobuf:"varint,2,opt,name=first_level_only,json=firstLevelOnly,proto3" json:"first_level_only,omitempty"`
+	ReturnCommands      bool                   `protobuf:"varint,3,opt,name=return_commands,json=returnCommands,proto3" json:"return_commands,omitempty"`
+	ReturnEvents        bool                   `protobuf:"varint,4,opt,name=return_events,json=returnEvents,proto3" json:"return_events,omitempty"`
+	ReturnParams        bool                   `protobuf:"varint,5,opt,name=return_params,json=returnParams,proto3" json:"return_params,omitempty"`
+	ReturnUniqueKeySets bool                   `protobuf:"varint,6,opt,name=return_unique_key_sets,json=returnUniqueKeySets,proto3" json:"return_unique_key_sets,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetSupportedDM) Reset() {
+	*x = GetSupportedDM{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedDM) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedDM) ProtoMessage() {}
+
+func (x *GetSupportedDM) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedDM.ProtoReflect.Descriptor instead.
+func (*GetSupportedDM) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetSupportedDM) GetObjPaths() []string {
+	if x != nil {
+		return x.ObjPaths
+	}
+	return nil
+}
+
+func (x *GetSupportedDM) GetFirstLevelOnly() bool {
+	if x != nil {
+		return x.FirstLevelOnly
+	}
+	return false
+}
+
+func (x *GetSupportedDM) GetReturnCommands() bool {
+	if x != nil {
+		return x.ReturnCommands
+	}
+	return false
+}
+
+func (x *GetSupportedDM) GetReturnEvents() bool {
+	if x != nil {
+		return x.ReturnEvents
+	}
+	return false
+}
+
+func (x *GetSupportedDM) GetReturnParams() bool {
+	if x != nil {
+		return x.ReturnParams
+	}
+	return false
+}
+
+func (x *GetSupportedDM) GetReturnUniqueKeySets() bool {
+	if x != nil {
+		return x.ReturnUniqueKeySets
+	}
+	return false
+}
+
+type GetSupportedDMResp struct {
+	state         protoimpl.MessageState                      `protogen:"open.v1"`
+	ReqObjResults []*GetSupportedDMResp_RequestedObjectResult `protobuf:"bytes,1,rep,name=req_obj_results,json=reqObjResults,proto3" json:"req_obj_results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupportedDMResp) Reset() {
+	*x = GetSupportedDMResp{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedDMResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedDMResp) ProtoMessage() {}
+
+func (x *GetSupportedDMResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedDMResp.ProtoReflect.Descriptor instead.
+func (*GetSupportedDMResp) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetSupportedDMResp) GetReqObjResults() []*GetSupportedDMResp_RequestedObjectResult {
+	if x != nil {
+		return x.ReqObjResults
+	}
+	return nil
+}
+
+type GetInstances struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ObjPaths       []string               `protobuf:"bytes,1,rep,name=obj_paths,json=objPaths,proto3" json:"obj_paths,omitempty"`
+	FirstLevelOnly bool                   `protobuf:"varint,2,opt,name=first_level_only,json=firstLevelOnly,proto3" json:"first_level_only,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetInstances) Reset() {
+	*x = GetInstances{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstances) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstances) ProtoMessage() {}
+
+func (x *GetInstances) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstances.ProtoReflect.Descriptor instead.
+func (*GetInstances) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetInstances) GetObjPaths() []string {
+	if x != nil {
+		return x.ObjPaths
+	}
+	return nil
+}
+
+func (x *GetInstances) GetFirstLevelOnly() bool {
+	if x != nil {
+		return x.FirstLevelOnly
+	}
+	return false
+}
+
+type GetInstancesResp struct {
+	state          protoimpl.MessageState                  `protogen:"open.v1"`
+	ReqPathResults []*GetInstancesResp_RequestedPathResult `protobuf:"bytes,1,rep,name=req_path_results,json=reqPathResults,proto3" json:"req_path_results,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetInstancesResp) Reset() {
+	*x = GetInstancesResp{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstancesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstancesResp) ProtoMessage() {}
+
+func (x *GetInstancesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstancesResp.ProtoReflect.Descriptor instead.
+func (*GetInstancesResp) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetInstancesResp) GetReqPathResults() []*GetInstancesResp_RequestedPathResult {
+	if x != nil {
+		return x.ReqPathResults
+	}
+	return nil
+}
+
+type GetSupportedProtocol struct {
+	state                               protoimpl.MessageState `protogen:"open.v1"`
+	ControllerSupportedProtocolVersions string                 `protobuf:"bytes,1,opt,name=controller_supported_protocol_versions,json=controllerSupportedProtocolVersions,proto3" json:"controller_supported_protocol_versions,omitempty"`
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
+}
+
+func (x *GetSupportedProtocol) Reset() {
+	*x = GetSupportedProtocol{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedProtocol) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedProtocol) ProtoMessage() {}
+
+func (x *GetSupportedProtocol) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedProtocol.ProtoReflect.Descriptor instead.
+func (*GetSupportedProtocol) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetSupportedProtocol) GetControllerSupportedProtocolVersions() string {
+	if x != nil {
+		return x.ControllerSupportedProtocolVersions
+	}
+	return ""
+}
+
+type GetSupportedProtocolResp struct {
+	state                          protoimpl.MessageState `protogen:"open.v1"`
+	AgentSupportedProtocolVersions string                 `protobuf:"bytes,1,opt,name=agent_supported_protocol_versions,json=agentSupportedProtocolVersions,proto3" json:"agent_supported_protocol_versions,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *GetSupportedProtocolResp) Reset() {
+	*x = GetSupportedProtocolResp{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedProtocolResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedProtocolResp) ProtoMessage() {}
+
+func (x *GetSupportedProtocolResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedProtocolResp.ProtoReflect.Descriptor instead.
+func (*GetSupportedProtocolResp) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetSupportedProtocolResp) GetAgentSupportedProtocolVersions() string {
+	if x != nil {
+		return x.AgentSupportedProtocolVersions
+	}
+	return ""
+}
+
+type Add struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllowPartial  bool                   `protobuf:"varint,1,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	CreateObjs    []*Add_CreateObject    `protobuf:"bytes,2,rep,name=create_objs,json=createObjs,proto3" json:"create_objs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Add) Reset() {
+	*x = Add{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Add) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Add) ProtoMessage() {}
+
+func (x *Add) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Add.ProtoReflect.Descriptor instead.
+func (*Add) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Add) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+func (x *Add) GetCreateObjs() []*Add_CreateObject {
+	if x != nil {
+		return x.CreateObjs
+	}
+	return nil
+}
+
+type AddResp struct {
+	state             protoimpl.MessageState         `protogen:"open.v1"`
+	CreatedObjResults []*AddResp_CreatedObjectResult `protobuf:"bytes,1,rep,name=created_obj_results,json=createdObjResults,proto3" json:"created_obj_results,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddResp) Reset() {
+	*x = AddResp{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResp) ProtoMessage() {}
+
+func (x *AddResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResp.ProtoReflect.Descriptor instead.
+func (*AddResp) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddResp) GetCreatedObjResults() []*AddResp_CreatedObjectResult {
+	if x != nil {
+		return x.CreatedObjResults
+	}
+	return nil
+}
+
+type Delete struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllowPartial  bool                   `protobuf:"varint,1,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	ObjPaths      []string               `protobuf:"bytes,2,rep,name=obj_paths,json=objPaths,proto3" json:"obj_paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Delete) Reset() {
+	*x = Delete{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Delete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delete) ProtoMessage() {}
+
+func (x *Delete) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Delete.ProtoReflect.Descriptor instead.
+func (*Delete) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Delete) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+func (x *Delete) GetObjPaths() []string {
+	if x != nil {
+		return x.ObjPaths
+	}
+	return nil
+}
+
+type DeleteResp struct {
+	state             protoimpl.MessageState            `protogen:"open.v1"`
+	DeletedObjResults []*DeleteResp_DeletedObjectResult `protobuf:"bytes,1,rep,name=deleted_obj_results,json=deletedObjResults,proto3" json:"deleted_obj_results,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeleteResp) Reset() {
+	*x = DeleteResp{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResp) ProtoMessage() {}
+
+func (x *DeleteResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResp.ProtoReflect.Descriptor instead.
+func (*DeleteResp) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteResp) GetDeletedObjResults() []*DeleteResp_DeletedObjectResult {
+	if x != nil {
+		return x.DeletedObjResults
+	}
+	return nil
+}
+
+type Set struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllowPartial  bool                   `protobuf:"varint,1,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	UpdateObjs    []*Set_UpdateObject    `protobuf:"bytes,2,rep,name=update_objs,json=updateObjs,proto3" json:"update_objs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Set) Reset() {
+	*x = Set{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Set) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Set) ProtoMessage() {}
+
+func (x *Set) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Set.ProtoReflect.Descriptor instead.
+func (*Set) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Set) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+func (x *Set) GetUpdateObjs() []*Set_UpdateObject {
+	if x != nil {
+		return x.UpdateObjs
+	}
+	return nil
+}
+
+type SetResp struct {
+	state             protoimpl.MessageState         `protogen:"open.v1"`
+	UpdatedObjResults []*SetResp_UpdatedObjectResult `protobuf:"bytes,1,rep,name=updated_obj_results,json=updatedObjResults,proto3" json:"updated_obj_results,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetResp) Reset() {
+	*x = SetResp{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResp) ProtoMessage() {}
+
+func (x *SetResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResp.ProtoReflect.Descriptor instead.
+func (*SetResp) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetResp) GetUpdatedObjResults() []*SetResp_UpdatedObjectResult {
+	if x != nil {
+		return x.UpdatedObjResults
+	}
+	return nil
+}
+
+type Operate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       string                 `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	CommandKey    string                 `protobuf:"bytes,2,opt,name=command_key,json=commandKey,proto3" json:"command_key,omitempty"`
+	SendResp      bool                   `protobuf:"varint,3,opt,name=send_resp,json=sendResp,proto3" json:"send_resp,omitempty"`
+	InputArgs     map[string]string      `protobuf:"bytes,4,rep,name=input_args,json=inputArgs,proto3" json:"input_args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Operate) Reset() {
+	*x = Operate{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Operate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operate) ProtoMessage() {}
+
+func (x *Operate) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operate.ProtoReflect.Descriptor instead.
+func (*Operate) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Operate) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *Operate) GetCommandKey() string {
+	if x != nil {
+		return x.CommandKey
+	}
+	return ""
+}
+
+func (x *Operate) GetSendResp() bool {
+	if x != nil {
+		return x.SendResp
+	}
+	return false
+}
+
+func (x *Operate) GetInputArgs() map[string]string {
+	if x != nil {
+		return x.InputArgs
+	}
+	return nil
+}
+
+type OperateResp struct {
+	state            protoimpl.MessageState         `protogen:"open.v1"`
+	OperationResults []*OperateResp_OperationResult `protobuf:"bytes,1,rep,name=operation_results,json=operationResults,proto3" json:"operation_results,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OperateResp) Reset() {
+	*x = OperateResp{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateResp) ProtoMessage() {}
+
+func (x *OperateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateResp.ProtoReflect.Descriptor instead.
+func (*OperateResp) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *OperateResp) GetOperationResults() []*OperateResp_OperationResult {
+	if x != nil {
+		return x.OperationResults
+	}
+	return nil
+}
+
+type Notify struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	SendResp       bool                   `protobuf:"varint,2,opt,name=send_resp,json=sendResp,proto3" json:"send_resp,omitempty"`
+	// Types that are valid to be assigned to Notification:
+	//
+	//	*Notify_Event_
+	//	*Notify_ValueChange_
+	//	*Notify_ObjCreation
+	//	*Notify_ObjDeletion
+	//	*Notify_OperComplete
+	//	*Notify_OnBoardReq
+	Notification  isNotify_Notification `protobuf_oneof:"notification"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notify) Reset() {
+	*x = Notify{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notify) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notify) ProtoMessage() {}
+
+func (x *Notify) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notify.ProtoReflect.Descriptor instead.
+func (*Notify) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Notify) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *Notify) GetSendResp() bool {
+	if x != nil {
+		return x.SendResp
+	}
+	return false
+}
+
+func (x *Notify) GetNotification() isNotify_Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *Notify) GetEvent() *Notify_Event {
+	if x != nil {
+		if x, ok := x.Notification.(*Notify_Event_); ok {
+			return x.Event
+		}
+	}
+	return nil
+}
+
+func (x *Notify) GetValueChange() *Notify_ValueChange {
+	if x != nil {
+		if x, ok := x.Notification.(*Notify_ValueChange_); ok {
+			return x.ValueChange
+		}
+	}
+	return nil
+}
+
+func (x *Notify) GetObjCreation() *Notify_ObjectCreation {
+	if x != nil {
+		if x, ok := x.Notification.(*Notify_ObjCreation); ok {
+			return x.ObjCreation
+		}
+	}
+	return nil
+}
+
+func (x *Notify) GetObjDeletion() *Notify_ObjectDeletion {
+	if x != nil {
+		if x, ok := x.Notification.(*Notify_ObjDeletion); ok {
+			return x.ObjDeletion
+		}
+	}
+	return nil
+}
+
+func (x *Notify) GetOperComplete() *Notify_OperationComplete {
+	if x != nil {
+		if x, ok := x.Notification.(*Notify_OperComplete); ok {
+			return x.OperComplete
+		}
+	}
+	return nil
+}
+
+func (x *Notify) GetOnBoardReq() *Notify_OnBoardRequest {
+	if x != nil {
+		if x, ok := x.Notification.(*Notify_OnBoardReq); ok {
+			return x.OnBoardReq
+		}
+	}
+	return nil
+}
+
+type isNotify_Notification interface {
+	isNotify_Notification()
+}
+
+type Notify_Event_ struct {
+	Event *Notify_Event `protobuf:"bytes,3,opt,name=event,proto3,oneof"`
+}
+
+type Notify_ValueChange_ struct {
+	ValueChange *Notify_ValueChange `protobuf:"bytes,4,opt,name=value_change,json=valueChange,proto3,oneof"`
+}
+
+type Notify_ObjCreation struct {
+	ObjCreation *Notify_ObjectCreation `protobuf:"bytes,5,opt,name=obj_creation,json=objCreation,proto3,oneof"`
+}
+
+type Notify_ObjDeletion struct {
+	ObjDeletion *Notify_ObjectDeletion `protobuf:"bytes,6,opt,name=obj_deletion,json=objDeletion,proto3,oneof"`
+}
+
+type Notify_OperComplete struct {
+	OperComplete *Notify_OperationComplete `protobuf:"bytes,7,opt,name=oper_complete,json=operComplete,proto3,oneof"`
+}
+
+type Notify_OnBoardReq struct {
+	OnBoardReq *Notify_OnBoardRequest `protobuf:"bytes,8,opt,name=on_board_req,json=onBoardReq,proto3,oneof"`
+}
+
+func (*Notify_Event_) isNotify_Notification() {}
+
+func (*Notify_ValueChange_) isNotify_Notification() {}
+
+func (*Notify_ObjCreation) isNotify_Notification() {}
+
+func (*Notify_ObjDeletion) isNotify_Notification() {}
+
+func (*Notify_OperComplete) isNotify_Notification() {}
+
+func (*Notify_OnBoardReq) isNotify_Notification() {}
+
+type NotifyResp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId string                 `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NotifyResp) Reset() {
+	*x = NotifyResp{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyResp) ProtoMessage() {}
+
+func (x *NotifyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyResp.ProtoReflect.Descriptor instead.
+func (*NotifyResp) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NotifyResp) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type Register struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	AllowPartial  bool                         `protobuf:"varint,1,opt,name=allow_partial,json=allowPartial,proto3" json:"allow_partial,omitempty"`
+	RegPaths      []*Register_RegistrationPath `protobuf:"bytes,2,rep,name=reg_paths,json=regPaths,proto3" json:"reg_paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Register) Reset() {
+	*x = Register{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Register) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Register) ProtoMessage() {}
+
+func (x *Register) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Register.ProtoReflect.Descriptor instead.
+func (*Register) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Register) GetAllowPartial() bool {
+	if x != nil {
+		return x.AllowPartial
+	}
+	return false
+}
+
+func (x *Register) GetRegPaths() []*Register_RegistrationPath {
+	if x != nil {
+		return x.RegPaths
+	}
+	return nil
+}
+
+type RegisterResp struct {
+	state                 protoimpl.MessageState               `protogen:"open.v1"`
+	RegisteredPathResults []*RegisterResp_RegisteredPathResult `protobuf:"bytes,1,rep,name=registered_path_results,json=registeredPathResults,proto3" json:"registered_path_results,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RegisterResp) Reset() {
+	*x = RegisterResp{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResp) ProtoMessage() {}
+
+func (x *RegisterResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResp.ProtoReflect.Descriptor instead.
+func (*RegisterResp) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RegisterResp) GetRegisteredPathResults() []*RegisterResp_RegisteredPathResult {
+	if x != nil {
+		return x.RegisteredPathResults
+	}
+	return nil
+}
+
+type Deregister struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Paths         []string               `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deregister) Reset() {
+	*x = Deregister{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deregister) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deregister) ProtoMessage() {}
+
+func (x *Deregister) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deregister.ProtoReflect.Descriptor instead.
+func (*Deregister) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Deregister) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type DeregisterResp struct {
+	state                   protoimpl.MessageState                   `protogen:"open.v1"`
+	DeregisteredPathResults []*DeregisterResp_DeregisteredPathResult `protobuf:"bytes,1,rep,name=deregistered_path_results,json=deregisteredPathResults,proto3" json:"deregistered_path_results,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *DeregisterResp) Reset() {
+	*x = DeregisterResp{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeregisterResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterResp) ProtoMessage() {}
+
+func (x *DeregisterResp) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterResp.ProtoReflect.Descriptor instead.
+func (*DeregisterResp) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeregisterResp) GetDeregisteredPathResults() []*DeregisterResp_DeregisteredPathResult {
+	if x != nil {
+		return x.DeregisteredPathResults
+	}
+	return nil
+}
+
+type Error_ParamError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParamPath     string                 `protobuf:"bytes,1,opt,name=param_path,json=paramPath,proto3" json:"param_path,omitempty"`
+	ErrCode       uint32                 `protobuf:"fixed32,2,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg        string                 `protobuf:"bytes,3,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Error_ParamError) Reset() {
+	*x = Error_ParamError{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Error_ParamError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error_ParamError) ProtoMessage() {}
+
+func (x *Error_ParamError) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error_ParamError.ProtoReflect.Descriptor instead.
+func (*Error_ParamError) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Error_ParamError) GetParamPath() string {
+	if x != nil {
+		return x.ParamPath
+	}
+	return ""
+}
+
+func (x *Error_ParamError) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *Error_ParamError) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type GetResp_RequestedPathResult struct {
+	state               protoimpl.MessageState        `protogen:"open.v1"`
+	RequestedPath       string                        `protobuf:"bytes,1,opt,name=requested_path,json=requestedPath,proto3" json:"requested_path,omitempty"`
+	ErrCode             uint32                        `protobuf:"fixed32,2,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg              string                        `protobuf:"bytes,3,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	ResolvedPathResults []*GetResp_ResolvedPathResult `protobuf:"bytes,4,rep,name=resolved_path_results,json=resolvedPathResults,proto3" json:"resolved_path_results,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetResp_RequestedPathResult) Reset() {
+	*x = GetResp_RequestedPathResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResp_RequestedPathResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResp_RequestedPathResult) ProtoMessage() {}
+
+func (x *GetResp_RequestedPathResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResp_RequestedPathResult.ProtoReflect.Descriptor instead.
+func (*GetResp_RequestedPathResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *GetResp_RequestedPathResult) GetRequestedPath() string {
+	if x != nil {
+		return x.RequestedPath
+	}
+	return ""
+}
+
+func (x *GetResp_RequestedPathResult) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *GetResp_RequestedPathResult) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *GetResp_RequestedPathResult) GetResolvedPathResults() []*GetResp_ResolvedPathResult {
+	if x != nil {
+		return x.ResolvedPathResults
+	}
+	return nil
+}
+
+type GetResp_ResolvedPathResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResolvedPath  string                 `protobuf:"bytes,1,opt,name=resolved_path,json=resolvedPath,proto3" json:"resolved_path,omitempty"`
+	ResultParams  map[string]string      `protobuf:"bytes,2,rep,name=result_params,json=resultParams,proto3" json:"result_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResp_ResolvedPathResult) Reset() {
+	*x = GetResp_ResolvedPathResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResp_ResolvedPathResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResp_ResolvedPathResult) ProtoMessage() {}
+
+func (x *GetResp_ResolvedPathResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResp_ResolvedPathResult.ProtoReflect.Descriptor instead.
+func (*GetResp_ResolvedPathResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *GetResp_ResolvedPathResult) GetResolvedPath() string {
+	if x != nil {
+		return x.ResolvedPath
+	}
+	return ""
+}
+
+func (x *GetResp_ResolvedPathResult) GetResultParams() map[string]string {
+	if x != nil {
+		return x.ResultParams
+	}
+	return nil
+}
+
+type GetSupportedDMResp_RequestedObjectResult struct {
+	state            protoimpl.MessageState                      `protogen:"open.v1"`
+	ReqObjPath       string                                      `protobuf:"bytes,1,opt,name=req_obj_path,json=reqObjPath,proto3" json:"req_obj_path,omitempty"`
+	ErrCode          uint32                                      `protobuf:"fixed32,2,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg           string                                      `protobuf:"bytes,3,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	DataModelInstUri string                                      `protobuf:"bytes,4,opt,name=data_model_inst_uri,json=dataModelInstUri,proto3" json:"data_model_inst_uri,omitempty"`
+	SupportedObjs    []*GetSupportedDMResp_SupportedObjectResult `protobuf:"bytes,5,rep,name=supported_objs,json=supportedObjs,proto3" json:"supported_objs,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetSupportedDMResp_RequestedObjectResult) Reset() {
+	*x = GetSupportedDMResp_RequestedObjectResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedDMResp_RequestedObjectResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedDMResp_RequestedObjectResult) ProtoMessage() {}
+
+func (x *GetSupportedDMResp_RequestedObjectResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedDMResp_RequestedObjectResult.ProtoReflect.Descriptor instead.
+func (*GetSupportedDMResp_RequestedObjectResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *GetSupportedDMResp_RequestedObjectResult) GetReqObjPath() string {
+	if x != nil {
+		return x.ReqObjPath
+	}
+	return ""
+}
+
+func (x *GetSupportedDMResp_RequestedObjectResult) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *GetSupportedDMResp_RequestedObjectResult) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *GetSupportedDMResp_RequestedObjectResult) GetDataModelInstUri() string {
+	if x != nil {
+		return x.DataModelInstUri
+	}
+	return ""
+}
+
+func (x *GetSupportedDMResp_RequestedObjectResult) GetSupportedObjs() []*GetSupportedDMResp_SupportedObjectResult {
+	if x != nil {
+		return x.SupportedObjs
+	}
+	return nil
+}
+
+type GetSupportedDMResp_SupportedObjectResult struct {
+	state             protoimpl.MessageState                       `protogen:"open.v1"`
+	SupportedObjPath  string                                       `protobuf:"bytes,1,opt,name=supported_obj_path,json=supportedObjPath,proto3" json:"supported_obj_path,omitempty"`
+	Access            GetSupportedDMResp_ObjAccessType             `protobuf:"varint,2,opt,name=access,proto3,enum=usp.GetSupportedDMResp_ObjAccessType" json:"access,omitempty"`
+	IsMultiInstance   bool                                         `protobuf:"varint,3,opt,name=is_multi_instance,json=isMultiInstance,proto3" json:"is_multi_instance,omitempty"`
+	SupportedCommands []*GetSupportedDMResp_SupportedCommandResult `protobuf:"bytes,4,rep,name=supported_commands,json=supportedCommands,proto3" json:"supported_commands,omitempty"`
+	SupportedEvents   []*GetSupportedDMResp_SupportedEventResult   `protobuf:"bytes,5,rep,name=supported_events,json=supportedEvents,proto3" json:"supported_events,omitempty"`
+	SupportedParams   []*GetSupportedDMResp_SupportedParamResult   `protobuf:"bytes,6,rep,name=supported_params,json=supportedParams,proto3" json:"supported_params,omitempty"`
+	DivergentPaths    []string                                     `protobuf:"bytes,7,rep,name=divergent_paths,json=divergentPaths,proto3" json:"divergent_paths,omitempty"`
+	UniqueKeySets     []*GetSupportedDMResp_SupportedUniqueKeySet  `protobuf:"bytes,8,rep,name=unique_key_sets,json=uniqueKeySets,proto3" json:"unique_key_sets,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetSupportedDMResp_SupportedObjectResult) Reset() {
+	*x = GetSupportedDMResp_SupportedObjectResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedDMResp_SupportedObjectResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedDMResp_SupportedObjectResult) ProtoMessage() {}
+
+func (x *GetSupportedDMResp_SupportedObjectResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedDMResp_SupportedObjectResult.ProtoReflect.Descriptor instead.
+func (*GetSupportedDMResp_SupportedObjectResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *GetSupportedDMResp_SupportedObjectResult) GetSupportedObjPath() string {
+	if x != nil {
+		return x.SupportedObjPath
+	}
+	return ""
+}
+
+func (x *GetSupportedDMResp_SupportedObjectResult) GetAccess() GetSupportedDMResp_ObjAccessType {
+	if x != nil {
+		return x.Access
+	}
+	return GetSupportedDMResp_OBJ_READ_ONLY
+}
+
+func (x *GetSupportedDMResp_SupportedObjectResult) GetIsMultiInstance() bool {
+	if x != nil {
+		return x.IsMultiInstance
+	}
+	return false
+}
+
+func (x *GetSupportedDMResp_SupportedObjectResult) GetSupportedCommands() []*GetSupportedDMResp_SupportedCommandResult {
+	if x != nil {
+		return x.SupportedCommands
+	}
+	return nil
+}
+
+func (x *GetSupportedDMResp_SupportedObjectResult) GetSupportedEvents() []*GetSupportedDMResp_SupportedEventResult {
+	if x != nil {
+		return x.SupportedEvents
+	}
+	return nil
+}
+
+func (x *GetSupportedDMResp_SupportedObjectResult) GetSupportedParams() []*GetSupportedDMResp_SupportedParamResult {
+	if x != nil {
+		return x.SupportedParams
+	}
+	return nil
+}
+
+func (x *GetSupportedDMResp_SupportedObjectResult) GetDivergentPaths() []string {
+	if x != nil {
+		return x.DivergentPaths
+	}
+	return nil
+}
+
+func (x *GetSupportedDMResp_SupportedObjectResult) GetUniqueKeySets() []*GetSupportedDMResp_SupportedUniqueKeySet {
+	if x != nil {
+		return x.UniqueKeySets
+	}
+	return nil
+}
+
+type GetSupportedDMResp_SupportedParamResult struct {
+	state         protoimpl.MessageState             `protogen:"open.v1"`
+	ParamName     string                             `protobuf:"bytes,1,opt,name=param_name,json=paramName,proto3" json:"param_name,omitempty"`
+	Access        GetSupportedDMResp_ParamAccessType `protobuf:"varint,2,opt,name=access,proto3,enum=usp.GetSupportedDMResp_ParamAccessType" json:"access,omitempty"`
+	ValueType     GetSupportedDMResp_ParamValueType  `protobuf:"varint,3,opt,name=value_type,json=valueType,proto3,enum=usp.GetSupportedDMResp_ParamValueType" json:"value_type,omitempty"`
+	ValueChange   GetSupportedDMResp_ValueChangeType `protobuf:"varint,4,opt,name=value_change,json=valueChange,proto3,enum=usp.GetSupportedDMResp_ValueChangeType" json:"value_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupportedDMResp_SupportedParamResult) Reset() {
+	*x = GetSupportedDMResp_SupportedParamResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedDMResp_SupportedParamResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedDMResp_SupportedParamResult) ProtoMessage() {}
+
+func (x *GetSupportedDMResp_SupportedParamResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedDMResp_SupportedParamResult.ProtoReflect.Descriptor instead.
+func (*GetSupportedDMResp_SupportedParamResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9, 2}
+}
+
+func (x *GetSupportedDMResp_SupportedParamResult) GetParamName() string {
+	if x != nil {
+		return x.ParamName
+	}
+	return ""
+}
+
+func (x *GetSupportedDMResp_SupportedParamResult) GetAccess() GetSupportedDMResp_ParamAccessType {
+	if x != nil {
+		return x.Access
+	}
+	return GetSupportedDMResp_PARAM_READ_ONLY
+}
+
+func (x *GetSupportedDMResp_SupportedParamResult) GetValueType() GetSupportedDMResp_ParamValueType {
+	if x != nil {
+		return x.ValueType
+	}
+	return GetSupportedDMResp_PARAM_UNKNOWN
+}
+
+func (x *GetSupportedDMResp_SupportedParamResult) GetValueChange() GetSupportedDMResp_ValueChangeType {
+	if x != nil {
+		return x.ValueChange
+	}
+	return GetSupportedDMResp_VALUE_CHANGE_UNKNOWN
+}
+
+type GetSupportedDMResp_SupportedCommandResult struct {
+	state          protoimpl.MessageState     `protogen:"open.v1"`
+	CommandName    string                     `protobuf:"bytes,1,opt,name=command_name,json=commandName,proto3" json:"command_name,omitempty"`
+	InputArgNames  []string                   `protobuf:"bytes,2,rep,name=input_arg_names,json=inputArgNames,proto3" json:"input_arg_names,omitempty"`
+	OutputArgNames []string                   `protobuf:"bytes,3,rep,name=output_arg_names,json=outputArgNames,proto3" json:"output_arg_names,omitempty"`
+	CommandType    GetSupportedDMResp_CmdType `protobuf:"varint,4,opt,name=command_type,json=commandType,proto3,enum=usp.GetSupportedDMResp_CmdType" json:"command_type,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSupportedDMResp_SupportedCommandResult) Reset() {
+	*x = GetSupportedDMResp_SupportedCommandResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedDMResp_SupportedCommandResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedDMResp_SupportedCommandResult) ProtoMessage() {}
+
+func (x *GetSupportedDMResp_SupportedCommandResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedDMResp_SupportedCommandResult.ProtoReflect.Descriptor instead.
+func (*GetSupportedDMResp_SupportedCommandResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9, 3}
+}
+
+func (x *GetSupportedDMResp_SupportedCommandResult) GetCommandName() string {
+	if x != nil {
+		return x.CommandName
+	}
+	return ""
+}
+
+func (x *GetSupportedDMResp_SupportedCommandResult) GetInputArgNames() []string {
+	if x != nil {
+		return x.InputArgNames
+	}
+	return nil
+}
+
+func (x *GetSupportedDMResp_SupportedCommandResult) GetOutputArgNames() []string {
+	if x != nil {
+		return x.OutputArgNames
+	}
+	return nil
+}
+
+func (x *GetSupportedDMResp_SupportedCommandResult) GetCommandType() GetSupportedDMResp_CmdType {
+	if x != nil {
+		return x.CommandType
+	}
+	return GetSupportedDMResp_CMD_UNKNOWN
+}
+
+type GetSupportedDMResp_SupportedEventResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventName     string                 `protobuf:"bytes,1,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	ArgNames      []string               `protobuf:"bytes,2,rep,name=arg_names,json=argNames,proto3" json:"arg_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupportedDMResp_SupportedEventResult) Reset() {
+	*x = GetSupportedDMResp_SupportedEventResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedDMResp_SupportedEventResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedDMResp_SupportedEventResult) ProtoMessage() {}
+
+func (x *GetSupportedDMResp_SupportedEventResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedDMResp_SupportedEventResult.ProtoReflect.Descriptor instead.
+func (*GetSupportedDMResp_SupportedEventResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9, 4}
+}
+
+func (x *GetSupportedDMResp_SupportedEventResult) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *GetSupportedDMResp_SupportedEventResult) GetArgNames() []string {
+	if x != nil {
+		return x.ArgNames
+	}
+	return nil
+}
+
+type GetSupportedDMResp_SupportedUniqueKeySet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyNames      []string               `protobuf:"bytes,1,rep,name=key_names,json=keyNames,proto3" json:"key_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupportedDMResp_SupportedUniqueKeySet) Reset() {
+	*x = GetSupportedDMResp_SupportedUniqueKeySet{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedDMResp_SupportedUniqueKeySet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedDMResp_SupportedUniqueKeySet) ProtoMessage() {}
+
+func (x *GetSupportedDMResp_SupportedUniqueKeySet) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedDMResp_SupportedUniqueKeySet.ProtoReflect.Descriptor instead.
+func (*GetSupportedDMResp_SupportedUniqueKeySet) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{9, 5}
+}
+
+func (x *GetSupportedDMResp_SupportedUniqueKeySet) GetKeyNames() []string {
+	if x != nil {
+		return x.KeyNames
+	}
+	return nil
+}
+
+type GetInstancesResp_RequestedPathResult struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	RequestedPath string                           `protobuf:"bytes,1,opt,name=requested_path,json=requestedPath,proto3" json:"requested_path,omitempty"`
+	ErrCode       uint32                           `protobuf:"fixed32,2,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg        string                           `protobuf:"bytes,3,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	CurrInsts     []*GetInstancesResp_CurrInstance `protobuf:"bytes,4,rep,name=curr_insts,json=currInsts,proto3" json:"curr_insts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstancesResp_RequestedPathResult) Reset() {
+	*x = GetInstancesResp_RequestedPathResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstancesResp_RequestedPathResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstancesResp_RequestedPathResult) ProtoMessage() {}
+
+func (x *GetInstancesResp_RequestedPathResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstancesResp_RequestedPathResult.ProtoReflect.Descriptor instead.
+func (*GetInstancesResp_RequestedPathResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *GetInstancesResp_RequestedPathResult) GetRequestedPath() string {
+	if x != nil {
+		return x.RequestedPath
+	}
+	return ""
+}
+
+func (x *GetInstancesResp_RequestedPathResult) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *GetInstancesResp_RequestedPathResult) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *GetInstancesResp_RequestedPathResult) GetCurrInsts() []*GetInstancesResp_CurrInstance {
+	if x != nil {
+		return x.CurrInsts
+	}
+	return nil
+}
+
+type GetInstancesResp_CurrInstance struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	InstantiatedObjPath string                 `protobuf:"bytes,1,opt,name=instantiated_obj_path,json=instantiatedObjPath,proto3" json:"instantiated_obj_path,omitempty"`
+	UniqueKeys          map[string]string      `protobuf:"bytes,2,rep,name=unique_keys,json=uniqueKeys,proto3" json:"unique_keys,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetInstancesResp_CurrInstance) Reset() {
+	*x = GetInstancesResp_CurrInstance{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstancesResp_CurrInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstancesResp_CurrInstance) ProtoMessage() {}
+
+func (x *GetInstancesResp_CurrInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstancesResp_CurrInstance.ProtoReflect.Descriptor instead.
+func (*GetInstancesResp_CurrInstance) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *GetInstancesResp_CurrInstance) GetInstantiatedObjPath() string {
+	if x != nil {
+		return x.InstantiatedObjPath
+	}
+	return ""
+}
+
+func (x *GetInstancesResp_CurrInstance) GetUniqueKeys() map[string]string {
+	if x != nil {
+		return x.UniqueKeys
+	}
+	return nil
+}
+
+type Add_CreateObject struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	ObjPath       string                    `protobuf:"bytes,1,opt,name=obj_path,json=objPath,proto3" json:"obj_path,omitempty"`
+	ParamSettings []*Add_CreateParamSetting `protobuf:"bytes,2,rep,name=param_settings,json=paramSettings,proto3" json:"param_settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Add_CreateObject) Reset() {
+	*x = Add_CreateObject{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Add_CreateObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Add_CreateObject) ProtoMessage() {}
+
+func (x *Add_CreateObject) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Add_CreateObject.ProtoReflect.Descriptor instead.
+func (*Add_CreateObject) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *Add_CreateObject) GetObjPath() string {
+	if x != nil {
+		return x.ObjPath
+	}
+	return ""
+}
+
+func (x *Add_CreateObject) GetParamSettings() []*Add_CreateParamSetting {
+	if x != nil {
+		return x.ParamSettings
+	}
+	return nil
+}
+
+type Add_CreateParamSetting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Param         string                 `protobuf:"bytes,1,opt,name=param,proto3" json:"param,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Add_CreateParamSetting) Reset() {
+	*x = Add_CreateParamSetting{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Add_CreateParamSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Add_CreateParamSetting) ProtoMessage() {}
+
+func (x *Add_CreateParamSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Add_CreateParamSetting.ProtoReflect.Descriptor instead.
+func (*Add_CreateParamSetting) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{14, 1}
+}
+
+func (x *Add_CreateParamSetting) GetParam() string {
+	if x != nil {
+		return x.Param
+	}
+	return ""
+}
+
+func (x *Add_CreateParamSetting) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Add_CreateParamSetting) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type AddResp_CreatedObjectResult struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	RequestedPath string                                       `protobuf:"bytes,1,opt,name=requested_path,json=requestedPath,proto3" json:"requested_path,omitempty"`
+	OperStatus    *AddResp_CreatedObjectResult_OperationStatus `protobuf:"bytes,2,opt,name=oper_status,json=operStatus,proto3" json:"oper_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddResp_CreatedObjectResult) Reset() {
+	*x = AddResp_CreatedObjectResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddResp_CreatedObjectResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResp_CreatedObjectResult) ProtoMessage() {}
+
+func (x *AddResp_CreatedObjectResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResp_CreatedObjectResult.ProtoReflect.Descriptor instead.
+func (*AddResp_CreatedObjectResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *AddResp_CreatedObjectResult) GetRequestedPath() string {
+	if x != nil {
+		return x.RequestedPath
+	}
+	return ""
+}
+
+func (x *AddResp_CreatedObjectResult) GetOperStatus() *AddResp_CreatedObjectResult_OperationStatus {
+	if x != nil {
+		return x.OperStatus
+	}
+	return nil
+}
+
+type AddResp_ParameterError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Param         string                 `protobuf:"bytes,1,opt,name=param,proto3" json:"param,omitempty"`
+	ErrCode       uint32                 `protobuf:"fixed32,2,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg        string                 `protobuf:"bytes,3,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddResp_ParameterError) Reset() {
+	*x = AddResp_ParameterError{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddResp_ParameterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResp_ParameterError) ProtoMessage() {}
+
+func (x *AddResp_ParameterError) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResp_ParameterError.ProtoReflect.Descriptor instead.
+func (*AddResp_ParameterError) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *AddResp_ParameterError) GetParam() string {
+	if x != nil {
+		return x.Param
+	}
+	return ""
+}
+
+func (x *AddResp_ParameterError) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *AddResp_ParameterError) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type AddResp_CreatedObjectResult_OperationStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to OperStatus:
+	//
+	//	*AddResp_CreatedObjectResult_OperationStatus_OperFailure
+	//	*AddResp_CreatedObjectResult_OperationStatus_OperSuccess
+	OperStatus    isAddResp_CreatedObjectResult_OperationStatus_OperStatus `protobuf_oneof:"oper_status"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus) Reset() {
+	*x = AddResp_CreatedObjectResult_OperationStatus{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResp_CreatedObjectResult_OperationStatus) ProtoMessage() {}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResp_CreatedObjectResult_OperationStatus.ProtoReflect.Descriptor instead.
+func (*AddResp_CreatedObjectResult_OperationStatus) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{15, 0, 0}
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus) GetOperStatus() isAddResp_CreatedObjectResult_OperationStatus_OperStatus {
+	if x != nil {
+		return x.OperStatus
+	}
+	return nil
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus) GetOperFailure() *AddResp_CreatedObjectResult_OperationStatus_OperationFailure {
+	if x != nil {
+		if x, ok := x.OperStatus.(*AddResp_CreatedObjectResult_OperationStatus_OperFailure); ok {
+			return x.OperFailure
+		}
+	}
+	return nil
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus) GetOperSuccess() *AddResp_CreatedObjectResult_OperationStatus_OperationSuccess {
+	if x != nil {
+		if x, ok := x.OperStatus.(*AddResp_CreatedObjectResult_OperationStatus_OperSuccess); ok {
+			return x.OperSuccess
+		}
+	}
+	return nil
+}
+
+type isAddResp_CreatedObjectResult_OperationStatus_OperStatus interface {
+	isAddResp_CreatedObjectResult_OperationStatus_OperStatus()
+}
+
+type AddResp_CreatedObjectResult_OperationStatus_OperFailure struct {
+	OperFailure *AddResp_CreatedObjectResult_OperationStatus_OperationFailure `protobuf:"bytes,1,opt,name=oper_failure,json=operFailure,proto3,oneof"`
+}
+
+type AddResp_CreatedObjectResult_OperationStatus_OperSuccess struct {
+	OperSuccess *AddResp_CreatedObjectResult_OperationStatus_OperationSuccess `protobuf:"bytes,2,opt,name=oper_success,json=operSuccess,proto3,oneof"`
+}
+
+func (*AddResp_CreatedObjectResult_OperationStatus_OperFailure) isAddResp_CreatedObjectResult_OperationStatus_OperStatus() {
+}
+
+func (*AddResp_CreatedObjectResult_OperationStatus_OperSuccess) isAddResp_CreatedObjectResult_OperationStatus_OperStatus() {
+}
+
+type AddResp_CreatedObjectResult_OperationStatus_OperationFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrCode       uint32                 `protobuf:"fixed32,1,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg        string                 `protobuf:"bytes,2,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus_OperationFailure) Reset() {
+	*x = AddResp_CreatedObjectResult_OperationStatus_OperationFailure{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus_OperationFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResp_CreatedObjectResult_OperationStatus_OperationFailure) ProtoMessage() {}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus_OperationFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResp_CreatedObjectResult_OperationStatus_OperationFailure.ProtoReflect.Descriptor instead.
+func (*AddResp_CreatedObjectResult_OperationStatus_OperationFailure) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{15, 0, 0, 0}
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus_OperationFailure) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus_OperationFailure) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type AddResp_CreatedObjectResult_OperationStatus_OperationSuccess struct {
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	InstantiatedPath string                    `protobuf:"bytes,1,opt,name=instantiated_path,json=instantiatedPath,proto3" json:"instantiated_path,omitempty"`
+	ParamErrs        []*AddResp_ParameterError `protobuf:"bytes,2,rep,name=param_errs,json=paramErrs,proto3" json:"param_errs,omitempty"`
+	UniqueKeys       map[string]string         `protobuf:"bytes,3,rep,name=unique_keys,json=uniqueKeys,proto3" json:"unique_keys,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus_OperationSuccess) Reset() {
+	*x = AddResp_CreatedObjectResult_OperationStatus_OperationSuccess{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus_OperationSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddResp_CreatedObjectResult_OperationStatus_OperationSuccess) ProtoMessage() {}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus_OperationSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddResp_CreatedObjectResult_OperationStatus_OperationSuccess.ProtoReflect.Descriptor instead.
+func (*AddResp_CreatedObjectResult_OperationStatus_OperationSuccess) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{15, 0, 0, 1}
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus_OperationSuccess) GetInstantiatedPath() string {
+	if x != nil {
+		return x.InstantiatedPath
+	}
+	return ""
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus_OperationSuccess) GetParamErrs() []*AddResp_ParameterError {
+	if x != nil {
+		return x.ParamErrs
+	}
+	return nil
+}
+
+func (x *AddResp_CreatedObjectResult_OperationStatus_OperationSuccess) GetUniqueKeys() map[string]string {
+	if x != nil {
+		return x.UniqueKeys
+	}
+	return nil
+}
+
+type DeleteResp_DeletedObjectResult struct {
+	state         protoimpl.MessageState                          `protogen:"open.v1"`
+	RequestedPath string                                          `protobuf:"bytes,1,opt,name=requested_path,json=requestedPath,proto3" json:"requested_path,omitempty"`
+	OperStatus    *DeleteResp_DeletedObjectResult_OperationStatus `protobuf:"bytes,2,opt,name=oper_status,json=operStatus,proto3" json:"oper_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResp_DeletedObjectResult) Reset() {
+	*x = DeleteResp_DeletedObjectResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResp_DeletedObjectResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResp_DeletedObjectResult) ProtoMessage() {}
+
+func (x *DeleteResp_DeletedObjectResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResp_DeletedObjectResult.ProtoReflect.Descriptor instead.
+func (*DeleteResp_DeletedObjectResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *DeleteResp_DeletedObjectResult) GetRequestedPath() string {
+	if x != nil {
+		return x.RequestedPath
+	}
+	return ""
+}
+
+func (x *DeleteResp_DeletedObjectResult) GetOperStatus() *DeleteResp_DeletedObjectResult_OperationStatus {
+	if x != nil {
+		return x.OperStatus
+	}
+	return nil
+}
+
+type DeleteResp_UnaffectedPathError struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UnaffectedPath string                 `protobuf:"bytes,1,opt,name=unaffected_path,json=unaffectedPath,proto3" json:"unaffected_path,omitempty"`
+	ErrCode        uint32                 `protobuf:"fixed32,2,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg         string                 `protobuf:"bytes,3,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteResp_UnaffectedPathError) Reset() {
+	*x = DeleteResp_UnaffectedPathError{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResp_UnaffectedPathError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResp_UnaffectedPathError) ProtoMessage() {}
+
+func (x *DeleteResp_UnaffectedPathError) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResp_UnaffectedPathError.ProtoReflect.Descriptor instead.
+func (*DeleteResp_UnaffectedPathError) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *DeleteResp_UnaffectedPathError) GetUnaffectedPath() string {
+	if x != nil {
+		return x.UnaffectedPath
+	}
+	return ""
+}
+
+func (x *DeleteResp_UnaffectedPathError) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *DeleteResp_UnaffectedPathError) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type DeleteResp_DeletedObjectResult_OperationStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to OperStatus:
+	//
+	//	*DeleteResp_DeletedObjectResult_OperationStatus_OperFailure
+	//	*DeleteResp_DeletedObjectResult_OperationStatus_OperSuccess
+	OperStatus    isDeleteResp_DeletedObjectResult_OperationStatus_OperStatus `protobuf_oneof:"oper_status"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus) Reset() {
+	*x = DeleteResp_DeletedObjectResult_OperationStatus{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResp_DeletedObjectResult_OperationStatus) ProtoMessage() {}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResp_DeletedObjectResult_OperationStatus.ProtoReflect.Descriptor instead.
+func (*DeleteResp_DeletedObjectResult_OperationStatus) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{17, 0, 0}
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus) GetOperStatus() isDeleteResp_DeletedObjectResult_OperationStatus_OperStatus {
+	if x != nil {
+		return x.OperStatus
+	}
+	return nil
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus) GetOperFailure() *DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure {
+	if x != nil {
+		if x, ok := x.OperStatus.(*DeleteResp_DeletedObjectResult_OperationStatus_OperFailure); ok {
+			return x.OperFailure
+		}
+	}
+	return nil
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus) GetOperSuccess() *DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess {
+	if x != nil {
+		if x, ok := x.OperStatus.(*DeleteResp_DeletedObjectResult_OperationStatus_OperSuccess); ok {
+			return x.OperSuccess
+		}
+	}
+	return nil
+}
+
+type isDeleteResp_DeletedObjectResult_OperationStatus_OperStatus interface {
+	isDeleteResp_DeletedObjectResult_OperationStatus_OperStatus()
+}
+
+type DeleteResp_DeletedObjectResult_OperationStatus_OperFailure struct {
+	OperFailure *DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure `protobuf:"bytes,1,opt,name=oper_failure,json=operFailure,proto3,oneof"`
+}
+
+type DeleteResp_DeletedObjectResult_OperationStatus_OperSuccess struct {
+	OperSuccess *DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess `protobuf:"bytes,2,opt,name=oper_success,json=operSuccess,proto3,oneof"`
+}
+
+func (*DeleteResp_DeletedObjectResult_OperationStatus_OperFailure) isDeleteResp_DeletedObjectResult_OperationStatus_OperStatus() {
+}
+
+func (*DeleteResp_DeletedObjectResult_OperationStatus_OperSuccess) isDeleteResp_DeletedObjectResult_OperationStatus_OperStatus() {
+}
+
+type DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrCode       uint32                 `protobuf:"fixed32,1,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg        string                 `protobuf:"bytes,2,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure) Reset() {
+	*x = DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure) ProtoMessage() {}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure.ProtoReflect.Descriptor instead.
+func (*DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{17, 0, 0, 0}
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess struct {
+	state              protoimpl.MessageState            `protogen:"open.v1"`
+	AffectedPaths      []string                          `protobuf:"bytes,1,rep,name=affected_paths,json=affectedPaths,proto3" json:"affected_paths,omitempty"`
+	UnaffectedPathErrs []*DeleteResp_UnaffectedPathError `protobuf:"bytes,2,rep,name=unaffected_path_errs,json=unaffectedPathErrs,proto3" json:"unaffected_path_errs,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess) Reset() {
+	*x = DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess) ProtoMessage() {}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess.ProtoReflect.Descriptor instead.
+func (*DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{17, 0, 0, 1}
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess) GetAffectedPaths() []string {
+	if x != nil {
+		return x.AffectedPaths
+	}
+	return nil
+}
+
+func (x *DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess) GetUnaffectedPathErrs() []*DeleteResp_UnaffectedPathError {
+	if x != nil {
+		return x.UnaffectedPathErrs
+	}
+	return nil
+}
+
+type Set_UpdateObject struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	ObjPath       string                    `protobuf:"bytes,1,opt,name=obj_path,json=objPath,proto3" json:"obj_path,omitempty"`
+	ParamSettings []*Set_UpdateParamSetting `protobuf:"bytes,2,rep,name=param_settings,json=paramSettings,proto3" json:"param_settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Set_UpdateObject) Reset() {
+	*x = Set_UpdateObject{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Set_UpdateObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Set_UpdateObject) ProtoMessage() {}
+
+func (x *Set_UpdateObject) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Set_UpdateObject.ProtoReflect.Descriptor instead.
+func (*Set_UpdateObject) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{18, 0}
+}
+
+func (x *Set_UpdateObject) GetObjPath() string {
+	if x != nil {
+		return x.ObjPath
+	}
+	return ""
+}
+
+func (x *Set_UpdateObject) GetParamSettings() []*Set_UpdateParamSetting {
+	if x != nil {
+		return x.ParamSettings
+	}
+	return nil
+}
+
+type Set_UpdateParamSetting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Param         string                 `protobuf:"bytes,1,opt,name=param,proto3" json:"param,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Set_UpdateParamSetting) Reset() {
+	*x = Set_UpdateParamSetting{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Set_UpdateParamSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Set_UpdateParamSetting) ProtoMessage() {}
+
+func (x *Set_UpdateParamSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Set_UpdateParamSetting.ProtoReflect.Descriptor instead.
+func (*Set_UpdateParamSetting) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{18, 1}
+}
+
+func (x *Set_UpdateParamSetting) GetParam() string {
+	if x != nil {
+		return x.Param
+	}
+	return ""
+}
+
+func (x *Set_UpdateParamSetting) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Set_UpdateParamSetting) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type SetResp_UpdatedObjectResult struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	RequestedPath string                                       `protobuf:"bytes,1,opt,name=requested_path,json=requestedPath,proto3" json:"requested_path,omitempty"`
+	OperStatus    *SetResp_UpdatedObjectResult_OperationStatus `protobuf:"bytes,2,opt,name=oper_status,json=operStatus,proto3" json:"oper_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResp_UpdatedObjectResult) Reset() {
+	*x = SetResp_UpdatedObjectResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResp_UpdatedObjectResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResp_UpdatedObjectResult) ProtoMessage() {}
+
+func (x *SetResp_UpdatedObjectResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResp_UpdatedObjectResult.ProtoReflect.Descriptor instead.
+func (*SetResp_UpdatedObjectResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *SetResp_UpdatedObjectResult) GetRequestedPath() string {
+	if x != nil {
+		return x.RequestedPath
+	}
+	return ""
+}
+
+func (x *SetResp_UpdatedObjectResult) GetOperStatus() *SetResp_UpdatedObjectResult_OperationStatus {
+	if x != nil {
+		return x.OperStatus
+	}
+	return nil
+}
+
+type SetResp_UpdatedInstanceFailure struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	AffectedPath  string                    `protobuf:"bytes,1,opt,name=affected_path,json=affectedPath,proto3" json:"affected_path,omitempty"`
+	ParamErrs     []*SetResp_ParameterError `protobuf:"bytes,2,rep,name=param_errs,json=paramErrs,proto3" json:"param_errs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResp_UpdatedInstanceFailure) Reset() {
+	*x = SetResp_UpdatedInstanceFailure{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResp_UpdatedInstanceFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResp_UpdatedInstanceFailure) ProtoMessage() {}
+
+func (x *SetResp_UpdatedInstanceFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResp_UpdatedInstanceFailure.ProtoReflect.Descriptor instead.
+func (*SetResp_UpdatedInstanceFailure) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *SetResp_UpdatedInstanceFailure) GetAffectedPath() string {
+	if x != nil {
+		return x.AffectedPath
+	}
+	return ""
+}
+
+func (x *SetResp_UpdatedInstanceFailure) GetParamErrs() []*SetResp_ParameterError {
+	if x != nil {
+		return x.ParamErrs
+	}
+	return nil
+}
+
+type SetResp_UpdatedInstanceResult struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	AffectedPath  string                    `protobuf:"bytes,1,opt,name=affected_path,json=affectedPath,proto3" json:"affected_path,omitempty"`
+	ParamErrs     []*SetResp_ParameterError `protobuf:"bytes,2,rep,name=param_errs,json=paramErrs,proto3" json:"param_errs,omitempty"`
+	UpdatedParams map[string]string         `protobuf:"bytes,3,rep,name=updated_params,json=updatedParams,proto3" json:"updated_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResp_UpdatedInstanceResult) Reset() {
+	*x = SetResp_UpdatedInstanceResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResp_UpdatedInstanceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResp_UpdatedInstanceResult) ProtoMessage() {}
+
+func (x *SetResp_UpdatedInstanceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResp_UpdatedInstanceResult.ProtoReflect.Descriptor instead.
+func (*SetResp_UpdatedInstanceResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{19, 2}
+}
+
+func (x *SetResp_UpdatedInstanceResult) GetAffectedPath() string {
+	if x != nil {
+		return x.AffectedPath
+	}
+	return ""
+}
+
+func (x *SetResp_UpdatedInstanceResult) GetParamErrs() []*SetResp_ParameterError {
+	if x != nil {
+		return x.ParamErrs
+	}
+	return nil
+}
+
+func (x *SetResp_UpdatedInstanceResult) GetUpdatedParams() map[string]string {
+	if x != nil {
+		return x.UpdatedParams
+	}
+	return nil
+}
+
+type SetResp_ParameterError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Param         string                 `protobuf:"bytes,1,opt,name=param,proto3" json:"param,omitempty"`
+	ErrCode       uint32                 `protobuf:"fixed32,2,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg        string                 `protobuf:"bytes,3,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResp_ParameterError) Reset() {
+	*x = SetResp_ParameterError{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResp_ParameterError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResp_ParameterError) ProtoMessage() {}
+
+func (x *SetResp_ParameterError) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResp_ParameterError.ProtoReflect.Descriptor instead.
+func (*SetResp_ParameterError) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{19, 3}
+}
+
+func (x *SetResp_ParameterError) GetParam() string {
+	if x != nil {
+		return x.Param
+	}
+	return ""
+}
+
+func (x *SetResp_ParameterError) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *SetResp_ParameterError) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type SetResp_UpdatedObjectResult_OperationStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to OperStatus:
+	//
+	//	*SetResp_UpdatedObjectResult_OperationStatus_OperFailure
+	//	*SetResp_UpdatedObjectResult_OperationStatus_OperSuccess
+	OperStatus    isSetResp_UpdatedObjectResult_OperationStatus_OperStatus `protobuf_oneof:"oper_status"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus) Reset() {
+	*x = SetResp_UpdatedObjectResult_OperationStatus{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResp_UpdatedObjectResult_OperationStatus) ProtoMessage() {}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResp_UpdatedObjectResult_OperationStatus.ProtoReflect.Descriptor instead.
+func (*SetResp_UpdatedObjectResult_OperationStatus) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{19, 0, 0}
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus) GetOperStatus() isSetResp_UpdatedObjectResult_OperationStatus_OperStatus {
+	if x != nil {
+		return x.OperStatus
+	}
+	return nil
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus) GetOperFailure() *SetResp_UpdatedObjectResult_OperationStatus_OperationFailure {
+	if x != nil {
+		if x, ok := x.OperStatus.(*SetResp_UpdatedObjectResult_OperationStatus_OperFailure); ok {
+			return x.OperFailure
+		}
+	}
+	return nil
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus) GetOperSuccess() *SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess {
+	if x != nil {
+		if x, ok := x.OperStatus.(*SetResp_UpdatedObjectResult_OperationStatus_OperSuccess); ok {
+			return x.OperSuccess
+		}
+	}
+	return nil
+}
+
+type isSetResp_UpdatedObjectResult_OperationStatus_OperStatus interface {
+	isSetResp_UpdatedObjectResult_OperationStatus_OperStatus()
+}
+
+type SetResp_UpdatedObjectResult_OperationStatus_OperFailure struct {
+	OperFailure *SetResp_UpdatedObjectResult_OperationStatus_OperationFailure `protobuf:"bytes,1,opt,name=oper_failure,json=operFailure,proto3,oneof"`
+}
+
+type SetResp_UpdatedObjectResult_OperationStatus_OperSuccess struct {
+	OperSuccess *SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess `protobuf:"bytes,2,opt,name=oper_success,json=operSuccess,proto3,oneof"`
+}
+
+func (*SetResp_UpdatedObjectResult_OperationStatus_OperFailure) isSetResp_UpdatedObjectResult_OperationStatus_OperStatus() {
+}
+
+func (*SetResp_UpdatedObjectResult_OperationStatus_OperSuccess) isSetResp_UpdatedObjectResult_OperationStatus_OperStatus() {
+}
+
+type SetResp_UpdatedObjectResult_OperationStatus_OperationFailure struct {
+	state               protoimpl.MessageState            `protogen:"open.v1"`
+	ErrCode             uint32                            `protobuf:"fixed32,1,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg              string                            `protobuf:"bytes,2,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	UpdatedInstFailures []*SetResp_UpdatedInstanceFailure `protobuf:"bytes,3,rep,name=updated_inst_failures,json=updatedInstFailures,proto3" json:"updated_inst_failures,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus_OperationFailure) Reset() {
+	*x = SetResp_UpdatedObjectResult_OperationStatus_OperationFailure{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus_OperationFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResp_UpdatedObjectResult_OperationStatus_OperationFailure) ProtoMessage() {}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus_OperationFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResp_UpdatedObjectResult_OperationStatus_OperationFailure.ProtoReflect.Descriptor instead.
+func (*SetResp_UpdatedObjectResult_OperationStatus_OperationFailure) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{19, 0, 0, 0}
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus_OperationFailure) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus_OperationFailure) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus_OperationFailure) GetUpdatedInstFailures() []*SetResp_UpdatedInstanceFailure {
+	if x != nil {
+		return x.UpdatedInstFailures
+	}
+	return nil
+}
+
+type SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess struct {
+	state              protoimpl.MessageState           `protogen:"open.v1"`
+	UpdatedInstResults []*SetResp_UpdatedInstanceResult `protobuf:"bytes,1,rep,name=updated_inst_results,json=updatedInstResults,proto3" json:"updated_inst_results,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess) Reset() {
+	*x = SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess) ProtoMessage() {}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess.ProtoReflect.Descriptor instead.
+func (*SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{19, 0, 0, 1}
+}
+
+func (x *SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess) GetUpdatedInstResults() []*SetResp_UpdatedInstanceResult {
+	if x != nil {
+		return x.UpdatedInstResults
+	}
+	return nil
+}
+
+type OperateResp_OperationResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ExecutedCommand string                 `protobuf:"bytes,1,opt,name=executed_command,json=executedCommand,proto3" json:"executed_command,omitempty"`
+	// Types that are valid to be assigned to OperationResp:
+	//
+	//	*OperateResp_OperationResult_ReqObjPath
+	//	*OperateResp_OperationResult_ReqOutputArgs
+	//	*OperateResp_OperationResult_CmdFailure
+	OperationResp isOperateResp_OperationResult_OperationResp `protobuf_oneof:"operation_resp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperateResp_OperationResult) Reset() {
+	*x = OperateResp_OperationResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperateResp_OperationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateResp_OperationResult) ProtoMessage() {}
+
+func (x *OperateResp_OperationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateResp_OperationResult.ProtoReflect.Descriptor instead.
+func (*OperateResp_OperationResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *OperateResp_OperationResult) GetExecutedCommand() string {
+	if x != nil {
+		return x.ExecutedCommand
+	}
+	return ""
+}
+
+func (x *OperateResp_OperationResult) GetOperationResp() isOperateResp_OperationResult_OperationResp {
+	if x != nil {
+		return x.OperationResp
+	}
+	return nil
+}
+
+func (x *OperateResp_OperationResult) GetReqObjPath() string {
+	if x != nil {
+		if x, ok := x.OperationResp.(*OperateResp_OperationResult_ReqObjPath); ok {
+			return x.ReqObjPath
+		}
+	}
+	return ""
+}
+
+func (x *OperateResp_OperationResult) GetReqOutputArgs() *OperateResp_OperationResult_OutputArgs {
+	if x != nil {
+		if x, ok := x.OperationResp.(*OperateResp_OperationResult_ReqOutputArgs); ok {
+			return x.ReqOutputArgs
+		}
+	}
+	return nil
+}
+
+func (x *OperateResp_OperationResult) GetCmdFailure() *OperateResp_OperationResult_CommandFailure {
+	if x != nil {
+		if x, ok := x.OperationResp.(*OperateResp_OperationResult_CmdFailure); ok {
+			return x.CmdFailure
+		}
+	}
+	return nil
+}
+
+type isOperateResp_OperationResult_OperationResp interface {
+	isOperateResp_OperationResult_OperationResp()
+}
+
+type OperateResp_OperationResult_ReqObjPath struct {
+	ReqObjPath string `protobuf:"bytes,2,opt,name=req_obj_path,json=reqObjPath,proto3,oneof"`
+}
+
+type OperateResp_OperationResult_ReqOutputArgs struct {
+	ReqOutputArgs *OperateResp_OperationResult_OutputArgs `protobuf:"bytes,3,opt,name=req_output_args,json=reqOutputArgs,proto3,oneof"`
+}
+
+type OperateResp_OperationResult_CmdFailure struct {
+	CmdFailure *OperateResp_OperationResult_CommandFailure `protobuf:"bytes,4,opt,name=cmd_failure,json=cmdFailure,proto3,oneof"`
+}
+
+func (*OperateResp_OperationResult_ReqObjPath) isOperateResp_OperationResult_OperationResp() {}
+
+func (*OperateResp_OperationResult_ReqOutputArgs) isOperateResp_OperationResult_OperationResp() {}
+
+func (*OperateResp_OperationResult_CmdFailure) isOperateResp_OperationResult_OperationResp() {}
+
+type OperateResp_OperationResult_OutputArgs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OutputArgs    map[string]string      `protobuf:"bytes,1,rep,name=output_args,json=outputArgs,proto3" json:"output_args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperateResp_OperationResult_OutputArgs) Reset() {
+	*x = OperateResp_OperationResult_OutputArgs{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperateResp_OperationResult_OutputArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateResp_OperationResult_OutputArgs) ProtoMessage() {}
+
+func (x *OperateResp_OperationResult_OutputArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateResp_OperationResult_OutputArgs.ProtoReflect.Descriptor instead.
+func (*OperateResp_OperationResult_OutputArgs) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{21, 0, 0}
+}
+
+func (x *OperateResp_OperationResult_OutputArgs) GetOutputArgs() map[string]string {
+	if x != nil {
+		return x.OutputArgs
+	}
+	return nil
+}
+
+type OperateResp_OperationResult_CommandFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrCode       uint32                 `protobuf:"fixed32,1,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg        string                 `protobuf:"bytes,2,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OperateResp_OperationResult_CommandFailure) Reset() {
+	*x = OperateResp_OperationResult_CommandFailure{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperateResp_OperationResult_CommandFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperateResp_OperationResult_CommandFailure) ProtoMessage() {}
+
+func (x *OperateResp_OperationResult_CommandFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperateResp_OperationResult_CommandFailure.ProtoReflect.Descriptor instead.
+func (*OperateResp_OperationResult_CommandFailure) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{21, 0, 1}
+}
+
+func (x *OperateResp_OperationResult_CommandFailure) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *OperateResp_OperationResult_CommandFailure) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type Notify_Event struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjPath       string                 `protobuf:"bytes,1,opt,name=obj_path,json=objPath,proto3" json:"obj_path,omitempty"`
+	EventName     string                 `protobuf:"bytes,2,opt,name=event_name,json=eventName,proto3" json:"event_name,omitempty"`
+	Params        map[string]string      `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notify_Event) Reset() {
+	*x = Notify_Event{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notify_Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notify_Event) ProtoMessage() {}
+
+func (x *Notify_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notify_Event.ProtoReflect.Descriptor instead.
+func (*Notify_Event) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *Notify_Event) GetObjPath() string {
+	if x != nil {
+		return x.ObjPath
+	}
+	return ""
+}
+
+func (x *Notify_Event) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *Notify_Event) GetParams() map[string]string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type Notify_ValueChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ParamPath     string                 `protobuf:"bytes,1,opt,name=param_path,json=paramPath,proto3" json:"param_path,omitempty"`
+	ParamValue    string                 `protobuf:"bytes,2,opt,name=param_value,json=paramValue,proto3" json:"param_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notify_ValueChange) Reset() {
+	*x = Notify_ValueChange{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notify_ValueChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notify_ValueChange) ProtoMessage() {}
+
+func (x *Notify_ValueChange) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notify_ValueChange.ProtoReflect.Descriptor instead.
+func (*Notify_ValueChange) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{22, 1}
+}
+
+func (x *Notify_ValueChange) GetParamPath() string {
+	if x != nil {
+		return x.ParamPath
+	}
+	return ""
+}
+
+func (x *Notify_ValueChange) GetParamValue() string {
+	if x != nil {
+		return x.ParamValue
+	}
+	return ""
+}
+
+type Notify_ObjectCreation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjPath       string                 `protobuf:"bytes,1,opt,name=obj_path,json=objPath,proto3" json:"obj_path,omitempty"`
+	UniqueKeys    map[string]string      `protobuf:"bytes,2,rep,name=unique_keys,json=uniqueKeys,proto3" json:"unique_keys,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notify_ObjectCreation) Reset() {
+	*x = Notify_ObjectCreation{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notify_ObjectCreation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notify_ObjectCreation) ProtoMessage() {}
+
+func (x *Notify_ObjectCreation) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notify_ObjectCreation.ProtoReflect.Descriptor instead.
+func (*Notify_ObjectCreation) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{22, 2}
+}
+
+func (x *Notify_ObjectCreation) GetObjPath() string {
+	if x != nil {
+		return x.ObjPath
+	}
+	return ""
+}
+
+func (x *Notify_ObjectCreation) GetUniqueKeys() map[string]string {
+	if x != nil {
+		return x.UniqueKeys
+	}
+	return nil
+}
+
+type Notify_ObjectDeletion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ObjPath       string                 `protobuf:"bytes,1,opt,name=obj_path,json=objPath,proto3" json:"obj_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notify_ObjectDeletion) Reset() {
+	*x = Notify_ObjectDeletion{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notify_ObjectDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notify_ObjectDeletion) ProtoMessage() {}
+
+func (x *Notify_ObjectDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notify_ObjectDeletion.ProtoReflect.Descriptor instead.
+func (*Notify_ObjectDeletion) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{22, 3}
+}
+
+func (x *Notify_ObjectDeletion) GetObjPath() string {
+	if x != nil {
+		return x.ObjPath
+	}
+	return ""
+}
+
+type Notify_OperationComplete struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ObjPath     string                 `protobuf:"bytes,1,opt,name=obj_path,json=objPath,proto3" json:"obj_path,omitempty"`
+	CommandName string                 `protobuf:"bytes,2,opt,name=command_name,json=commandName,proto3" json:"command_name,omitempty"`
+	CommandKey  string                 `protobuf:"bytes,3,opt,name=command_key,json=commandKey,proto3" json:"command_key,omitempty"`
+	// Types that are valid to be assigned to OperationResp:
+	//
+	//	*Notify_OperationComplete_ReqOutputArgs
+	//	*Notify_OperationComplete_CmdFailure
+	OperationResp isNotify_OperationComplete_OperationResp `protobuf_oneof:"operation_resp"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notify_OperationComplete) Reset() {
+	*x = Notify_OperationComplete{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notify_OperationComplete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notify_OperationComplete) ProtoMessage() {}
+
+func (x *Notify_OperationComplete) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notify_OperationComplete.ProtoReflect.Descriptor instead.
+func (*Notify_OperationComplete) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{22, 4}
+}
+
+func (x *Notify_OperationComplete) GetObjPath() string {
+	if x != nil {
+		return x.ObjPath
+	}
+	return ""
+}
+
+func (x *Notify_OperationComplete) GetCommandName() string {
+	if x != nil {
+		return x.CommandName
+	}
+	return ""
+}
+
+func (x *Notify_OperationComplete) GetCommandKey() string {
+	if x != nil {
+		return x.CommandKey
+	}
+	return ""
+}
+
+func (x *Notify_OperationComplete) GetOperationResp() isNotify_OperationComplete_OperationResp {
+	if x != nil {
+		return x.OperationResp
+	}
+	return nil
+}
+
+func (x *Notify_OperationComplete) GetReqOutputArgs() *Notify_OperationComplete_OutputArgs {
+	if x != nil {
+		if x, ok := x.OperationResp.(*Notify_OperationComplete_ReqOutputArgs); ok {
+			return x.ReqOutputArgs
+		}
+	}
+	return nil
+}
+
+func (x *Notify_OperationComplete) GetCmdFailure() *Notify_OperationComplete_CommandFailure {
+	if x != nil {
+		if x, ok := x.OperationResp.(*Notify_OperationComplete_CmdFailure); ok {
+			return x.CmdFailure
+		}
+	}
+	return nil
+}
+
+type isNotify_OperationComplete_OperationResp interface {
+	isNotify_OperationComplete_OperationResp()
+}
+
+type Notify_OperationComplete_ReqOutputArgs struct {
+	ReqOutputArgs *Notify_OperationComplete_OutputArgs `protobuf:"bytes,4,opt,name=req_output_args,json=reqOutputArgs,proto3,oneof"`
+}
+
+type Notify_OperationComplete_CmdFailure struct {
+	CmdFailure *Notify_OperationComplete_CommandFailure `protobuf:"bytes,5,opt,name=cmd_failure,json=cmdFailure,proto3,oneof"`
+}
+
+func (*Notify_OperationComplete_ReqOutputArgs) isNotify_OperationComplete_OperationResp() {}
+
+func (*Notify_OperationComplete_CmdFailure) isNotify_OperationComplete_OperationResp() {}
+
+type Notify_OnBoardRequest struct {
+	state                          protoimpl.MessageState `protogen:"open.v1"`
+	Oui                            string                 `protobuf:"bytes,1,opt,name=oui,proto3" json:"oui,omitempty"`
+	ProductClass                   string                 `protobuf:"bytes,2,opt,name=product_class,json=productClass,proto3" json:"product_class,omitempty"`
+	SerialNumber                   string                 `protobuf:"bytes,3,opt,name=serial_number,json=serialNumber,proto3" json:"serial_number,omitempty"`
+	AgentSupportedProtocolVersions string                 `protobuf:"bytes,4,opt,name=agent_supported_protocol_versions,json=agentSupportedProtocolVersions,proto3" json:"agent_supported_protocol_versions,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *Notify_OnBoardRequest) Reset() {
+	*x = Notify_OnBoardRequest{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notify_OnBoardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notify_OnBoardRequest) ProtoMessage() {}
+
+func (x *Notify_OnBoardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notify_OnBoardRequest.ProtoReflect.Descriptor instead.
+func (*Notify_OnBoardRequest) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{22, 5}
+}
+
+func (x *Notify_OnBoardRequest) GetOui() string {
+	if x != nil {
+		return x.Oui
+	}
+	return ""
+}
+
+func (x *Notify_OnBoardRequest) GetProductClass() string {
+	if x != nil {
+		return x.ProductClass
+	}
+	return ""
+}
+
+func (x *Notify_OnBoardRequest) GetSerialNumber() string {
+	if x != nil {
+		return x.SerialNumber
+	}
+	return ""
+}
+
+func (x *Notify_OnBoardRequest) GetAgentSupportedProtocolVersions() string {
+	if x != nil {
+		return x.AgentSupportedProtocolVersions
+	}
+	return ""
+}
+
+type Notify_OperationComplete_OutputArgs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OutputArgs    map[string]string      `protobuf:"bytes,1,rep,name=output_args,json=outputArgs,proto3" json:"output_args,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notify_OperationComplete_OutputArgs) Reset() {
+	*x = Notify_OperationComplete_OutputArgs{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notify_OperationComplete_OutputArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notify_OperationComplete_OutputArgs) ProtoMessage() {}
+
+func (x *Notify_OperationComplete_OutputArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notify_OperationComplete_OutputArgs.ProtoReflect.Descriptor instead.
+func (*Notify_OperationComplete_OutputArgs) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{22, 4, 0}
+}
+
+func (x *Notify_OperationComplete_OutputArgs) GetOutputArgs() map[string]string {
+	if x != nil {
+		return x.OutputArgs
+	}
+	return nil
+}
+
+type Notify_OperationComplete_CommandFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrCode       uint32                 `protobuf:"fixed32,1,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg        string                 `protobuf:"bytes,2,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notify_OperationComplete_CommandFailure) Reset() {
+	*x = Notify_OperationComplete_CommandFailure{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notify_OperationComplete_CommandFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notify_OperationComplete_CommandFailure) ProtoMessage() {}
+
+func (x *Notify_OperationComplete_CommandFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notify_OperationComplete_CommandFailure.ProtoReflect.Descriptor instead.
+func (*Notify_OperationComplete_CommandFailure) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{22, 4, 1}
+}
+
+func (x *Notify_OperationComplete_CommandFailure) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *Notify_OperationComplete_CommandFailure) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type Register_RegistrationPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Register_RegistrationPath) Reset() {
+	*x = Register_RegistrationPath{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Register_RegistrationPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Register_RegistrationPath) ProtoMessage() {}
+
+func (x *Register_RegistrationPath) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Register_RegistrationPath.ProtoReflect.Descriptor instead.
+func (*Register_RegistrationPath) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *Register_RegistrationPath) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RegisterResp_RegisteredPathResult struct {
+	state         protoimpl.MessageState                             `protogen:"open.v1"`
+	RequestedPath string                                             `protobuf:"bytes,1,opt,name=requested_path,json=requestedPath,proto3" json:"requested_path,omitempty"`
+	OperStatus    *RegisterResp_RegisteredPathResult_OperationStatus `protobuf:"bytes,2,opt,name=oper_status,json=operStatus,proto3" json:"oper_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResp_RegisteredPathResult) Reset() {
+	*x = RegisterResp_RegisteredPathResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResp_RegisteredPathResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResp_RegisteredPathResult) ProtoMessage() {}
+
+func (x *RegisterResp_RegisteredPathResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResp_RegisteredPathResult.ProtoReflect.Descriptor instead.
+func (*RegisterResp_RegisteredPathResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *RegisterResp_RegisteredPathResult) GetRequestedPath() string {
+	if x != nil {
+		return x.RequestedPath
+	}
+	return ""
+}
+
+func (x *RegisterResp_RegisteredPathResult) GetOperStatus() *RegisterResp_RegisteredPathResult_OperationStatus {
+	if x != nil {
+		return x.OperStatus
+	}
+	return nil
+}
+
+type RegisterResp_RegisteredPathResult_OperationStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to OperStatus:
+	//
+	//	*RegisterResp_RegisteredPathResult_OperationStatus_OperFailure
+	//	*RegisterResp_RegisteredPathResult_OperationStatus_OperSuccess
+	OperStatus    isRegisterResp_RegisteredPathResult_OperationStatus_OperStatus `protobuf_oneof:"oper_status"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus) Reset() {
+	*x = RegisterResp_RegisteredPathResult_OperationStatus{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResp_RegisteredPathResult_OperationStatus) ProtoMessage() {}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResp_RegisteredPathResult_OperationStatus.ProtoReflect.Descriptor instead.
+func (*RegisterResp_RegisteredPathResult_OperationStatus) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{25, 0, 0}
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus) GetOperStatus() isRegisterResp_RegisteredPathResult_OperationStatus_OperStatus {
+	if x != nil {
+		return x.OperStatus
+	}
+	return nil
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus) GetOperFailure() *RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure {
+	if x != nil {
+		if x, ok := x.OperStatus.(*RegisterResp_RegisteredPathResult_OperationStatus_OperFailure); ok {
+			return x.OperFailure
+		}
+	}
+	return nil
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus) GetOperSuccess() *RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess {
+	if x != nil {
+		if x, ok := x.OperStatus.(*RegisterResp_RegisteredPathResult_OperationStatus_OperSuccess); ok {
+			return x.OperSuccess
+		}
+	}
+	return nil
+}
+
+type isRegisterResp_RegisteredPathResult_OperationStatus_OperStatus interface {
+	isRegisterResp_RegisteredPathResult_OperationStatus_OperStatus()
+}
+
+type RegisterResp_RegisteredPathResult_OperationStatus_OperFailure struct {
+	OperFailure *RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure `protobuf:"bytes,1,opt,name=oper_failure,json=operFailure,proto3,oneof"`
+}
+
+type RegisterResp_RegisteredPathResult_OperationStatus_OperSuccess struct {
+	OperSuccess *RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess `protobuf:"bytes,2,opt,name=oper_success,json=operSuccess,proto3,oneof"`
+}
+
+func (*RegisterResp_RegisteredPathResult_OperationStatus_OperFailure) isRegisterResp_RegisteredPathResult_OperationStatus_OperStatus() {
+}
+
+func (*RegisterResp_RegisteredPathResult_OperationStatus_OperSuccess) isRegisterResp_RegisteredPathResult_OperationStatus_OperStatus() {
+}
+
+type RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrCode       uint32                 `protobuf:"fixed32,1,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg        string                 `protobuf:"bytes,2,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure) Reset() {
+	*x = RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure) ProtoMessage() {}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure.ProtoReflect.Descriptor instead.
+func (*RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{25, 0, 0, 0}
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RegisteredPath string                 `protobuf:"bytes,1,opt,name=registered_path,json=registeredPath,proto3" json:"registered_path,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess) Reset() {
+	*x = RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess) ProtoMessage() {}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess.ProtoReflect.Descriptor instead.
+func (*RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{25, 0, 0, 1}
+}
+
+func (x *RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess) GetRegisteredPath() string {
+	if x != nil {
+		return x.RegisteredPath
+	}
+	return ""
+}
+
+type DeregisterResp_DeregisteredPathResult struct {
+	state         protoimpl.MessageState                                 `protogen:"open.v1"`
+	RequestedPath string                                                 `protobuf:"bytes,1,opt,name=requested_path,json=requestedPath,proto3" json:"requested_path,omitempty"`
+	OperStatus    *DeregisterResp_DeregisteredPathResult_OperationStatus `protobuf:"bytes,2,opt,name=oper_status,json=operStatus,proto3" json:"oper_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeregisterResp_DeregisteredPathResult) Reset() {
+	*x = DeregisterResp_DeregisteredPathResult{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeregisterResp_DeregisteredPathResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterResp_DeregisteredPathResult) ProtoMessage() {}
+
+func (x *DeregisterResp_DeregisteredPathResult) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterResp_DeregisteredPathResult.ProtoReflect.Descriptor instead.
+func (*DeregisterResp_DeregisteredPathResult) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *DeregisterResp_DeregisteredPathResult) GetRequestedPath() string {
+	if x != nil {
+		return x.RequestedPath
+	}
+	return ""
+}
+
+func (x *DeregisterResp_DeregisteredPathResult) GetOperStatus() *DeregisterResp_DeregisteredPathResult_OperationStatus {
+	if x != nil {
+		return x.OperStatus
+	}
+	return nil
+}
+
+type DeregisterResp_DeregisteredPathResult_OperationStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to OperStatus:
+	//
+	//	*DeregisterResp_DeregisteredPathResult_OperationStatus_OperFailure
+	//	*DeregisterResp_DeregisteredPathResult_OperationStatus_OperSuccess
+	OperStatus    isDeregisterResp_DeregisteredPathResult_OperationStatus_OperStatus `protobuf_oneof:"oper_status"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus) Reset() {
+	*x = DeregisterResp_DeregisteredPathResult_OperationStatus{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterResp_DeregisteredPathResult_OperationStatus) ProtoMessage() {}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterResp_DeregisteredPathResult_OperationStatus.ProtoReflect.Descriptor instead.
+func (*DeregisterResp_DeregisteredPathResult_OperationStatus) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{27, 0, 0}
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus) GetOperStatus() isDeregisterResp_DeregisteredPathResult_OperationStatus_OperStatus {
+	if x != nil {
+		return x.OperStatus
+	}
+	return nil
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus) GetOperFailure() *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure {
+	if x != nil {
+		if x, ok := x.OperStatus.(*DeregisterResp_DeregisteredPathResult_OperationStatus_OperFailure); ok {
+			return x.OperFailure
+		}
+	}
+	return nil
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus) GetOperSuccess() *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess {
+	if x != nil {
+		if x, ok := x.OperStatus.(*DeregisterResp_DeregisteredPathResult_OperationStatus_OperSuccess); ok {
+			return x.OperSuccess
+		}
+	}
+	return nil
+}
+
+type isDeregisterResp_DeregisteredPathResult_OperationStatus_OperStatus interface {
+	isDeregisterResp_DeregisteredPathResult_OperationStatus_OperStatus()
+}
+
+type DeregisterResp_DeregisteredPathResult_OperationStatus_OperFailure struct {
+	OperFailure *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure `protobuf:"bytes,1,opt,name=oper_failure,json=operFailure,proto3,oneof"`
+}
+
+type DeregisterResp_DeregisteredPathResult_OperationStatus_OperSuccess struct {
+	OperSuccess *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess `protobuf:"bytes,2,opt,name=oper_success,json=operSuccess,proto3,oneof"`
+}
+
+func (*DeregisterResp_DeregisteredPathResult_OperationStatus_OperFailure) isDeregisterResp_DeregisteredPathResult_OperationStatus_OperStatus() {
+}
+
+func (*DeregisterResp_DeregisteredPathResult_OperationStatus_OperSuccess) isDeregisterResp_DeregisteredPathResult_OperationStatus_OperStatus() {
+}
+
+type DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ErrCode       uint32                 `protobuf:"fixed32,1,opt,name=err_code,json=errCode,proto3" json:"err_code,omitempty"`
+	ErrMsg        string                 `protobuf:"bytes,2,opt,name=err_msg,json=errMsg,proto3" json:"err_msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure) Reset() {
+	*x = DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure) ProtoMessage() {}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure.ProtoReflect.Descriptor instead.
+func (*DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{27, 0, 0, 0}
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure) GetErrCode() uint32 {
+	if x != nil {
+		return x.ErrCode
+	}
+	return 0
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure) GetErrMsg() string {
+	if x != nil {
+		return x.ErrMsg
+	}
+	return ""
+}
+
+type DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DeregisteredPath []string               `protobuf:"bytes,1,rep,name=deregistered_path,json=deregisteredPath,proto3" json:"deregistered_path,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess) Reset() {
+	*x = DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess{}
+	mi := &file_usp_msg_1_3_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess) ProtoMessage() {}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess) ProtoReflect() protoreflect.Message {
+	mi := &file_usp_msg_1_3_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess.ProtoReflect.Descriptor instead.
+func (*DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess) Descriptor() ([]byte, []int) {
+	return file_usp_msg_1_3_proto_rawDescGZIP(), []int{27, 0, 0, 1}
+}
+
+func (x *DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess) GetDeregisteredPath() []string {
+	if x != nil {
+		return x.DeregisteredPath
+	}
+	return nil
+}
+
+var File_usp_msg_1_3_proto protoreflect.FileDescriptor
+
+var file_usp_msg_1_3_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x75, 0x73, 0x70, 0x2d, 0x6d, 0x73, 0x67, 0x2d, 0x31, 0x2d, 0x33, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x03, 0x75, 0x73, 0x70, 0x22, 0x49, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12,
+	0x23, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x22, 0xdd, 0x03, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x15,
+	0x0a, 0x06, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x2e, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6d, 0x73,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x22, 0x8b, 0x03, 0x0a, 0x07, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x47, 0x45, 0x54, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x45, 0x54, 0x5f, 0x52, 0x45, 0x53,
+	0x50, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x10, 0x03, 0x12,
+	0x07, 0x0a, 0x03, 0x53, 0x45, 0x54, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x45, 0x54, 0x5f,
+	0x52, 0x45, 0x53, 0x50, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x45, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x52,
+	0x45, 0x53, 0x50, 0x10, 0x07, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44, 0x44, 0x10, 0x08, 0x12, 0x0c,
+	0x0a, 0x08, 0x41, 0x44, 0x44, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x09, 0x12, 0x0a, 0x0a, 0x06,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x0a, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x0b, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x45, 0x54,
+	0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x44, 0x4d, 0x10, 0x0c, 0x12,
+	0x19, 0x0a, 0x15, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44,
+	0x5f, 0x44, 0x4d, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x0d, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x45,
+	0x54, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x53, 0x10, 0x0e, 0x12, 0x16, 0x0a,
+	0x12, 0x47, 0x45, 0x54, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45, 0x53, 0x5f, 0x52,
+	0x45, 0x53, 0x50, 0x10, 0x0f, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x59, 0x5f,
+	0x52, 0x45, 0x53, 0x50, 0x10, 0x10, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x55,
+	0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x11, 0x12,
+	0x1c, 0x0a, 0x18, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44,
+	0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x12, 0x12, 0x0c, 0x0a,
+	0x08, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x10, 0x13, 0x12, 0x11, 0x0a, 0x0d, 0x52,
+	0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0x14, 0x12, 0x0e,
+	0x0a, 0x0a, 0x44, 0x45, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x10, 0x15, 0x12, 0x13,
+	0x0a, 0x0f, 0x44, 0x45, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53,
+	0x50, 0x10, 0x16, 0x22, 0x8d, 0x01, 0x0a, 0x04, 0x42, 0x6f, 0x64, 0x79, 0x12, 0x28, 0x0a, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x75, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x5f, 0x62,
+	0x6f, 0x64, 0x79, 0x22, 0x95, 0x04, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x03, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75,
+	0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x00, 0x52, 0x03, 0x67, 0x65, 0x74, 0x12, 0x3f, 0x0a,
+	0x10, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x64,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x4d, 0x48, 0x00, 0x52, 0x0e,
+	0x67, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x6d, 0x12, 0x38,
+	0x0a, 0x0d, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x67, 0x65, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x03, 0x73, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x53, 0x65, 0x74, 0x48,
+	0x00, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x41, 0x64, 0x64, 0x48, 0x00, 0x52,
+	0x03, 0x61, 0x64, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x07, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75,
+	0x73, 0x70, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x51, 0x0a, 0x16,
+	0x67, 0x65, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x75,
+	0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x48, 0x00, 0x52, 0x14, 0x67, 0x65, 0x74, 0x53, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x2b, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x0a,
+	0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42,
+	0x0a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xa6, 0x05, 0x0a, 0x08,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x67, 0x65, 0x74, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x73, 0x70,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x07, 0x67, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x6d, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x12, 0x67,
+	0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x45, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x10, 0x67, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x73, 0x70,
+	0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x07, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32,
+	0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x35, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65,
+	0x73, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x32, 0x0a, 0x0b, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x75, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x48,
+	0x00, 0x52, 0x0a, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a,
+	0x1b, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x48, 0x00, 0x52, 0x18, 0x67, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x38, 0x0a,
+	0x0d, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0f, 0x64, 0x65, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x42, 0x0b, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07,
+	0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72,
+	0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d,
+	0x73, 0x67, 0x12, 0x34, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x09, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x45, 0x72, 0x72, 0x73, 0x1a, 0x5f, 0x0a, 0x0a, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x07, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x22, 0x43, 0x0a, 0x03, 0x47, 0x65, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x07, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x74, 0x68, 0x22, 0xf2,
+	0x03, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4a, 0x0a, 0x10, 0x72, 0x65,
+	0x71, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0xc5, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x07, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x53, 0x0a, 0x15, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0xd2,
+	0x01, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x56, 0x0a, 0x0d, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xff, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x44, 0x4d, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x27, 0x0a,
+	0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x33, 0x0a, 0x16, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x13, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65,
+	0x79, 0x53, 0x65, 0x74, 0x73, 0x22, 0xcb, 0x10, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0f,
+	0x72, 0x65, 0x71, 0x5f, 0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x4f, 0x62, 0x6a, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x1a, 0xf2, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x20, 0x0a,
+	0x0c, 0x72, 0x65, 0x71, 0x5f, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x4f, 0x62, 0x6a, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x07, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72,
+	0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x4d, 0x73, 0x67, 0x12, 0x2d, 0x0a, 0x13, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x55,
+	0x72, 0x69, 0x12, 0x54, 0x0a, 0x0e, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x6f, 0x62, 0x6a, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x75, 0x73, 0x70,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x4d, 0x52,
+	0x65, 0x73, 0x70, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0d, 0x73, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x73, 0x1a, 0xc1, 0x04, 0x0a, 0x15, 0x53, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x3d, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x25, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4f, 0x62, 0x6a, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x69, 0x73, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x73, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x12, 0x73,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x11, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x12, 0x57, 0x0a, 0x10, 0x73, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x53, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x0f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x57, 0x0a, 0x10, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0f, 0x73, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x76, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x74,
+	0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x55, 0x0a, 0x0f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x52, 0x0d, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x73, 0x1a, 0x89, 0x02, 0x0a,
+	0x14, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x75, 0x73, 0x70, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x4d, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x4a, 0x0a, 0x0c,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x27, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x1a, 0xd1, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f,
+	0x61, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x41, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f,
+	0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x44, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6d, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x1a, 0x52, 0x0a, 0x14,
+	0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x72, 0x67, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x1a, 0x34, 0x0a, 0x15, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x65,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x41,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x41, 0x52,
+	0x41, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x57, 0x52,
+	0x49, 0x54, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x22, 0x5d, 0x0a, 0x0d, 0x4f, 0x62,
+	0x6a, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x4f,
+	0x42, 0x4a, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x4f, 0x42, 0x4a, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4f, 0x42, 0x4a, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x4f, 0x4e,
+	0x4c, 0x59, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x42, 0x4a, 0x5f, 0x44, 0x45, 0x4c, 0x45,
+	0x54, 0x45, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x03, 0x22, 0xe9, 0x01, 0x0a, 0x0e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d,
+	0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x11, 0x0a, 0x0d, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x5f, 0x36, 0x34,
+	0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x42, 0x4f, 0x4f, 0x4c,
+	0x45, 0x41, 0x4e, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x41,
+	0x52, 0x41, 0x4d, 0x5f, 0x44, 0x45, 0x43, 0x49, 0x4d, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x14, 0x0a,
+	0x10, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x48, 0x45, 0x58, 0x5f, 0x42, 0x49, 0x4e, 0x41, 0x52,
+	0x59, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x49, 0x4e, 0x54,
+	0x10, 0x06, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x4c, 0x4f, 0x4e, 0x47,
+	0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x52, 0x49,
+	0x4e, 0x47, 0x10, 0x08, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x55, 0x4e,
+	0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x54, 0x10, 0x09, 0x12, 0x17, 0x0a, 0x13,
+	0x50, 0x41, 0x52, 0x41, 0x4d, 0x5f, 0x55, 0x4e, 0x53, 0x49, 0x47, 0x4e, 0x45, 0x44, 0x5f, 0x4c,
+	0x4f, 0x4e, 0x47, 0x10, 0x0a, 0x22, 0x63, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x41, 0x4c, 0x55,
+	0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18,
+	0x56, 0x41, 0x4c, 0x55, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x57, 0x49, 0x4c,
+	0x4c, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x10, 0x02, 0x22, 0x37, 0x0a, 0x07, 0x43, 0x6d,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x4d, 0x44, 0x5f, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4d, 0x44, 0x5f, 0x53, 0x59,
+	0x4e, 0x43, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4d, 0x44, 0x5f, 0x41, 0x53, 0x59, 0x4e,
+	0x43, 0x10, 0x02, 0x22, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x50, 0x61, 0x74, 0x68, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xf6, 0x03, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x53, 0x0a, 0x10, 0x72, 0x65, 0x71, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x75, 0x73, 0x70, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x1a, 0xb3, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x07, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x5f,
+	0x69, 0x6e, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x73,
+	0x70, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x09, 0x63, 0x75, 0x72, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x73, 0x1a, 0xd6, 0x01, 0x0a, 0x0c, 0x43,
+	0x75, 0x72, 0x72, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x53, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x75, 0x72, 0x72,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b,
+	0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x4b, 0x65, 0x79, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65,
+	0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x6b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x53, 0x0a, 0x26, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x23, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x65, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x21,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xaf, 0x02, 0x0a, 0x03, 0x41, 0x64, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x6f,
+	0x62, 0x6a, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x75, 0x73, 0x70, 0x2e,
+	0x41, 0x64, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x73, 0x1a, 0x6d, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x62, 0x6a, 0x50, 0x61, 0x74, 0x68, 0x12, 0x42, 0x0a, 0x0e, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x41, 0x64, 0x64, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x5c, 0x0a, 0x12, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xb5, 0x07, 0x0a, 0x07, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0xfb, 0x05, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x51, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x75, 0x73,
+	0x70, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0xe9, 0x04, 0x0a, 0x0f, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x66, 0x0a,
+	0x0c, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x66, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x75, 0x73,
+	0x70, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00,
+	0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x1a, 0x46, 0x0a,
+	0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x07, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0xae, 0x02, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61,
+	0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73,
+	0x70, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x45,
+	0x72, 0x72, 0x73, 0x12, 0x72, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6b, 0x65,
+	0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x51, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x55, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x5a, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x07, 0x52,
+	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
+	0x67, 0x22, 0x4a, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0xbe, 0x06,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x53, 0x0a, 0x13,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x73, 0x70, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x11,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x1a, 0xe6, 0x04, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x54, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0xd1, 0x03, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x69, 0x0a, 0x0c, 0x6f, 0x70,
+	0x65, 0x72, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x44, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x69, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x75, 0x73,
+	0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x1a, 0x46, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x90, 0x01, 0x0a, 0x10, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x12, 0x55, 0x0a, 0x14, 0x75, 0x6e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x2e, 0x55, 0x6e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61,
+	0x74, 0x68, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x12, 0x75, 0x6e, 0x61, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x45, 0x72, 0x72, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x6f,
+	0x70, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x72, 0x0a, 0x13, 0x55, 0x6e,
+	0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x75, 0x6e, 0x61, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x07, 0x52, 0x07, 0x65, 0x72,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x22, 0xaf,
+	0x02, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x62, 0x6a, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x53, 0x65, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f,
+	0x62, 0x6a, 0x73, 0x1a, 0x6d, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x50, 0x61, 0x74, 0x68, 0x12, 0x42,
+	0x0a, 0x0e, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x53, 0x65, 0x74,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x1a, 0x5c, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x22, 0xde, 0x09, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x50, 0x0a, 0x13,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x62, 0x6a, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x73, 0x70, 0x2e,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x11, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x8e,
+	0x05, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x51, 0x0a,
+	0x0b, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x1a, 0xfc, 0x03, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x66, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x75, 0x73, 0x70,
+	0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52,
+	0x0b, 0x6f, 0x70, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x66, 0x0a, 0x0c,
+	0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x41, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x1a, 0x9f, 0x01, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x07, 0x65, 0x72, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x12, 0x57, 0x0a,
+	0x15, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x75,
+	0x73, 0x70, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x52, 0x13, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x68, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x54, 0x0a, 0x14, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x12, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x42, 0x0d, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a,
+	0x79, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3a,
+	0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x45, 0x72, 0x72, 0x73, 0x1a, 0x98, 0x02, 0x0a, 0x15, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x66, 0x66,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3a, 0x0a, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x75, 0x73, 0x70, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x45, 0x72, 0x72, 0x73, 0x12, 0x5c, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x75, 0x73, 0x70, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x1a, 0x40, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5a, 0x0a, 0x0e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x07, 0x52,
+	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f,
+	0x6d, 0x73, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73,
+	0x67, 0x22, 0xdb, 0x01, 0x0a, 0x07, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x72, 0x65, 0x73, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x0a, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x61,
+	0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x75, 0x73, 0x70, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67,
+	0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xee, 0x04, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x4d, 0x0a, 0x11, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x75, 0x73, 0x70,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x10, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x8f,
+	0x04, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x71, 0x5f, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x65, 0x71, 0x4f, 0x62, 0x6a, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x55, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x75, 0x73, 0x70,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x52, 0x0a, 0x0b, 0x63, 0x6d, 0x64, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x75, 0x73, 0x70, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00,
+	0x52, 0x0a, 0x63, 0x6d, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x1a, 0xa9, 0x01, 0x0a,
+	0x0a, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x5c, 0x0a, 0x0b, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x3b, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72,
+	0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x07, 0x65, 0x72,
+	0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x42, 0x10,
+	0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x22, 0x90, 0x0d, 0x0a, 0x06, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0c,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x0c, 0x6f, 0x62,
+	0x6a, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b,
+	0x6f, 0x62, 0x6a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0c, 0x6f,
+	0x62, 0x6a, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x0b, 0x6f, 0x62, 0x6a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x44, 0x0a, 0x0d,
+	0x6f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x6f, 0x6e, 0x5f, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x72,
+	0x65, 0x71, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x4f, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6e, 0x42, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x1a, 0xb3, 0x01, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x62, 0x6a, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x39, 0x0a,
+	0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4d, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x61, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0xb7, 0x01, 0x0a, 0x0e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62,
+	0x6a, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62,
+	0x6a, 0x50, 0x61, 0x74, 0x68, 0x12, 0x4b, 0x0a, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75, 0x73, 0x70,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65,
+	0x79, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4b, 0x65, 0x79, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x2b, 0x0a, 0x0e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x50, 0x61, 0x74, 0x68, 0x1a, 0x98,
+	0x04, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x62, 0x6a, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x4b, 0x65, 0x79, 0x12, 0x52, 0x0a, 0x0f, 0x72, 0x65, 0x71, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x75,
+	0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x4f, 0x0a, 0x0b, 0x63, 0x6d, 0x64, 0x5f, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x75,
+	0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6d,
+	0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x1a, 0xa6, 0x01, 0x0a, 0x0a, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x12, 0x59, 0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x75,
+	0x73, 0x70, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x2e, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x72,
+	0x67, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x41, 0x72, 0x67, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x44, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x42, 0x10, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x1a, 0xb7, 0x01, 0x0a, 0x0e, 0x4f, 0x6e,
+	0x42, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6f, 0x75, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6f, 0x75, 0x69, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x21, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x1e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x0a, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x08, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x3b, 0x0a, 0x09,
+	0x72, 0x65, 0x67, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x08, 0x72, 0x65, 0x67, 0x50, 0x61, 0x74, 0x68, 0x73, 0x1a, 0x26, 0x0a, 0x10, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x8b, 0x05, 0x0a, 0x0c, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x5e, 0x0a, 0x17, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x15, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x1a, 0x9a, 0x04, 0x0a, 0x14, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x57, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x81, 0x03, 0x0a, 0x0f,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x6c, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00,
+	0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x6c, 0x0a,
+	0x0c, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x47, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0b,
+	0x6f, 0x70, 0x65, 0x72, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x1a, 0x46, 0x0a, 0x10, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x72, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x07, 0x52, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72,
+	0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x4d, 0x73, 0x67, 0x1a, 0x3b, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x42, 0x0d, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x22, 0x0a, 0x0a, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61,
+	0x74, 0x68, 0x73, 0x22, 0xa7, 0x05, 0x0a, 0x0e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x66, 0x0a, 0x19, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x75, 0x73, 0x70, 0x2e,
+	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x17, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0xac,
+	0x04, 0x0a, 0x16, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x5b, 0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x8d, 0x03,
+	0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x70, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x75, 0x73, 0x70, 0x2e, 0x44, 0x65,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x44, 0x65, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x12, 0x70, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x75, 0x73, 0x70, 0x2e,
+	0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x44,
+	0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x53, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x1a, 0x46, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x72, 0x72,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x07, 0x65, 0x72, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x65, 0x72, 0x72, 0x5f, 0x6d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67, 0x1a, 0x3f, 0x0a,
+	0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65,
+	0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x42, 0x0d,
+	0x0a, 0x0b, 0x6f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_usp_msg_1_3_proto_rawDescOnce sync.Once
+	file_usp_msg_1_3_proto_rawDescData = file_usp_msg_1_3_proto_rawDesc
+)
+
+func file_usp_msg_1_3_proto_rawDescGZIP() []byte {
+	file_usp_msg_1_3_proto_rawDescOnce.Do(func() {
+		file_usp_msg_1_3_proto_rawDescData = protoimpl.X.CompressGZIP(file_usp_msg_1_3_proto_rawDescData)
+	})
+	return file_usp_msg_1_3_proto_rawDescData
+}
+
+var file_usp_msg_1_3_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_usp_msg_1_3_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
+var file_usp_msg_1_3_proto_goTypes = []any{
+	(Header_MsgType)(0),                     // 0: usp.Header.MsgType
+	(GetSupportedDMResp_ParamAccessType)(0), // 1: usp.GetSupportedDMResp.ParamAccessType
+	(GetSupportedDMResp_ObjAccessType)(0),   // 2: usp.GetSupportedDMResp.ObjAccessType
+	(GetSupportedDMResp_ParamValueType)(0),  // 3: usp.GetSupportedDMResp.ParamValueType
+	(GetSupportedDMResp_ValueChangeType)(0), // 4: usp.GetSupportedDMResp.ValueChangeType
+	(GetSupportedDMResp_CmdType)(0),         // 5: usp.GetSupportedDMResp.CmdType
+	(*Msg)(nil),                             // 6: usp.Msg
+	(*Header)(nil),                          // 7: usp.Header
+	(*Body)(nil),                            // 8: usp.Body
+	(*Request)(nil),                         // 9: usp.Request
+	(*Response)(nil),                        // 10: usp.Response
+	(*Error)(nil),                           // 11: usp.Error
+	(*Get)(nil),                             // 12: usp.Get
+	(*GetResp)(nil),                         // 13: usp.GetResp
+	(*GetSupportedDM)(nil),                  // 14: usp.GetSupportedDM
+	(*GetSupportedDMResp)(nil),              // 15: usp.GetSupportedDMResp
+	(*GetInstances)(nil),                    // 16: usp.GetInstances
+	(*GetInstancesResp)(nil),                // 17: usp.GetInstancesResp
+	(*GetSupportedProtocol)(nil),            // 18: usp.GetSupportedProtocol
+	(*GetSupportedProtocolResp)(nil),        // 19: usp.GetSupportedProtocolResp
+	(*Add)(nil),                             // 20: usp.Add
+	(*AddResp)(nil),                         // 21: usp.AddResp
+	(*Delete)(nil),                          // 22: usp.Delete
+	(*DeleteResp)(nil),                      // 23: usp.DeleteResp
+	(*Set)(nil),                             // 24: usp.Set
+	(*SetResp)(nil),                         // 25: usp.SetResp
+	(*Operate)(nil),                         // 26: usp.Operate
+	(*OperateResp)(nil),                     // 27: usp.OperateResp
+	(*Notify)(nil),                          // 28: usp.Notify
+	(*NotifyResp)(nil),                      // 29: usp.NotifyResp
+	(*Register)(nil),                        // 30: usp.Register
+	(*RegisterResp)(nil),                    // 31: usp.RegisterResp
+	(*Deregister)(nil),                      // 32: usp.Deregister
+	(*DeregisterResp)(nil),                  // 33: usp.DeregisterResp
+	(*Error_ParamError)(nil),                // 34: usp.Error.ParamError
+	(*GetResp_RequestedPathResult)(nil),     // 35: usp.GetResp.RequestedPathResult
+	(*GetResp_ResolvedPathResult)(nil),      // 36: usp.GetResp.ResolvedPathResult
+	nil,                                     // 37: usp.GetResp.ResolvedPathResult.ResultParamsEntry
+	(*GetSupportedDMResp_RequestedObjectResult)(nil),                     // 38: usp.GetSupportedDMResp.RequestedObjectResult
+	(*GetSupportedDMResp_SupportedObjectResult)(nil),                     // 39: usp.GetSupportedDMResp.SupportedObjectResult
+	(*GetSupportedDMResp_SupportedParamResult)(nil),                      // 40: usp.GetSupportedDMResp.SupportedParamResult
+	(*GetSupportedDMResp_SupportedCommandResult)(nil),                    // 41: usp.GetSupportedDMResp.SupportedCommandResult
+	(*GetSupportedDMResp_SupportedEventResult)(nil),                      // 42: usp.GetSupportedDMResp.SupportedEventResult
+	(*GetSupportedDMResp_SupportedUniqueKeySet)(nil),                     // 43: usp.GetSupportedDMResp.SupportedUniqueKeySet
+	(*GetInstancesResp_RequestedPathResult)(nil),                         // 44: usp.GetInstancesResp.RequestedPathResult
+	(*GetInstancesResp_CurrInstance)(nil),                                // 45: usp.GetInstancesResp.CurrInstance
+	nil,                                                                  // 46: usp.GetInstancesResp.CurrInstance.UniqueKeysEntry
+	(*Add_CreateObject)(nil),                                             // 47: usp.Add.CreateObject
+	(*Add_CreateParamSetting)(nil),                                       // 48: usp.Add.CreateParamSetting
+	(*AddResp_CreatedObjectResult)(nil),                                  // 49: usp.AddResp.CreatedObjectResult
+	(*AddResp_ParameterError)(nil),                                       // 50: usp.AddResp.ParameterError
+	(*AddResp_CreatedObjectResult_OperationStatus)(nil),                  // 51: usp.AddResp.CreatedObjectResult.OperationStatus
+	(*AddResp_CreatedObjectResult_OperationStatus_OperationFailure)(nil), // 52: usp.AddResp.CreatedObjectResult.OperationStatus.OperationFailure
+	(*AddResp_CreatedObjectResult_OperationStatus_OperationSuccess)(nil), // 53: usp.AddResp.CreatedObjectResult.OperationStatus.OperationSuccess
+	nil,                                    // 54: usp.AddResp.CreatedObjectResult.OperationStatus.OperationSuccess.UniqueKeysEntry
+	(*DeleteResp_DeletedObjectResult)(nil), // 55: usp.DeleteResp.DeletedObjectResult
+	(*DeleteResp_UnaffectedPathError)(nil), // 56: usp.DeleteResp.UnaffectedPathError
+	(*DeleteResp_DeletedObjectResult_OperationStatus)(nil),                  // 57: usp.DeleteResp.DeletedObjectResult.OperationStatus
+	(*DeleteResp_DeletedObjectResult_OperationStatus_OperationFailure)(nil), // 58: usp.DeleteResp.DeletedObjectResult.OperationStatus.OperationFailure
+	(*DeleteResp_DeletedObjectResult_OperationStatus_OperationSuccess)(nil), // 59: usp.DeleteResp.DeletedObjectResult.OperationStatus.OperationSuccess
+	(*Set_UpdateObject)(nil),                                             // 60: usp.Set.UpdateObject
+	(*Set_UpdateParamSetting)(nil),                                       // 61: usp.Set.UpdateParamSetting
+	(*SetResp_UpdatedObjectResult)(nil),                                  // 62: usp.SetResp.UpdatedObjectResult
+	(*SetResp_UpdatedInstanceFailure)(nil),                               // 63: usp.SetResp.UpdatedInstanceFailure
+	(*SetResp_UpdatedInstanceResult)(nil),                                // 64: usp.SetResp.UpdatedInstanceResult
+	(*SetResp_ParameterError)(nil),                                       // 65: usp.SetResp.ParameterError
+	(*SetResp_UpdatedObjectResult_OperationStatus)(nil),                  // 66: usp.SetResp.UpdatedObjectResult.OperationStatus
+	(*SetResp_UpdatedObjectResult_OperationStatus_OperationFailure)(nil), // 67: usp.SetResp.UpdatedObjectResult.OperationStatus.OperationFailure
+	(*SetResp_UpdatedObjectResult_OperationStatus_OperationSuccess)(nil), // 68: usp.SetResp.UpdatedObjectResult.OperationStatus.OperationSuccess
+	nil,                                 // 69: usp.SetResp.UpdatedInstanceResult.UpdatedParamsEntry
+	nil,                                 // 70: usp.Operate.InputArgsEntry
+	(*OperateResp_OperationResult)(nil), // 71: usp.OperateResp.OperationResult
+	(*OperateResp_OperationResult_OutputArgs)(nil),     // 72: usp.OperateResp.OperationResult.OutputArgs
+	(*OperateResp_OperationResult_CommandFailure)(nil), // 73: usp.OperateResp.OperationResult.CommandFailure
+	nil,                              // 74: usp.OperateResp.OperationResult.OutputArgs.OutputArgsEntry
+	(*Notify_Event)(nil),             // 75: usp.Notify.Event
+	(*Notify_ValueChange)(nil),       // 76: usp.Notify.ValueChange
+	(*Notify_ObjectCreation)(nil),    // 77: usp.Notify.ObjectCreation
+	(*Notify_ObjectDeletion)(nil),    // 78: usp.Notify.ObjectDeletion
+	(*Notify_OperationComplete)(nil), // 79: usp.Notify.OperationComplete
+	(*Notify_OnBoardRequest)(nil),    // 80: usp.Notify.OnBoardRequest
+	nil,                              // 81: usp.Notify.Event.ParamsEntry
+	nil,                              // 82: usp.Notify.ObjectCreation.UniqueKeysEntry
+	(*Notify_OperationComplete_OutputArgs)(nil),     // 83: usp.Notify.OperationComplete.OutputArgs
+	(*Notify_OperationComplete_CommandFailure)(nil), // 84: usp.Notify.OperationComplete.CommandFailure
+	nil,                               // 85: usp.Notify.OperationComplete.OutputArgs.OutputArgsEntry
+	(*Register_RegistrationPath)(nil), // 86: usp.Register.RegistrationPath
+	(*RegisterResp_RegisteredPathResult)(nil),                                      // 87: usp.RegisterResp.RegisteredPathResult
+	(*RegisterResp_RegisteredPathResult_OperationStatus)(nil),                      // 88: usp.RegisterResp.RegisteredPathResult.OperationStatus
+	(*RegisterResp_RegisteredPathResult_OperationStatus_OperationFailure)(nil),     // 89: usp.RegisterResp.RegisteredPathResult.OperationStatus.OperationFailure
+	(*RegisterResp_RegisteredPathResult_OperationStatus_OperationSuccess)(nil),     // 90: usp.RegisterResp.RegisteredPathResult.OperationStatus.OperationSuccess
+	(*DeregisterResp_DeregisteredPathResult)(nil),                                  // 91: usp.DeregisterResp.DeregisteredPathResult
+	(*DeregisterResp_DeregisteredPathResult_OperationStatus)(nil),                  // 92: usp.DeregisterResp.DeregisteredPathResult.OperationStatus
+	(*DeregisterResp_DeregisteredPathResult_OperationStatus_OperationFailure)(nil), // 93: usp.DeregisterResp.DeregisteredPathResult.OperationStatus.OperationFailure
+	(*DeregisterResp_DeregisteredPathResult_OperationStatus_OperationSuccess)(nil), // 94: usp.DeregisterResp.DeregisteredPathResult.OperationStatus.OperationSuccess
+}
+var file_usp_msg_1_3_proto_depIdxs = []int32{
+	7,  // 0: usp.Msg.header:type_name -> usp.Header
+	8,  // 1: usp.Msg.body:type_name -> usp.Body
+	0,  // 2: usp.Header.msg_type:type_name -> usp.Header.MsgType
+	9,  // 3: usp.Body.request:type_name -> usp.Request
+	10, // 4: usp.Body.response:type_name -> usp.Response
+	11, // 5: usp.Body.error:type_name -> usp.Error
+	12, // 6: usp.Request.get:type_name -> usp.Get
+	14, // 7: usp.Request.get_supported_dm:type_name -> usp.GetSupportedDM
+	16, // 8: usp.Request.get_instances:type_name -> usp.GetInstances
+	24, // 9: usp.Request.set:type_name -> usp.Set
+	20, // 10: usp.Request.add:type_name -> usp.Add
+	22, // 11: usp.Request.delete:type_name -> usp.Delete
+	26, // 12: usp.Request.operate:type_name -> usp.Operate
+	28, // 13: usp.Request.notify:type_name -> usp.Notify
+	18, // 14: usp.Request.get_supported_protocol:type_name -> usp.GetSupportedProtocol
+	30, // 15: usp.Request.register:type_name -> usp.Register
+	32, // 16: usp.Request.deregister:type_name -> usp.Deregister
+	13, // 17: usp.Response.get_resp:type_name -> usp.GetResp
+	15, // 18: usp.Response.get_supported_dm_resp:type_name -> usp.GetSupportedDMResp
+	17, // 19: usp.Response.get_instances_resp:type_name -> usp.GetInstancesResp
+	25, // 20: usp.Response.set_resp:type_name -> usp.SetResp
+	21, // 21: usp.Response.add_resp:type_name -> usp.AddResp
+	23, // 22: usp.Response.delete_resp:type_name -> usp.DeleteResp
+	27, // 23: usp.Response.operate_resp:type_name -> usp.OperateResp
+	29, // 24: usp.Response.notify_resp:type_name -> usp.NotifyResp
+	19, // 25: usp.Response.get_supported_protocol_resp:type_name -> usp.GetSupportedProtocolResp
+	31, // 26: usp.Response.register_resp:type_name -> usp.RegisterResp
+	33, // 27: usp.Response.deregister_resp:type_name -> usp.DeregisterResp
+	34, // 28: usp.Error.param_errs:type_name -> usp.Error.ParamError
+	35, // 29: usp.GetResp.req_path_results:type_name -> usp.GetResp.RequestedPathResult
+	38, // 30: usp.GetSupportedDMResp.req_obj_results:type_name -> usp.GetSupportedDMResp.RequestedObjectResult
+	44, // 31: usp.GetInstancesResp.req_path_results:type_name -> usp.GetInstancesResp.RequestedPathResult
+	47, // 32: usp.Add.create_objs:type_name -> usp.Add.CreateObject
+	49, // 33: usp.AddResp.created_obj_results:type_name -> usp.AddResp.CreatedObjectResult
+	55, // 34: usp.DeleteResp.deleted_obj_results:type_name -> usp.DeleteResp.DeletedObjectResult
+	60, // 35: usp.Set.update_objs:type_name -> usp.Set.UpdateObject
+	62, // 36: usp.SetResp.updated_obj_results:type_name -> usp.SetResp.UpdatedObjectResult
+	70, // 37: usp.Operate.input_args:type_name -> usp.Operate.InputArgsEntry
+	71, // 38: usp.OperateResp.operation_results:type_name -> usp.OperateResp.OperationResult
+	75, // 39: usp.Notify.event:type_name -> usp.Notify.Event
+	76, // 40: usp.Notify.value_change:type_name -> usp.Notify.ValueChange
+	77, // 41: usp.Notify.obj_creation:type_name -> usp.Notify.ObjectCreation
+	78, // 42: usp.Notify.obj_deletion:type_name -> usp.Notify.ObjectDeletion
+	79, // 43: usp.Notify.oper_complete:type_name -> usp.Notify.OperationComplete
+	80, // 44: usp.Notify.on_board_req:type_name -> usp.Notify.OnBoardRequest
+	86, // 45: usp.Register.reg_paths:type_name -> usp.Register.RegistrationPath
+	87, // 46: usp.RegisterResp.registered_path_results:type_name -> usp.RegisterResp.RegisteredPathResult
+	91, // 47: usp.DeregisterResp.deregistered_path_results:type_name -> usp.DeregisterResp.DeregisteredPathResult
+	36, // 48: usp.GetResp.RequestedPathResult.resolved_path_results:type_name -> usp.GetResp.ResolvedPathResult
+	37, // 49: usp.GetResp.ResolvedPathResult.result_params:type_name -> usp.GetResp.ResolvedPathResult.ResultParamsEntry
+	39, // 50: usp.GetSupportedDMResp.RequestedObjectResult.supported_objs:type_name -> usp.GetSupportedDMResp.SupportedObjectResult
+	2,  // 51: usp.GetSupportedDMResp.SupportedObjectResult.access:type_name -> usp.GetSupportedDMResp.ObjAccessType
+	41, // 52: usp.GetSupportedDMResp.SupportedObjectResult.supported_commands:type_name -> usp.GetSupportedDMResp.SupportedCommandResult
+	42, // 53: usp.GetSupportedDMResp.SupportedObjectResult.supported_events:type_name -> usp.GetSupportedDMResp.SupportedEventResult
+	40, // 54: usp.GetSupportedDMResp.SupportedObjectResult.supported_params:type_name -> usp.GetSupportedDMResp.SupportedParamResult
+	43, // 55: usp.GetSupportedDMResp.SupportedObjectResult.unique_key_sets:type_name -> usp.GetSupportedDMResp.SupportedUniqueKeySet
+	1,  // 56: usp.GetSupportedDMResp.SupportedParamResult.access:type_name -> usp.GetSupportedDMResp.ParamAccessType
+	3,  // 57: usp.GetSupportedDMResp.SupportedParamResult.value_type:type_name -> usp.GetSupportedDMResp.ParamValueType
+	4,  // 58: usp.GetSupportedDMResp.SupportedParamResult.value_change:type_name -> usp.GetSupportedDMResp.ValueChangeType
+	5,  // 59: usp.GetSupportedDMResp.SupportedCommandResult.command_type:type_name -> usp.GetSupportedDMResp.CmdType
+	45, // 60: usp.GetInstancesResp.RequestedPathResult.curr_insts:type_name -> usp.GetInstancesResp.CurrInstance
+	46, // 61: usp.GetInstancesResp.CurrInstance.unique_keys:type_name -> usp.GetInstancesResp.CurrInstance.UniqueKeysEntry
+	48, // 62: usp.Add.CreateObject.param_settings:type_name -> usp.Add.CreateParamSetting
+	51, // 63: usp.AddResp.CreatedObjectResult.oper_status:type_name -> usp.AddResp.CreatedObjectResult.OperationStatus
+	52, // 64: usp.AddResp.CreatedObjectResult.OperationStatus.oper_failure:type_name -> usp.AddResp.CreatedObjectResult.OperationStatus.OperationFailure
+	53, // 65: usp.AddResp.CreatedObjectResult.OperationStatus.oper_success:type_name -> usp.AddResp.CreatedObjectResult.OperationStatus.OperationSuccess
+	50, // 66: usp.AddResp.CreatedObjectResult.OperationStatus.OperationSuccess.param_errs:type_name -> usp.AddResp.ParameterError
+	54, // 67: usp.AddResp.CreatedObjectResult.OperationStatus.OperationSuccess.unique_keys:type_name -> usp.AddResp.CreatedObjectResult.OperationStatus.OperationSuccess.UniqueKeysEntry
+	57, // 68: usp.DeleteResp.DeletedObjectResult.oper_status:type_name -> usp.DeleteResp.DeletedObjectResult.OperationStatus
+	58, // 69: usp.DeleteResp.DeletedObjectResult.OperationStatus.oper_failure:type_name -> usp.DeleteResp.DeletedObjectResult.OperationStatus.OperationFailure
+	59, // 70: usp.DeleteResp.DeletedObjectResult.OperationStatus.oper_success:type_name -> usp.DeleteResp.DeletedObjectResult.OperationStatus.OperationSuccess
+	56, // 71: usp.DeleteResp.DeletedObjectResult.OperationStatus.OperationSuccess.unaffected_path_errs:type_name -> usp.DeleteResp.UnaffectedPathError
+	61, // 72: usp.Set.UpdateObject.param_settings:type_name -> usp.Set.UpdateParamSetting
+	66, // 73: usp.SetResp.UpdatedObjectResult.oper_status:type_name -> usp.SetResp.UpdatedObjectResult.OperationStatus
+	65, // 74: usp.SetResp.UpdatedInstanceFailure.param_errs:type_name -> usp.SetResp.ParameterError
+	65, // 75: usp.SetResp.UpdatedInstanceResult.param_errs:type_name -> usp.SetResp.ParameterError
+	69, // 76: usp.SetResp.UpdatedInstanceResult.updated_params:type_name -> usp.SetResp.UpdatedInstanceResult.UpdatedParamsEntry
+	67, // 77: usp.SetResp.UpdatedObjectResult.OperationStatus.oper_failure:type_name -> usp.SetResp.UpdatedObjectResult.OperationStatus.OperationFailure
+	68, // 78: usp.SetResp.UpdatedObjectResult.OperationStatus.oper_success:type_name -> usp.SetResp.UpdatedObjectResult.OperationStatus.OperationSuccess
+	63, // 79: usp.SetResp.UpdatedObjectResult.OperationStatus.OperationFailure.updated_inst_failures:type_name -> usp.SetResp.UpdatedInstanceFailure
+	64, // 80: usp.SetResp.UpdatedObjectResult.OperationStatus.OperationSuccess.updated_inst_results:type_name -> usp.SetResp.UpdatedInstanceResult
+	72, // 81: usp.OperateResp.OperationResult.req_output_args:type_name -> usp.OperateResp.OperationResult.OutputArgs
+	73, // 82: usp.OperateResp.OperationResult.cmd_failure:type_name -> usp.OperateResp.OperationResult.CommandFailure
+	74, // 83: usp.OperateResp.OperationResult.OutputArgs.output_args:type_name -> usp.OperateResp.OperationResult.OutputArgs.OutputArgsEntry
+	81, // 84: usp.Notify.Event.params:type_name -> usp.Notify.Event.ParamsEntry
+	82, // 85: usp.Notify.ObjectCreation.unique_keys:type_name -> usp.Notify.ObjectCreation.UniqueKeysEntry
+	83, // 86: usp.Notify.OperationComplete.req_output_args:type_name -> usp.Notify.OperationComplete.OutputArgs
+	84, // 87: usp.Notify.OperationComplete.cmd_failure:type_name -> usp.Notify.OperationComplete.CommandFailure
+	85, // 88: usp.Notify.OperationComplete.OutputArgs.output_args:type_name -> usp.Notify.OperationComplete.OutputArgs.OutputArgsEntry
+	88, // 89: usp.RegisterResp.RegisteredPathResult.oper_status:type_name -> usp.RegisterResp.RegisteredPathResult.OperationStatus
+	89, // 90: usp.RegisterResp.RegisteredPathResult.OperationStatus.oper_failure:type_name -> usp.RegisterResp.RegisteredPathResult.OperationStatus.OperationFailure
+	90, // 91: usp.RegisterResp.RegisteredPathResult.OperationStatus.oper_success:type_name -> usp.RegisterResp.RegisteredPathResult.OperationStatus.OperationSuccess
+	92, // 92: usp.DeregisterResp.DeregisteredPathResult.oper_status:type_name -> usp.DeregisterResp.DeregisteredPathResult.OperationStatus
+	93, // 93: usp.DeregisterResp.DeregisteredPathResult.OperationStatus.oper_failure:type_name -> usp.DeregisterResp.DeregisteredPathResult.OperationStatus.OperationFailure
+	94, // 94: usp.DeregisterResp.DeregisteredPathResult.OperationStatus.oper_success:type_name -> usp.DeregisterResp.DeregisteredPathResult.OperationStatus.OperationSuccess
+	95, // [95:95] is the sub-list for method output_type
+	95, // [95:95] is the sub-list for method input_type
+	95, // [95:95] is the sub-list for extension type_name
+	95, // [95:95] is the sub-list for extension extendee
+	0,  // [0:95] is the sub-list for field type_name
+}
+
+func init() { file_usp_msg_1_3_proto_init() }
+func file_usp_msg_1_3_proto_init() {
+	if File_usp_msg_1_3_proto != nil {
+		return
+	}
+	file_usp_msg_1_3_proto_msgTypes[2].OneofWrappers = []any{
+		(*Body_Request)(nil),
+		(*Body_Response)(nil),
+		(*Body_Error)(nil),
+	}
+	file_usp_msg_1_3_proto_msgTypes[3].OneofWrappers = []any{
+		(*Request_Get)(nil),
+		(*Request_GetSupportedDm)(nil),
+		(*Request_GetInstances)(nil),
+		(*Request_Set)(nil),
+		(*Request_Add)(nil),
+		(*Request_Delete)(nil),
+		(*Request_Operate)(nil),
+		(*Request_Notify)(nil),
+		(*Request_GetSupportedProtocol)(nil),
+		(*Request_Register)(nil),
+		(*Request_Deregister)(nil),
+	}
+	file_usp_msg_1_3_proto_msgTypes[4].OneofWrappers = []any{
+		(*Response_GetResp)(nil),
+		(*Response_GetSupportedDmResp)(nil),
+		(*Response_GetInstancesResp)(nil),
+		(*Response_SetResp)(nil),
+		(*Response_AddResp)(nil),
+		(*Response_DeleteResp)(nil),
+		(*Response_OperateResp)(nil),
+		(*Response_NotifyResp)(nil),
+		(*Response_GetSupportedProtocolResp)(nil),
+		(*Response_RegisterResp)(nil),
+		(*Response_DeregisterResp)(nil),
+	}
+	file_usp_msg_1_3_proto_msgTypes[22].OneofWrappers = []any{
+		(*Notify_Event_)(nil),
+		(*Notify_ValueChange_)(nil),
+		(*Notify_ObjCreation)(nil),
+		(*Notify_ObjDeletion)(nil),
+		(*Notify_OperComplete)(nil),
+		(*Notify_OnBoardReq)(nil),
+	}
+	file_usp_msg_1_3_proto_msgTypes[45].OneofWrappers = []any{
+		(*AddResp_CreatedObjectResult_OperationStatus_OperFailure)(nil),
+		(*AddResp_CreatedObjectResult_OperationStatus_OperSuccess)(nil),
+	}
+	file_usp_msg_1_3_proto_msgTypes[51].OneofWrappers = []any{
+		(*DeleteResp_DeletedObjectResult_OperationStatus_OperFailure)(nil),
+		(*DeleteResp_DeletedObjectResult_OperationStatus_OperSuccess)(nil),
+	}
+	file_usp_msg_1_3_proto_msgTypes[60].OneofWrappers = []any{
+		(*SetResp_UpdatedObjectResult_OperationStatus_OperFailure)(nil),
+		(*SetResp_UpdatedObjectResult_OperationStatus_OperSuccess)(nil),
+	}
+	file_usp_msg_1_3_proto_msgTypes[65].OneofWrappers = []any{
+		(*OperateResp_OperationResult_ReqObjPath)(nil),
+		(*OperateResp_OperationResult_ReqOutputArgs)(nil),
+		(*OperateResp_OperationResult_CmdFailure)(nil),
+	}
+	file_usp_msg_1_3_proto_msgTypes[73].OneofWrappers = []any{
+		(*Notify_OperationComplete_ReqOutputArgs)(nil),
+		(*Notify_OperationComplete_CmdFailure)(nil),
+	}
+	file_usp_msg_1_3_proto_msgTypes[82].OneofWrappers = []any{
+		(*RegisterResp_RegisteredPathResult_OperationStatus_OperFailure)(nil),
+		(*RegisterResp_RegisteredPathResult_OperationStatus_OperSuccess)(nil),
+	}
+	file_usp_msg_1_3_proto_msgTypes[86].OneofWrappers = []any{
+		(*DeregisterResp_DeregisteredPathResult_OperationStatus_OperFailure)(nil),
+		(*DeregisterResp_DeregisteredPathResult_OperationStatus_OperSuccess)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_usp_msg_1_3_proto_rawDesc,
+			NumEnums:      6,
+			NumMessages:   89,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_usp_msg_1_3_proto_goTypes,
+		DependencyIndexes: file_usp_msg_1_3_proto_depIdxs,
+		EnumInfos:         file_usp_msg_1_3_proto_enumTypes,
+		MessageInfos:      file_usp_msg_1_3_proto_msgTypes,
+	}.Build()
+	File_usp_msg_1_3_proto = out.File
+	file_usp_msg_1_3_proto_rawDesc = nil
+	file_usp_msg_1_3_proto_goTypes = nil
+	file_usp_msg_1_3_proto_depIdxs = nil
+}
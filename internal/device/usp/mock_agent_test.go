@@ -0,0 +1,188 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package usp
+
+import (
+	"github.com/nokia/corteca-cli/internal/device/usp/messages"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// in-process USP agent answering over the WebSocket MTP, with a flat parameter map as its data model
+type mockAgent struct {
+	t        *testing.T
+	id       string
+	mu       sync.Mutex
+	params   map[string]string
+	received []messages.Message
+	// subscription IDs by instance path
+	subscriptions map[string]string
+	nextInstance  int
+	// basic authentication credentials required for the upgrade, if set
+	username, password string
+}
+
+func startMockAgent(t *testing.T, id string, params map[string]string) (*mockAgent, *httptest.Server) {
+	agent := &mockAgent{t: t, id: id, params: params, subscriptions: make(map[string]string), nextInstance: 1}
+	server := httptest.NewServer(http.HandlerFunc(agent.serveWebSocket))
+	t.Cleanup(server.Close)
+	return agent, server
+}
+
+func (a *mockAgent) serveWebSocket(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Sec-WebSocket-Protocol") != USPSubprotocol || !strings.Contains(r.Header.Get("Sec-WebSocket-Extensions"), "bbf-usp-protocol") {
+		http.Error(w, "not a USP controller", http.StatusBadRequest)
+		return
+	}
+	if username, password, _ := r.BasicAuth(); username != a.username || password != a.password {
+		w.Header().Set("WWW-Authenticate", `Basic realm="agent"`)
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	upgrader := websocket.Upgrader{Subprotocols: []string{USPSubprotocol}}
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		a.t.Error(err)
+		return
+	}
+	defer ws.Close()
+	for {
+		_, data, err := ws.ReadMessage()
+		if err != nil {
+			return
+		}
+		record, err := messages.ParseRecord(data)
+		if err != nil {
+			a.t.Error(err)
+			return
+		}
+		msg, err := messages.ParseMsg(record.Payload)
+		if err != nil {
+			a.t.Error(err)
+			return
+		}
+		a.mu.Lock()
+		a.received = append(a.received, msg.Body)
+		a.mu.Unlock()
+		for _, reply := range a.handle(msg) {
+			out, err := messages.NewRecord(record.FromID, a.id, reply)
+			if err != nil {
+				a.t.Error(err)
+				return
+			}
+			data, err := out.Marshal()
+			if err != nil {
+				a.t.Error(err)
+				return
+			}
+			if err := ws.WriteMessage(websocket.BinaryMessage, data); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (a *mockAgent) handle(msg *messages.Msg) []messages.Msg {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	reply := func(body messages.Message) []messages.Msg { return []messages.Msg{{ID: msg.ID, Body: body}} }
+	switch m := msg.Body.(type) {
+	case messages.Get:
+		resp := messages.GetResp{}
+		for _, path := range m.ParamPaths {
+			result := messages.RequestedPathResult{RequestedPath: path.String()}
+			params := make(map[string]string)
+			for name, value := range a.params {
+				if strings.HasPrefix(name, path.String()) {
+					params[strings.TrimPrefix(name, path.String())] = value
+				}
+			}
+			if len(params) == 0 {
+				result.ErrCode, result.ErrMsg = 7026, "Invalid path"
+			} else {
+				result.ResolvedPathResults = []messages.ResolvedPathResult{{ResolvedPath: path.String(), ResultParams: params}}
+			}
+			resp.ReqPathResults = append(resp.ReqPathResults, result)
+		}
+		return reply(resp)
+	case messages.Set:
+		resp := messages.SetResp{}
+		for _, obj := range m.UpdateObjs {
+			updated := make(map[string]string)
+			for _, p := range obj.ParamSettings {
+				a.params[obj.ObjPath.String()+p.Param.String()] = p.Value.String()
+				updated[p.Param.String()] = p.Value.String()
+			}
+			resp.UpdatedObjResults = append(resp.UpdatedObjResults, messages.UpdatedObjectResult{
+				RequestedPath: obj.ObjPath.String(),
+				OperSuccess:   []messages.UpdatedInstanceResult{{AffectedPath: obj.ObjPath.String(), UpdatedParams: updated}},
+			})
+		}
+		return reply(resp)
+	case messages.Add:
+		resp := messages.AddResp{}
+		for _, obj := range m.CreateObjs {
+			path := fmt.Sprintf("%s%d.", obj.ObjPath.String(), a.nextInstance)
+			a.nextInstance++
+			for _, p := range obj.ParamSettings {
+				a.params[path+p.Param.String()] = p.Value.String()
+			}
+			if obj.ObjPath.String() == subscriptionTable {
+				a.subscriptions[path] = a.params[path+"ID"]
+			}
+			resp.CreatedObjResults = append(resp.CreatedObjResults, messages.CreatedObjectResult{RequestedPath: obj.ObjPath.String(), InstantiatedPath: path})
+		}
+		return reply(resp)
+	case messages.Delete:
+		resp := messages.DeleteResp{}
+		for _, path := range m.ObjPaths {
+			for name := range a.params {
+				if strings.HasPrefix(name, path.String()) {
+					delete(a.params, name)
+				}
+			}
+			delete(a.subscriptions, path.String())
+			resp.DeletedObjResults = append(resp.DeletedObjResults, messages.DeletedObjectResult{RequestedPath: path.String(), AffectedPaths: []string{path.String()}})
+		}
+		return reply(resp)
+	case messages.Operate:
+		if m.Command.String() != "Device.SoftwareModules.InstallDU()" {
+			return reply(messages.Error{ErrCode: 7022, ErrMsg: "Command failure"})
+		}
+		result := messages.OperationResult{ExecutedCommand: m.Command.String(), ReqObjPath: "Device.LocalAgent.Request.1."}
+		replies := reply(messages.OperateResp{OperationResults: []messages.OperationResult{result}})
+		complete := &messages.OperationComplete{ObjPath: "Device.SoftwareModules.", CommandName: "InstallDU()", CommandKey: m.CommandKey.String()}
+		if strings.HasPrefix(m.InputArgs["URL"].String(), "http://") {
+			a.params["Device.SoftwareModules.DeploymentUnit.1.URL"] = m.InputArgs["URL"].String()
+			complete.ReqOutputArgs = map[string]string{"DeploymentUnitRef": "Device.SoftwareModules.DeploymentUnit.1."}
+		} else {
+			complete.CmdFailure = &messages.CommandFailure{ErrCode: 7225, ErrMsg: "Unsupported protocol"}
+		}
+		// completion is only notified to subscribed controllers
+		for _, id := range a.subscriptions {
+			// an unrelated notification arriving first must be ignored
+			replies = append(replies,
+				messages.Msg{ID: "notif-0", Body: messages.Notify{SubscriptionID: "other", ValueChange: &messages.ValueChange{ParamPath: "Device.Foo", ParamValue: "1"}}},
+				messages.Msg{ID: "notif-1", Body: messages.Notify{SubscriptionID: id, SendResp: true, OperComplete: complete}})
+		}
+		return replies
+	case messages.NotifyResp:
+		return nil
+	default:
+		return reply(messages.Error{ErrCode: 7000, ErrMsg: "Message failed"})
+	}
+}
+
+func (a *mockAgent) receivedMessages() []messages.Message {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]messages.Message{}, a.received...)
+}
@@ -0,0 +1,343 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package usp
+
+import (
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device"
+	"github.com/nokia/corteca-cli/internal/device/usp/messages"
+	"github.com/nokia/corteca-cli/internal/tui"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DefaultControllerId = "self::corteca"
+	// table of the agent where subscriptions to OperationComplete notifications are created
+	subscriptionTable = "Device.LocalAgent.Subscription."
+	incomingQueueSize = 16
+)
+
+func init() {
+	device.RegisterDeviceType("usp", NewUSPDevice)
+	device.RegisterDeviceType("usps", NewUSPDevice)
+}
+
+type USPDevice struct {
+	conn         *wsConn
+	agentId      string
+	controllerId string
+	log          io.Writer
+	logMutex     sync.Mutex
+	in           chan *messages.Msg
+	// notifications received while waiting for a response, kept for later steps
+	notifications []messages.Notify
+	// closed when the device is closed
+	closed chan struct{}
+	// closed when the connection to the agent is lost
+	halted  chan struct{}
+	haltErr error
+}
+
+type USPConfig struct {
+	configuration.HttpClientEndpoint `yaml:",inline"`
+	AgentId                          configuration.TemplateField `yaml:"agentId"`
+	ControllerId                     configuration.TemplateField `yaml:"controllerId,omitempty"`
+}
+
+func NewUSPDevice(c *configuration.DeviceConfig, log io.Writer) (device.Device, error) {
+	uspconfig := USPConfig{}
+	if err := c.Decode(&uspconfig); err != nil {
+		return nil, err
+	}
+	d := USPDevice{
+		agentId:      uspconfig.AgentId.String(),
+		controllerId: uspconfig.ControllerId.String(),
+		log:          log,
+		in:           make(chan *messages.Msg, incomingQueueSize),
+		closed:       make(chan struct{}),
+		halted:       make(chan struct{}),
+	}
+	if len(d.agentId) == 0 {
+		return nil, fmt.Errorf("the endpoint ID of the USP agent (agentId) must be specified")
+	}
+	if len(d.controllerId) == 0 {
+		d.controllerId = DefaultControllerId
+	}
+	u, err := url.Parse(uspconfig.Addr.String())
+	if err != nil {
+		return nil, err
+	}
+	// the WebSocket handshake is an HTTP upgrade
+	switch u.Scheme {
+	case "usp":
+		u.Scheme = "http"
+	case "usps":
+		u.Scheme = "https"
+	default:
+		panic(fmt.Sprintf("unexpected scheme '%s'", u.Scheme))
+	}
+	// not canonicalized, as the WebSocket library refuses to pass on extensions it doesn't negotiate itself
+	header := http.Header{"Sec-WebSocket-Extensions": {fmt.Sprintf(`bbf-usp-protocol; eid="%s"`, d.controllerId)}}
+	if d.conn, err = dialWebSocket(&uspconfig.HttpClientEndpoint, u.String(), header); err != nil {
+		return nil, fmt.Errorf("failed to connect to USP agent: %w", err)
+	}
+	d.logLine(fmt.Sprintf("Connected to %s", u.String()))
+	go d.receive()
+	tui.DisplaySuccessMsg(fmt.Sprintf("Connected to USP agent '%s'", d.agentId))
+	return &d, nil
+}
+
+func (d *USPDevice) BeginSequence() error {
+	return nil
+}
+
+func (d *USPDevice) ExecuteCommand(ctx context.Context, cmd *configuration.SequenceCmd) (any, error) {
+	req, err := createRequestFromCmd(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if op, ok := req.(messages.Operate); ok {
+		return d.operate(ctx, op)
+	}
+	tui.LogNormal("Sending '%s' message...", req.GetName())
+	return d.request(ctx, req)
+}
+
+func (d *USPDevice) EndSequence() error {
+	return nil
+}
+
+func (d *USPDevice) GetProtocol() string {
+	return "usp"
+}
+
+func (d *USPDevice) Close() {
+	close(d.closed)
+	d.conn.Close()
+}
+
+func createRequestFromCmd(cmd *configuration.SequenceCmd) (messages.Request, error) {
+	msgName := cmd.Cmd.String()
+	switch msgName {
+	case messages.Get{}.GetName():
+		var m messages.Get
+		return m, cmd.Decode(&m)
+	case messages.Set{}.GetName():
+		var m messages.Set
+		return m, cmd.Decode(&m)
+	case messages.Add{}.GetName():
+		var m messages.Add
+		return m, cmd.Decode(&m)
+	case messages.Delete{}.GetName():
+		var m messages.Delete
+		return m, cmd.Decode(&m)
+	case messages.Operate{}.GetName():
+		var m messages.Operate
+		return m, cmd.Decode(&m)
+	default:
+		return nil, fmt.Errorf("unknown USP message '%s'", msgName)
+	}
+}
+
+// execute a command, waiting for its OperationComplete notification if it completes asynchronously
+func (d *USPDevice) operate(ctx context.Context, op messages.Operate) (messages.Message, error) {
+	op.SendResp = true
+	// the agent only notifies the completion of asynchronous commands to subscribed controllers
+	if subscription, err := d.subscribe(ctx, op.Command.String()); err != nil {
+		tui.LogWarning("Failed to subscribe to completion of '%s': %s", op.Command.String(), err.Error())
+	} else {
+		defer d.unsubscribe(subscription)
+	}
+
+	tui.LogNormal("Sending '%s' message...", op.GetName())
+	resp, err := d.request(ctx, op)
+	if err != nil || !op.IsPending(resp) {
+		return resp, err
+	}
+	tui.LogNormal("Expecting OperationComplete notification for '%s'...", op.Command.String())
+	notif, err := d.expectNotify(ctx, op.Match)
+	if err != nil {
+		return nil, err
+	}
+	return notif, op.ValidateResponse(notif)
+}
+
+// create a non-persistent subscription to the completion of the given command; returns its instance path
+func (d *USPDevice) subscribe(ctx context.Context, command string) (string, error) {
+	id := make([]byte, 4)
+	rand.Read(id)
+	add := messages.Add{CreateObjs: []messages.ObjectSettings{{
+		ObjPath: configuration.T(subscriptionTable),
+		ParamSettings: []messages.ParamSetting{
+			{Param: configuration.T("Enable"), Value: configuration.T("true"), Required: true},
+			{Param: configuration.T("ID"), Value: configuration.T("corteca-" + hex.EncodeToString(id)), Required: true},
+			{Param: configuration.T("NotifType"), Value: configuration.T("OperationComplete"), Required: true},
+			{Param: configuration.T("ReferenceList"), Value: configuration.T(command), Required: true},
+			{Param: configuration.T("Persistent"), Value: configuration.T("false")},
+		},
+	}}}
+	resp, err := d.request(ctx, add)
+	if err != nil {
+		return "", err
+	}
+	results := resp.(messages.AddResp).CreatedObjResults
+	if len(results) == 0 || len(results[0].InstantiatedPath) == 0 {
+		return "", fmt.Errorf("no subscription created by the agent")
+	}
+	return results[0].InstantiatedPath, nil
+}
+
+func (d *USPDevice) unsubscribe(path string) {
+	ctx, cancel := context.WithTimeout(context.Background(), configuration.DefaultMaxTimeout)
+	defer cancel()
+	if _, err := d.request(ctx, messages.Delete{ObjPaths: []configuration.TemplateField{configuration.T(path)}}); err != nil {
+		tui.LogWarning("Failed to delete subscription '%s': %s", path, err.Error())
+	}
+}
+
+// send a request and wait for the response with the same message ID
+func (d *USPDevice) request(ctx context.Context, req messages.Request) (messages.Message, error) {
+	id := uuid.NewString()
+	if err := d.send(&messages.Msg{ID: id, Body: req}); err != nil {
+		return nil, err
+	}
+	for {
+		msg, err := d.pullMsg(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if msg.ID == id {
+			if e, ok := msg.Body.(messages.Error); ok {
+				return nil, e
+			}
+			return msg.Body, req.ValidateResponse(msg.Body)
+		}
+		if notif, ok := msg.Body.(messages.Notify); ok {
+			d.notifications = append(d.notifications, notif)
+		} else {
+			tui.LogWarning("Ignoring unexpected '%s' message (msg_id: %s)", msg.Body.GetName(), msg.ID)
+		}
+	}
+}
+
+// wait for a notification accepted by the matcher, including the ones received earlier
+func (d *USPDevice) expectNotify(ctx context.Context, matcher func(messages.Notify) bool) (messages.Notify, error) {
+	for i, notif := range d.notifications {
+		if matcher(notif) {
+			d.notifications = append(d.notifications[:i], d.notifications[i+1:]...)
+			return notif, nil
+		}
+	}
+	for {
+		msg, err := d.pullMsg(ctx)
+		if err != nil {
+			return messages.Notify{}, err
+		}
+		if notif, ok := msg.Body.(messages.Notify); !ok {
+			tui.LogWarning("Ignoring unexpected '%s' message (msg_id: %s)", msg.Body.GetName(), msg.ID)
+		} else if matcher(notif) {
+			return notif, nil
+		} else {
+			d.notifications = append(d.notifications, notif)
+		}
+	}
+}
+
+func (d *USPDevice) pullMsg(ctx context.Context) (*messages.Msg, error) {
+	select {
+	case msg := <-d.in:
+		return msg, nil
+	case <-d.halted:
+		return nil, d.haltErr
+	case <-ctx.Done():
+		return nil, fmt.Errorf("timeout while waiting for incoming message")
+	}
+}
+
+func (d *USPDevice) send(msg *messages.Msg) error {
+	d.logMsg("OUT", msg)
+	record, err := messages.NewRecord(d.agentId, d.controllerId, *msg)
+	if err != nil {
+		return err
+	}
+	data, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+	if err := d.conn.WriteMessage(data); err != nil {
+		return fmt.Errorf("error sending message: %w", err)
+	}
+	return nil
+}
+
+// read records from the agent until the connection is lost; notifications requiring a response are
+// acknowledged immediately
+func (d *USPDevice) receive() {
+	defer close(d.halted)
+	for {
+		data, err := d.conn.ReadMessage()
+		if err != nil {
+			d.haltErr = fmt.Errorf("connection to USP agent lost: %w", err)
+			return
+		}
+		record, err := messages.ParseRecord(data)
+		if err != nil {
+			tui.LogWarning("Discarding malformed USP record: %s", err.Error())
+			continue
+		}
+		if record.Disconnect != nil {
+			d.haltErr = fmt.Errorf("USP agent disconnected: %s (reason_code: %d)", record.Disconnect.Reason, record.Disconnect.ReasonCode)
+			return
+		}
+		if len(record.Payload) == 0 {
+			continue
+		}
+		msg, err := messages.ParseMsg(record.Payload)
+		if err != nil {
+			tui.LogWarning("Discarding malformed USP message: %s", err.Error())
+			continue
+		}
+		d.logMsg("IN", msg)
+		if notif, ok := msg.Body.(messages.Notify); ok && notif.SendResp {
+			if err := d.send(&messages.Msg{ID: msg.ID, Body: messages.NotifyResp{SubscriptionID: notif.SubscriptionID}}); err != nil {
+				tui.LogWarning("Failed to respond to notification: %s", err.Error())
+			}
+		}
+		select {
+		case d.in <- msg:
+		case <-d.closed:
+			return
+		}
+	}
+}
+
+func (d *USPDevice) logLine(line string) {
+	d.logMutex.Lock()
+	defer d.logMutex.Unlock()
+	d.log.Write(fmt.Appendf([]byte(""), "[%s] %s\n", time.Now().Format(time.DateTime), line))
+}
+
+func (d *USPDevice) logMsg(direction string, msg *messages.Msg) {
+	body, err := yaml.Marshal(msg.Body)
+	if err != nil {
+		panic(err)
+	}
+	d.logMutex.Lock()
+	defer d.logMutex.Unlock()
+	d.log.Write(fmt.Appendf([]byte(""), "[%s] %s: %s (msg_id: %s)\n", time.Now().Format(time.DateTime), direction, msg.Body.GetName(), msg.ID))
+	d.log.Write(body)
+	d.log.Write([]byte("--------------------------------------------------------------------------------\n"))
+}
@@ -0,0 +1,215 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package usp
+
+import (
+	"context"
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"github.com/nokia/corteca-cli/internal/device"
+	"github.com/nokia/corteca-cli/internal/device/usp/messages"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+const testAgentId = "os::012345-ABC"
+
+func newTestDevice(t *testing.T) (*mockAgent, device.Device) {
+	agent, server := startMockAgent(t, testAgentId, map[string]string{
+		"Device.DeviceInfo.SerialNumber":    "ABC",
+		"Device.DeviceInfo.SoftwareVersion": "1.0",
+	})
+	var config configuration.DeviceConfig
+	require.NoError(t, yaml.Unmarshal([]byte(fmt.Sprintf(`
+addr: %s
+agentId: %s
+`, strings.Replace(server.URL, "http://", "usp://", 1), testAgentId)), &config))
+	dev, err := device.NewDevice(&config, io.Discard)
+	require.NoError(t, err)
+	t.Cleanup(dev.Close)
+	return agent, dev
+}
+
+func executeStep(t *testing.T, dev device.Device, step string) (any, error) {
+	var cmd configuration.SequenceCmd
+	require.NoError(t, yaml.Unmarshal([]byte(step), &cmd))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	return dev.ExecuteCommand(ctx, &cmd)
+}
+
+func TestNewUSPDeviceRequiresAgentId(t *testing.T) {
+	var config configuration.DeviceConfig
+	require.NoError(t, yaml.Unmarshal([]byte("addr: usp://127.0.0.1:1"), &config))
+	_, err := device.NewDevice(&config, io.Discard)
+	assert.ErrorContains(t, err, "agentId")
+}
+
+func TestUSPAuthenticatedHandshake(t *testing.T) {
+	agent, server := startMockAgent(t, testAgentId, map[string]string{})
+	agent.username, agent.password = "controller", "secret"
+	connect := func(password string) error {
+		var config configuration.DeviceConfig
+		require.NoError(t, yaml.Unmarshal([]byte(fmt.Sprintf(`
+addr: %s
+agentId: %s
+auth: basic
+username: controller
+password: %s
+`, strings.Replace(server.URL, "http://", "usp://", 1), testAgentId, password)), &config))
+		dev, err := device.NewDevice(&config, io.Discard)
+		if err == nil {
+			dev.Close()
+		}
+		return err
+	}
+
+	assert.NoError(t, connect("secret"))
+	assert.ErrorContains(t, connect("wrong"), "401")
+}
+
+func TestUSPGetSetAddDelete(t *testing.T) {
+	_, dev := newTestDevice(t)
+	require.NoError(t, dev.BeginSequence())
+	assert.Equal(t, "usp", dev.GetProtocol())
+
+	res, err := executeStep(t, dev, `
+cmd: Get
+param_paths:
+    - Device.DeviceInfo.
+`)
+	require.NoError(t, err)
+	assert.Equal(t, "ABC", res.(messages.GetResp).Params()["Device.DeviceInfo.SerialNumber"])
+
+	_, err = executeStep(t, dev, `
+cmd: Get
+param_paths:
+    - Device.Unknown.
+`)
+	assert.ErrorContains(t, err, "7026")
+
+	_, err = executeStep(t, dev, `
+cmd: Set
+update_objs:
+    - obj_path: Device.DeviceInfo.
+      param_settings:
+          - param: ProvisioningCode
+            value: abc
+            required: true
+`)
+	require.NoError(t, err)
+
+	res, err = executeStep(t, dev, `
+cmd: Add
+create_objs:
+    - obj_path: Device.IP.Interface.
+`)
+	require.NoError(t, err)
+	path := res.(messages.AddResp).CreatedObjResults[0].InstantiatedPath
+	assert.Equal(t, "Device.IP.Interface.1.", path)
+
+	_, err = executeStep(t, dev, fmt.Sprintf(`
+cmd: Delete
+obj_paths:
+    - %s
+`, path))
+	require.NoError(t, err)
+
+	_, err = executeStep(t, dev, "cmd: GetInstances")
+	assert.ErrorContains(t, err, "unknown USP message")
+	assert.NoError(t, dev.EndSequence())
+}
+
+func TestUSPInstallDU(t *testing.T) {
+	agent, dev := newTestDevice(t)
+	require.NoError(t, dev.BeginSequence())
+
+	res, err := executeStep(t, dev, `
+cmd: Operate
+command: Device.SoftwareModules.InstallDU()
+command_key: install
+input_args:
+    URL: http://example.com/app.tar.gz
+    ExecutionEnvRef: Device.SoftwareModules.ExecEnv.1.
+`)
+	require.NoError(t, err)
+	complete := res.(messages.Notify).OperComplete
+	require.NotNil(t, complete)
+	assert.Equal(t, "Device.SoftwareModules.DeploymentUnit.1.", complete.ReqOutputArgs["DeploymentUnitRef"])
+
+	_, err = executeStep(t, dev, `
+cmd: Operate
+command: Device.SoftwareModules.InstallDU()
+command_key: install-ftp
+input_args:
+    URL: ftp://example.com/app.tar.gz
+`)
+	assert.ErrorContains(t, err, "Unsupported protocol")
+
+	_, err = executeStep(t, dev, `
+cmd: Operate
+command: Device.Reboot()
+`)
+	assert.ErrorContains(t, err, "7022")
+	assert.NoError(t, dev.EndSequence())
+
+	// the subscriptions are created before each command and removed afterwards, and every notification was acknowledged;
+	// the agent handled all of these before answering the last Delete
+	var adds, deletes, notifyResps int
+	for _, m := range agent.receivedMessages() {
+		switch m := m.(type) {
+		case messages.Add:
+			assert.Equal(t, subscriptionTable, m.CreateObjs[0].ObjPath.String())
+			adds++
+		case messages.Delete:
+			deletes++
+		case messages.NotifyResp:
+			notifyResps++
+		case messages.Operate:
+			assert.True(t, m.SendResp)
+		}
+	}
+	assert.Equal(t, 3, adds)
+	assert.Equal(t, 3, deletes)
+	assert.Equal(t, 2, notifyResps)
+	agent.mu.Lock()
+	defer agent.mu.Unlock()
+	assert.Empty(t, agent.subscriptions)
+}
+
+func TestUSPReadDataModel(t *testing.T) {
+	_, dev := newTestDevice(t)
+	require.NoError(t, dev.BeginSequence())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	params, err := dev.(device.DataModelReader).ReadDataModel(ctx, "Device.", 0)
+	require.NoError(t, err)
+	assert.Equal(t, []device.Parameter{
+		{Name: "Device."},
+		{Name: "Device.DeviceInfo."},
+		{Name: "Device.DeviceInfo.SerialNumber", Value: "ABC"},
+		{Name: "Device.DeviceInfo.SoftwareVersion", Value: "1.0"},
+	}, params)
+
+	params, err = dev.(device.DataModelReader).ReadDataModel(ctx, "Device.", 1)
+	require.NoError(t, err)
+	assert.Equal(t, []device.Parameter{{Name: "Device."}, {Name: "Device.DeviceInfo."}}, params)
+
+	// objects are left out of snapshots
+	params, err = device.ReadParameterValues(ctx, dev, "Device.DeviceInfo.")
+	require.NoError(t, err)
+	assert.Equal(t, []device.Parameter{
+		{Name: "Device.DeviceInfo.SerialNumber", Value: "ABC"},
+		{Name: "Device.DeviceInfo.SoftwareVersion", Value: "1.0"},
+	}, params)
+	assert.NoError(t, dev.EndSequence())
+}
@@ -0,0 +1,124 @@
+// Copyright 2024 Nokia
+// Licensed under the BSD 3-Clause License.
+// SPDX-License-Identifier: BSD-3-Clause
+
+package usp
+
+import (
+	"github.com/nokia/corteca-cli/internal/configuration"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/icholy/digest"
+)
+
+// WebSocket MTP (TR-369 section 6.4)
+const (
+	wsMaxMessageSize   = 16 << 20
+	wsHandshakeTimeout = 30 * time.Second
+	wsCloseTimeout     = time.Second
+
+	USPSubprotocol = "v1.usp"
+)
+
+// WebSocket carrying one USP record per binary message
+type wsConn struct {
+	ws  *websocket.Conn
+	wmu sync.Mutex
+}
+
+// performs the WebSocket handshake as the innermost transport of the endpoint's HTTP client, so that the
+// configured authentication (including digest challenges) applies to the upgrade request
+type wsDialTransport struct {
+	dialer *websocket.Dialer
+	conn   *websocket.Conn
+}
+
+func (t *wsDialTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	u := *req.URL
+	switch u.Scheme {
+	case "http":
+		u.Scheme = "ws"
+	case "https":
+		u.Scheme = "wss"
+	}
+	conn, resp, err := t.dialer.DialContext(req.Context(), u.String(), req.Header)
+	if errors.Is(err, websocket.ErrBadHandshake) {
+		// hand the response back to the client, to answer an authentication challenge
+		return resp, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	t.conn = conn
+	return resp, nil
+}
+
+// open a WebSocket to the given http(s) URL, using the authentication and TLS settings of the endpoint
+func dialWebSocket(ep *configuration.HttpClientEndpoint, url string, header http.Header) (*wsConn, error) {
+	client, err := ep.NewHttpClient()
+	if err != nil {
+		return nil, err
+	}
+	transport := &wsDialTransport{dialer: &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: wsHandshakeTimeout,
+		TLSClientConfig:  &tls.Config{InsecureSkipVerify: ep.SkipTLSVerification},
+		Subprotocols:     []string{USPSubprotocol},
+	}}
+	switch t := client.Transport.(type) {
+	case nil:
+		client.Transport = transport
+	case *configuration.BasicAuthTransport:
+		t.Transport = transport
+	case *configuration.BearerAuthTransport:
+		t.Transport = transport
+	case *digest.Transport:
+		t.Transport = transport
+	}
+
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = header
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("WebSocket handshake failed: %w", err)
+	}
+	resp.Body.Close()
+	if transport.conn == nil {
+		return nil, fmt.Errorf("WebSocket handshake failed: %s", resp.Status)
+	}
+	c := &wsConn{ws: transport.conn}
+	if protocol := c.ws.Subprotocol(); protocol != USPSubprotocol {
+		c.ws.Close()
+		return nil, fmt.Errorf("WebSocket handshake failed: unexpected subprotocol '%s'", protocol)
+	}
+	c.ws.SetReadLimit(wsMaxMessageSize)
+	return c, nil
+}
+
+// send a binary message
+func (c *wsConn) WriteMessage(data []byte) error {
+	c.wmu.Lock()
+	defer c.wmu.Unlock()
+	return c.ws.WriteMessage(websocket.BinaryMessage, data)
+}
+
+// receive the next data message; control frames are handled by the library
+func (c *wsConn) ReadMessage() ([]byte, error) {
+	_, data, err := c.ws.ReadMessage()
+	return data, err
+}
+
+// send a close frame and close the underlying connection
+func (c *wsConn) Close() error {
+	c.ws.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(wsCloseTimeout))
+	return c.ws.Close()
+}
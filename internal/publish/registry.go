@@ -5,21 +5,31 @@
 package publish
 
 import (
+	"github.com/nokia/corteca-cli/internal/certs"
 	"github.com/nokia/corteca-cli/internal/configuration"
 	"github.com/nokia/corteca-cli/internal/tui"
+	"crypto/tls"
 	"net/http"
 
 	"github.com/google/go-containerregistry/pkg/registry"
 )
 
-func StartRegistry(config configuration.HttpServerEndpoint) (*http.Server, error) {
+func StartRegistry(config configuration.HttpServerEndpoint, useTLS bool) (*http.Server, error) {
 	handler := registry.New()
 	server := &http.Server{Addr: config.Addr.String(), Handler: handler}
 	certFile := config.Certificate.String()
 	keyFile := config.Key.String()
+	if useTLS || len(certFile) > 0 || len(keyFile) > 0 {
+		var err error
+		// fall back to a generated self-signed certificate
+		if certFile, keyFile, err = certs.ResolveServerCertificate(certFile, keyFile); err != nil {
+			return nil, err
+		}
+		server.TLSConfig = &tls.Config{}
+	}
 	go func() {
 		var err error
-		if len(certFile) > 0 {
+		if server.TLSConfig != nil {
 			err = server.ListenAndServeTLS(certFile, keyFile)
 		} else {
 			err = server.ListenAndServe()
@@ -74,6 +74,11 @@ func ResetOutputColor(out io.Writer) {
 	}
 }
 
+// whether the user can be prompted, i.e. the standard input is a terminal
+func IsInteractive() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
 func PromptForValue(label string, defaultValue string) (string, error) {
 	result, err := pterm.DefaultInteractiveTextInput.WithDefaultValue(defaultValue).Show(label)
 	if result == "" && err == nil {